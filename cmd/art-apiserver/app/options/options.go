@@ -2,11 +2,15 @@
 package options
 
 import (
+	"fmt"
+	"net"
+
 	genericoptions "github.com/moweilong/milady/pkg/options"
 	"github.com/spf13/pflag"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/moweilong/art-design-pro-go/internal/apiserver"
+	pkgoptions "github.com/moweilong/art-design-pro-go/internal/pkg/options"
 )
 
 // ServerOptions contains the configuration options for the server.
@@ -20,20 +24,56 @@ type ServerOptions struct {
 	// OTelOptions used to specify the otel options.
 	OTelOptions *genericoptions.OTelOptions `json:"otel" mapstructure:"otel"`
 	// JWT options for configuring JWT related options.
-	JWTOptions *genericoptions.JWTOptions `json:"jwt" mapstructure:"jwt"`
+	JWTOptions *pkgoptions.JWTOptions `json:"jwt" mapstructure:"jwt"`
 	// Redis options for configuring Redis related options.
 	RedisOptions *genericoptions.RedisOptions `json:"redis" mapstructure:"redis"`
+	// LockoutOptions contains the brute-force protection options of the login.
+	LockoutOptions *pkgoptions.LockoutOptions `json:"lockout" mapstructure:"lockout"`
+	// CaptchaOptions contains the image captcha options of the login and registration.
+	CaptchaOptions *pkgoptions.CaptchaOptions `json:"captcha" mapstructure:"captcha"`
+	// SignatureOptions contains the options of the AK/SK request signatures.
+	SignatureOptions *pkgoptions.SignatureOptions `json:"signature" mapstructure:"signature"`
+	// OIDCOptions contains the identity providers of the single sign-on.
+	OIDCOptions *pkgoptions.OIDCOptions `json:"oidc" mapstructure:"oidc"`
+	// LDAPOptions contains the options of the LDAP / Active Directory authentication.
+	LDAPOptions *pkgoptions.LDAPOptions `json:"ldap" mapstructure:"ldap"`
+	// PasswordPolicyOptions contains the rules new passwords must follow.
+	PasswordPolicyOptions *pkgoptions.PasswordPolicyOptions `json:"password-policy" mapstructure:"password-policy"`
+	// PasswordHashOptions contains the algorithm and parameters of the password hashes.
+	PasswordHashOptions *pkgoptions.PasswordHashOptions `json:"password-hash" mapstructure:"password-hash"`
+	// PasswordResetOptions contains the options of the self-service password reset.
+	PasswordResetOptions *pkgoptions.PasswordResetOptions `json:"password-reset" mapstructure:"password-reset"`
+	// RegistrationOptions contains the options of the self-registration.
+	RegistrationOptions *pkgoptions.RegistrationOptions `json:"registration" mapstructure:"registration"`
+	// MailOptions contains the options of the outgoing mails.
+	MailOptions *pkgoptions.MailOptions `json:"mail" mapstructure:"mail"`
+	// ExtAuthzOptions contains the options of the Envoy ext_authz gRPC server.
+	ExtAuthzOptions *pkgoptions.ExtAuthzOptions `json:"ext-authz" mapstructure:"ext-authz"`
+	// TrustedProxies are the IPs and CIDRs of the reverse proxies whose X-Forwarded-For
+	// headers are trusted. No proxy is trusted by default, the client IP is the peer address.
+	TrustedProxies []string `json:"trusted-proxies" mapstructure:"trusted-proxies"`
 }
 
 // NewServerOptions creates a ServerOptions instance with default values.
 func NewServerOptions() *ServerOptions {
 	opts := &ServerOptions{
-		TLSOptions:   genericoptions.NewTLSOptions(),
-		HTTPOptions:  genericoptions.NewHTTPOptions(),
-		JWTOptions:   genericoptions.NewJWTOptions(),
-		RedisOptions: genericoptions.NewRedisOptions(),
-		MySQLOptions: genericoptions.NewMySQLOptions(),
-		OTelOptions:  genericoptions.NewOTelOptions(),
+		TLSOptions:            genericoptions.NewTLSOptions(),
+		HTTPOptions:           genericoptions.NewHTTPOptions(),
+		JWTOptions:            pkgoptions.NewJWTOptions(),
+		RedisOptions:          genericoptions.NewRedisOptions(),
+		MySQLOptions:          genericoptions.NewMySQLOptions(),
+		OTelOptions:           genericoptions.NewOTelOptions(),
+		LockoutOptions:        pkgoptions.NewLockoutOptions(),
+		CaptchaOptions:        pkgoptions.NewCaptchaOptions(),
+		SignatureOptions:      pkgoptions.NewSignatureOptions(),
+		OIDCOptions:           pkgoptions.NewOIDCOptions(),
+		LDAPOptions:           pkgoptions.NewLDAPOptions(),
+		PasswordPolicyOptions: pkgoptions.NewPasswordPolicyOptions(),
+		PasswordHashOptions:   pkgoptions.NewPasswordHashOptions(),
+		PasswordResetOptions:  pkgoptions.NewPasswordResetOptions(),
+		RegistrationOptions:   pkgoptions.NewRegistrationOptions(),
+		MailOptions:           pkgoptions.NewMailOptions(),
+		ExtAuthzOptions:       pkgoptions.NewExtAuthzOptions(),
 	}
 	opts.HTTPOptions.Addr = ":5555"
 
@@ -49,6 +89,19 @@ func (o *ServerOptions) AddFlags(fs *pflag.FlagSet) {
 	o.OTelOptions.AddFlags(fs)
 	o.JWTOptions.AddFlags(fs)
 	o.RedisOptions.AddFlags(fs)
+	o.LockoutOptions.AddFlags(fs)
+	o.CaptchaOptions.AddFlags(fs)
+	o.SignatureOptions.AddFlags(fs)
+	o.OIDCOptions.AddFlags(fs)
+	o.LDAPOptions.AddFlags(fs)
+	o.PasswordPolicyOptions.AddFlags(fs)
+	o.PasswordHashOptions.AddFlags(fs)
+	o.PasswordResetOptions.AddFlags(fs)
+	o.RegistrationOptions.AddFlags(fs)
+	o.MailOptions.AddFlags(fs)
+	o.ExtAuthzOptions.AddFlags(fs)
+
+	fs.StringSliceVar(&o.TrustedProxies, "trusted-proxies", o.TrustedProxies, "The IPs and CIDRs of the reverse proxies whose X-Forwarded-For headers are trusted.")
 }
 
 // Complete completes all the required options.
@@ -68,6 +121,25 @@ func (o *ServerOptions) Validate() error {
 	errs = append(errs, o.OTelOptions.Validate()...)
 	errs = append(errs, o.JWTOptions.Validate()...)
 	errs = append(errs, o.RedisOptions.Validate()...)
+	errs = append(errs, o.LockoutOptions.Validate()...)
+	errs = append(errs, o.CaptchaOptions.Validate()...)
+	errs = append(errs, o.SignatureOptions.Validate()...)
+	errs = append(errs, o.OIDCOptions.Validate()...)
+	errs = append(errs, o.LDAPOptions.Validate()...)
+	errs = append(errs, o.PasswordPolicyOptions.Validate()...)
+	errs = append(errs, o.PasswordHashOptions.Validate()...)
+	errs = append(errs, o.PasswordResetOptions.Validate()...)
+	errs = append(errs, o.RegistrationOptions.Validate()...)
+	errs = append(errs, o.MailOptions.Validate()...)
+	errs = append(errs, o.ExtAuthzOptions.Validate()...)
+	for _, proxy := range o.TrustedProxies {
+		if net.ParseIP(proxy) != nil {
+			continue
+		}
+		if _, _, err := net.ParseCIDR(proxy); err != nil {
+			errs = append(errs, fmt.Errorf("--trusted-proxies: %q is not an IP or CIDR", proxy))
+		}
+	}
 
 	// Aggregate all errors and return them.
 	return utilerrors.NewAggregate(errs)
@@ -76,10 +148,22 @@ func (o *ServerOptions) Validate() error {
 // Config builds an apiserver.Config based on ServerOptions.
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
 	return &apiserver.Config{
-		TLSOptions:   o.TLSOptions,
-		HTTPOptions:  o.HTTPOptions,
-		MySQLOptions: o.MySQLOptions,
-		JWTOptions:   o.JWTOptions,
-		RedisOptions: o.RedisOptions,
+		TLSOptions:            o.TLSOptions,
+		HTTPOptions:           o.HTTPOptions,
+		MySQLOptions:          o.MySQLOptions,
+		JWTOptions:            o.JWTOptions,
+		RedisOptions:          o.RedisOptions,
+		LockoutOptions:        o.LockoutOptions,
+		CaptchaOptions:        o.CaptchaOptions,
+		SignatureOptions:      o.SignatureOptions,
+		OIDCOptions:           o.OIDCOptions,
+		LDAPOptions:           o.LDAPOptions,
+		PasswordPolicyOptions: o.PasswordPolicyOptions,
+		PasswordHashOptions:   o.PasswordHashOptions,
+		PasswordResetOptions:  o.PasswordResetOptions,
+		RegistrationOptions:   o.RegistrationOptions,
+		MailOptions:           o.MailOptions,
+		ExtAuthzOptions:       o.ExtAuthzOptions,
+		TrustedProxies:        o.TrustedProxies,
 	}, nil
 }
@@ -161,6 +161,16 @@ func applyGeneratorOptions(g *gen.Generator) {
 func GenerateArtModels(g *gen.Generator) {
 	g.GenerateModelAs("user", "UserM")
 	g.GenerateModelAs("secret", "SecretM")
+	g.GenerateModelAs("user_mfa", "UserMFAM")
+	g.GenerateModelAs("user_identity", "UserIdentityM")
+	g.GenerateModelAs("user_session", "UserSessionM")
+	g.GenerateModelAs("user_password_history", "UserPasswordHistoryM")
+	g.GenerateModelAs("audit_log", "AuditLogM")
+	g.GenerateModelAs("role", "RoleM")
+	g.GenerateModelAs("menu", "MenuM")
+	g.GenerateModelAs("role_menu", "RoleMenuM")
+	g.GenerateModelAs("role_department", "RoleDepartmentM")
+	g.GenerateModelAs("department", "DepartmentM")
 }
 
 func rootDir() string {
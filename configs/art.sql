@@ -38,14 +38,211 @@ CREATE TABLE `user` (
   `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
   `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
   `username` varchar(253) NOT NULL DEFAULT '' COMMENT '用户名称',
-  `status` tinyint(3) unsigned NOT NULL DEFAULT 1 COMMENT '用户状态，0-禁用；1-启用',
+  `status` varchar(16) NOT NULL DEFAULT 'actived' COMMENT '用户状态，registered-待激活；actived-已激活',
   `nickname` varchar(253) NOT NULL DEFAULT '' COMMENT '用户昵称',
-  `password` varchar(64) NOT NULL DEFAULT '' COMMENT '用户加密后的密码',
+  `password` varchar(255) NOT NULL DEFAULT '' COMMENT '用户加密后的密码',
   `email` varchar(253) NOT NULL DEFAULT '' COMMENT '用户电子邮箱',
   `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `departmentId` varchar(36) NOT NULL DEFAULT '' COMMENT '所属部门 ID，不属于任何部门时为空',
+  `passwordChangedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '最近一次修改密码的时间',
   `createdAt` datetime NOT NULL COMMENT '创建时间',
   `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
   PRIMARY KEY (`id`),
   UNIQUE KEY `idx_username` (`username`),
-  UNIQUE KEY `idx_user_id` (`userId`)
-) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户表';
\ No newline at end of file
+  UNIQUE KEY `idx_user_id` (`userId`),
+  KEY `idx_user_department_id` (`departmentId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户表';
+
+--
+-- Table structure for table `user_mfa`
+--
+
+DROP TABLE IF EXISTS `user_mfa`;
+CREATE TABLE `user_mfa` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `secret` varchar(64) NOT NULL DEFAULT '' COMMENT 'TOTP 密钥，base32 编码',
+  `enabled` tinyint(3) unsigned NOT NULL DEFAULT 0 COMMENT '是否已启用，0-待确认；1-已启用',
+  `recoveryCodes` varchar(1024) NOT NULL DEFAULT '' COMMENT '未使用的恢复码的 SHA-256 摘要，逗号分隔',
+  `lastUsedStep` bigint(20) NOT NULL DEFAULT 0 COMMENT '最近一次使用的 TOTP 时间步，防止验证码重放',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_mfa_user_id` (`userId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户多因素认证表';
+
+--
+-- Table structure for table `user_identity`
+--
+
+DROP TABLE IF EXISTS `user_identity`;
+CREATE TABLE `user_identity` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `provider` varchar(64) NOT NULL DEFAULT '' COMMENT '身份提供方名称',
+  `subject` varchar(255) NOT NULL DEFAULT '' COMMENT '用户在身份提供方的唯一标识',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_identity_provider_subject` (`provider`,`subject`),
+  KEY `idx_identity_user_id` (`userId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户外部身份表';
+
+--
+-- Table structure for table `user_session`
+--
+
+DROP TABLE IF EXISTS `user_session`;
+CREATE TABLE `user_session` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `sessionId` varchar(36) NOT NULL DEFAULT '' COMMENT '会话 ID，写入令牌的 sid 声明',
+  `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `device` varchar(128) NOT NULL DEFAULT '' COMMENT '设备名称，由 User-Agent 解析',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT '登录时的客户端 IP',
+  `userAgent` varchar(512) NOT NULL DEFAULT '' COMMENT '登录时的 User-Agent',
+  `actorId` varchar(253) NOT NULL DEFAULT '' COMMENT '模拟登录该用户的管理员 ID，为空表示用户本人登录',
+  `lastSeenAt` datetime NOT NULL COMMENT '最近一次刷新令牌的时间',
+  `expiresAt` datetime NOT NULL COMMENT '过期时间，即刷新令牌的过期时间',
+  `createdAt` datetime NOT NULL COMMENT '创建时间，即登录时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_session_session_id` (`sessionId`),
+  KEY `idx_session_user_id` (`userId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户登录会话表';
+
+--
+-- Table structure for table `user_password_history`
+--
+
+DROP TABLE IF EXISTS `user_password_history`;
+CREATE TABLE `user_password_history` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '用户 ID',
+  `password` varchar(255) NOT NULL DEFAULT '' COMMENT '用户曾经使用的加密后的密码',
+  `createdAt` datetime NOT NULL COMMENT '创建时间，即设置该密码的时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_password_history_user_id` (`userId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='用户密码历史表';
+
+--
+-- Table structure for table `audit_log`
+--
+
+DROP TABLE IF EXISTS `audit_log`;
+CREATE TABLE `audit_log` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `userId` varchar(253) NOT NULL DEFAULT '' COMMENT '操作所使用的用户 ID',
+  `actorId` varchar(253) NOT NULL DEFAULT '' COMMENT '实际执行操作的管理员 ID',
+  `action` varchar(255) NOT NULL DEFAULT '' COMMENT '操作，impersonate 或请求的路由，例如 PUT /v1/users/:userID',
+  `path` varchar(1024) NOT NULL DEFAULT '' COMMENT '请求路径',
+  `statusCode` int(11) NOT NULL DEFAULT 0 COMMENT '响应的 HTTP 状态码',
+  `ip` varchar(64) NOT NULL DEFAULT '' COMMENT '客户端 IP',
+  `sessionId` varchar(36) NOT NULL DEFAULT '' COMMENT '执行操作的令牌所属的会话 ID',
+  `createdAt` datetime NOT NULL COMMENT '创建时间，即操作时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_audit_log_user_id` (`userId`),
+  KEY `idx_audit_log_actor_id` (`actorId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='审计日志表';
+
+--
+-- Table structure for table `role`
+--
+
+DROP TABLE IF EXISTS `role`;
+CREATE TABLE `role` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `name` varchar(253) NOT NULL DEFAULT '' COMMENT '角色名称，即 casbin 中的角色，例如 role::admin',
+  `displayName` varchar(253) NOT NULL DEFAULT '' COMMENT '角色显示名称',
+  `description` varchar(255) NOT NULL DEFAULT '' COMMENT '角色描述',
+  `status` tinyint(3) unsigned NOT NULL DEFAULT 1 COMMENT '角色状态，0-禁用；1-启用',
+  `dataScope` tinyint(3) unsigned NOT NULL DEFAULT 5 COMMENT '数据权限，1-全部；2-自定义部门；3-本部门；4-本部门及下级部门；5-仅本人',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uniq_role_name` (`name`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='角色表';
+
+-- 内置角色，不能删除或禁用。新用户自动获得 role::user 角色，只能访问自己的数据
+INSERT INTO `role` (`name`, `displayName`, `description`, `status`, `dataScope`, `createdAt`, `updatedAt`) VALUES
+  ('role::user', '普通用户', '所有用户创建时自动获得的角色', 1, 5, NOW(), NOW()),
+  ('role::admin', '管理员', '系统管理员', 1, 1, NOW(), NOW());
+
+--
+-- Table structure for table `menu`
+--
+
+DROP TABLE IF EXISTS `menu`;
+CREATE TABLE `menu` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `menuId` varchar(36) NOT NULL DEFAULT '' COMMENT '菜单 ID',
+  `parentId` varchar(36) NOT NULL DEFAULT '' COMMENT '上级菜单 ID，顶级菜单为空',
+  `type` tinyint(3) unsigned NOT NULL DEFAULT 2 COMMENT '菜单类型，1-目录；2-菜单；3-按钮',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '路由名称',
+  `path` varchar(255) NOT NULL DEFAULT '' COMMENT '路由路径',
+  `component` varchar(255) NOT NULL DEFAULT '' COMMENT '前端组件路径',
+  `icon` varchar(64) NOT NULL DEFAULT '' COMMENT '图标',
+  `sort` int(11) NOT NULL DEFAULT 0 COMMENT '排序，同级菜单按升序排列',
+  `title` varchar(128) NOT NULL DEFAULT '' COMMENT '标题的国际化键，例如 menus.dashboard.console',
+  `hidden` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否在侧边栏中隐藏',
+  `keepAlive` tinyint(1) NOT NULL DEFAULT 0 COMMENT '是否缓存页面',
+  `authMark` varchar(128) NOT NULL DEFAULT '' COMMENT '权限标识，例如 user:add',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_menu_id` (`menuId`),
+  KEY `idx_menu_parent_id` (`parentId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='菜单表';
+
+--
+-- Table structure for table `role_menu`
+--
+
+DROP TABLE IF EXISTS `role_menu`;
+CREATE TABLE `role_menu` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `role` varchar(253) NOT NULL DEFAULT '' COMMENT '角色名称，例如 role::admin',
+  `menuId` varchar(36) NOT NULL DEFAULT '' COMMENT '菜单 ID',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uniq_role_menu` (`role`, `menuId`),
+  KEY `idx_role_menu_menu_id` (`menuId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='角色菜单关联表';
+
+--
+-- Table structure for table `role_department`
+--
+
+DROP TABLE IF EXISTS `role_department`;
+CREATE TABLE `role_department` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `role` varchar(253) NOT NULL DEFAULT '' COMMENT '角色名称，例如 role::admin',
+  `departmentId` varchar(36) NOT NULL DEFAULT '' COMMENT '部门 ID',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uniq_role_department` (`role`, `departmentId`),
+  KEY `idx_role_department_department_id` (`departmentId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='角色自定义数据权限的部门关联表';
+
+--
+-- Table structure for table `department`
+--
+
+DROP TABLE IF EXISTS `department`;
+CREATE TABLE `department` (
+  `id` bigint(20) unsigned NOT NULL AUTO_INCREMENT COMMENT '主键 ID',
+  `departmentId` varchar(36) NOT NULL DEFAULT '' COMMENT '部门 ID',
+  `parentId` varchar(36) NOT NULL DEFAULT '' COMMENT '上级部门 ID，顶级部门为空',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '部门名称',
+  `sort` int(11) NOT NULL DEFAULT 0 COMMENT '排序，同级部门按升序排列',
+  `leaderId` varchar(253) NOT NULL DEFAULT '' COMMENT '部门负责人的用户 ID',
+  `status` tinyint(3) unsigned NOT NULL DEFAULT 1 COMMENT '部门状态，0-禁用；1-启用',
+  `createdAt` datetime NOT NULL COMMENT '创建时间',
+  `updatedAt` datetime NOT NULL COMMENT '最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `idx_department_id` (`departmentId`),
+  KEY `idx_department_parent_id` (`parentId`)
+) ENGINE=InnoDB AUTO_INCREMENT=0 DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci COMMENT='部门表';
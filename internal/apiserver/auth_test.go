@@ -0,0 +1,629 @@
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/moweilong/milady/pkg/core"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/totp"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestAuthLifecycle(t *testing.T) {
+	engine, rds := newTestEngine(t)
+
+	user := createUser(t, engine, "e2euser")
+
+	// login
+	var login v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, &login); code != http.StatusOK {
+		t.Fatalf("login: got status %d", code)
+	}
+	if login.RefreshToken == "" || login.AccessToken == "" {
+		t.Fatalf("login: expected both tokens, got %+v", &login)
+	}
+
+	// Wrong credentials must not issue tokens.
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: "wrong123456"}, nil); code == http.StatusOK {
+		t.Fatalf("login with wrong password: got status %d", code)
+	}
+
+	// refresh
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", "", &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized {
+		t.Fatalf("refresh without token: got status %d, want %d", code, http.StatusUnauthorized)
+	}
+	var refreshed v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, &refreshed); code != http.StatusOK {
+		t.Fatalf("refresh: got status %d", code)
+	}
+	if refreshed.RefreshToken == "" || refreshed.AccessToken == "" {
+		t.Fatalf("refresh: expected both tokens, got %+v", &refreshed)
+	}
+	// The tokens are unique, even if they are refreshed within the second of the login.
+	for _, token := range []string{refreshed.AccessToken, refreshed.RefreshToken} {
+		if claims, err := auth.ParseUnverified(token); err != nil || claims.ID == "" || token == login.AccessToken || token == login.RefreshToken {
+			t.Fatalf("refreshed token is not unique: %v", err)
+		}
+	}
+	// Rotated tokens are accepted for a few seconds, in case of concurrent refreshes.
+	rds.FastForward(time.Minute)
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "RefreshTokenReused" {
+		t.Fatalf("refresh with a rotated token: got status %d reason %q", code, reason)
+	}
+	// Reusing a rotated token revokes the whole session, the refreshed tokens included.
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", refreshed.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("refresh after reuse: got status %d reason %q", code, reason)
+	}
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, &refreshed); code != http.StatusOK {
+		t.Fatalf("login after reuse: got status %d", code)
+	}
+
+	// Each endpoint only accepts its own kind of token.
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", refreshed.AccessToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "WrongTokenType" {
+		t.Fatalf("refresh with an access token: got status %d reason %q", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/auth/logout", refreshed.RefreshToken, &v1.LogoutRequest{RefreshToken: refreshed.RefreshToken}, nil); code != http.StatusUnauthorized || reason != "WrongTokenType" {
+		t.Fatalf("logout with a refresh token: got status %d reason %q", code, reason)
+	}
+
+	// logout
+	if code, _ := do(t, engine, "/v1/auth/logout", refreshed.AccessToken, &v1.LogoutRequest{RefreshToken: refreshed.RefreshToken}, nil); code != http.StatusOK {
+		t.Fatalf("logout: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", refreshed.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized {
+		t.Fatalf("refresh after logout: got status %d, want %d", code, http.StatusUnauthorized)
+	}
+}
+
+func TestJWKS(t *testing.T) {
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	der, err := x509.MarshalECPrivateKey(priv)
+	if err != nil {
+		t.Fatalf("marshal key: %v", err)
+	}
+	keyFile := filepath.Join(t.TempDir(), "jwt.pem")
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("write key: %v", err)
+	}
+
+	engine, _ := newTestEngine(t, func(c *Config) {
+		c.JWTOptions.SigningMethod = "ES256"
+		c.JWTOptions.KeyID = "e2e-es256"
+		c.JWTOptions.PrivateKeyFile = keyFile
+	})
+
+	user := createUser(t, engine, "jwksuser")
+	var login v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, &login); code != http.StatusOK {
+		t.Fatalf("login: got status %d", code)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/.well-known/jwks.json", nil))
+	var jwks v1.JWKSResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &jwks); err != nil || len(jwks.Keys) != 1 {
+		t.Fatalf("jwks: got status %d body %s", w.Code, w.Body.String())
+	}
+	jwk := jwks.Keys[0]
+	if jwk.Kid != "e2e-es256" || jwk.Kty != "EC" || jwk.Crv != "P-256" || jwk.Alg != "ES256" {
+		t.Fatalf("jwks: unexpected key %+v", jwk)
+	}
+
+	// Both tokens can be verified with the published key alone.
+	decode := func(s string) *big.Int {
+		b, _ := base64.RawURLEncoding.DecodeString(s)
+		return new(big.Int).SetBytes(b)
+	}
+	pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: decode(jwk.X), Y: decode(jwk.Y)}
+	for _, token := range []string{login.AccessToken, login.RefreshToken} {
+		parsed, err := jwt.ParseWithClaims(token, &auth.Claims{}, func(*jwt.Token) (any, error) { return pub, nil })
+		if err != nil || parsed.Header["kid"] != jwk.Kid {
+			t.Fatalf("verify token with jwks: %v", err)
+		}
+	}
+
+	// The server accepts its own asymmetric access tokens.
+	if code, _ := do(t, engine, "/v1/auth/logout", login.AccessToken, &v1.LogoutRequest{RefreshToken: login.RefreshToken}, nil); code != http.StatusOK {
+		t.Fatalf("logout: got status %d", code)
+	}
+}
+
+func TestJWTKeyRotation(t *testing.T) {
+	engine, _ := newTestEngine(t, func(c *Config) {
+		c.JWTOptions.Keys = []options.JWTKeyOptions{
+			{ID: "hs-2024", State: options.KeyStateActive, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2024"},
+			{ID: "hs-2025", State: options.KeyStateVerifyOnly, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2025"},
+			{ID: "hs-2023", State: options.KeyStateVerifyOnly, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2023", NotAfter: "2023-12-31T00:00:00Z"},
+		}
+	})
+
+	before := loginAdmin(t, engine)
+	kid := func(token string) any {
+		parsed, _, _ := new(jwt.Parser).ParseUnverified(token, &auth.Claims{})
+		return parsed.Header["kid"]
+	}
+	if got := kid(before.RefreshToken); got != "hs-2024" {
+		t.Fatalf("refresh token kid: got %v, want hs-2024", got)
+	}
+
+	// Promoting without a kid skips the retired key and wraps around to the next usable one.
+	var promoted v1.PromoteJWTKeyResponse
+	if code, reason := do(t, engine, "/v1/jwt-keys/promote", before.AccessToken, &v1.PromoteJWTKeyRequest{}, &promoted); code != http.StatusOK {
+		t.Fatalf("promote: got status %d (%s)", code, reason)
+	}
+	if promoted.Key.GetKid() != "hs-2025" || promoted.Key.GetState() != options.KeyStateActive {
+		t.Fatalf("promote: unexpected key %+v", promoted.Key)
+	}
+	if code, _ := do(t, engine, "/v1/jwt-keys/promote", before.AccessToken, &v1.PromoteJWTKeyRequest{Kid: "hs-2023"}, nil); code != http.StatusBadRequest {
+		t.Fatalf("promote retired key: got status %d", code)
+	}
+
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/jwt-keys", nil)
+	req.Header.Set("Authorization", "Bearer "+before.AccessToken)
+	engine.ServeHTTP(w, req)
+	var list v1.ListJWTKeyResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &list); err != nil || len(list.Keys) != 3 {
+		t.Fatalf("list keys: got status %d body %s", w.Code, w.Body.String())
+	}
+	states := map[string]string{}
+	for _, key := range list.Keys {
+		states[key.Kid] = key.State
+	}
+	if states["hs-2024"] != options.KeyStateVerifyOnly || states["hs-2025"] != options.KeyStateActive || states["hs-2023"] != auth.KeyStateRetired {
+		t.Fatalf("list keys: unexpected states %v", states)
+	}
+
+	// Tokens signed with the previous key still verify, new ones use the promoted key.
+	var after v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", before.RefreshToken, &v1.RefreshTokenRequest{}, &after); code != http.StatusOK {
+		t.Fatalf("refresh with previous key: got status %d", code)
+	}
+	if got := kid(after.RefreshToken); got != "hs-2025" {
+		t.Fatalf("refreshed token kid: got %v, want hs-2025", got)
+	}
+}
+
+func TestJWTKeyRetiredActive(t *testing.T) {
+	engine, rds := newTestEngine(t, func(c *Config) {
+		c.JWTOptions.Keys = []options.JWTKeyOptions{
+			{ID: "hs-2023", State: options.KeyStateActive, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2023", NotAfter: "2023-12-31T00:00:00Z"},
+			{ID: "hs-2024", State: options.KeyStateVerifyOnly, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2024"},
+			{ID: "hs-2025", State: options.KeyStateVerifyOnly, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2025"},
+		}
+	})
+	// A promotion left behind by a deployment with another configured active key is ignored.
+	rds.HSet("authn_active_key", "kid", "hs-2025", "configured", "hs-2022")
+
+	// The retired active key is skipped, tokens are signed with the next usable key.
+	login := loginAdmin(t, engine)
+	parsed, _, _ := new(jwt.Parser).ParseUnverified(login.RefreshToken, &auth.Claims{})
+	if got := parsed.Header["kid"]; got != "hs-2024" {
+		t.Fatalf("refresh token kid: got %v, want hs-2024", got)
+	}
+	var list v1.ListJWTKeyResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/jwt-keys", login.AccessToken, nil, &list); code != http.StatusOK {
+		t.Fatalf("list keys: got status %d", code)
+	}
+	states := map[string]string{}
+	for _, key := range list.Keys {
+		states[key.Kid] = key.State
+	}
+	if states["hs-2023"] != auth.KeyStateRetired || states["hs-2024"] != options.KeyStateActive || states["hs-2025"] != options.KeyStateVerifyOnly {
+		t.Fatalf("list keys: unexpected states %v", states)
+	}
+
+	// Without any usable key no token is issued.
+	retired, _ := newTestEngine(t, func(c *Config) {
+		c.JWTOptions.Keys = []options.JWTKeyOptions{
+			{ID: "hs-2023", State: options.KeyStateActive, SigningMethod: "HS512", Secret: "art-design-pro-go-e2e-key-2023", NotAfter: "2023-12-31T00:00:00Z"},
+		}
+	})
+	rq := &v1.LoginRequest{Username: "root", Password: "root123456"}
+	if code, reason := do(t, retired, "/v1/auth/login", "", rq, nil); code != http.StatusInternalServerError {
+		t.Fatalf("login without usable keys: got status %d (%s)", code, reason)
+	}
+}
+
+func TestLoginLockout(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	user := createUser(t, engine, "lockeduser")
+
+	// newTestEngine locks a user after 3 failures.
+	for i := 0; i < 3; i++ {
+		if code, reason := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: "wrong123456"}, nil); code == http.StatusOK || reason == v1.ErrorReason_UserLocked.String() {
+			t.Fatalf("failed login %d: got status %d (%s)", i, code, reason)
+		}
+	}
+	// The right password is refused as well once the account is locked.
+	if code, reason := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, nil); code != http.StatusForbidden || reason != v1.ErrorReason_UserLocked.String() {
+		t.Fatalf("login while locked: got status %d (%s)", code, reason)
+	}
+	body, _ := json.Marshal(&v1.LoginRequest{Username: user.Username, Password: user.Password})
+	rq := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+	rq.Header.Set("Accept-Language", "zh-CN,zh;q=0.9")
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, rq)
+	var resp core.ErrorResponse
+	if _ = json.Unmarshal(w.Body.Bytes(), &resp); resp.Message != "用户已锁定" {
+		t.Fatalf("localized lock message: got %s", w.Body.String())
+	}
+
+	// Only the administrator can unlock users.
+	var login v1.LoginReply
+	unlock := "/v1/users/" + user.UserID + "/unlock"
+	if code, _ := do(t, engine, unlock, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("unlock: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, &login); code != http.StatusOK {
+		t.Fatalf("login after unlock: got status %d", code)
+	}
+	if code, _ := do(t, engine, unlock, login.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("unlock by non-admin: got status %d", code)
+	}
+}
+
+func TestPermanentLockout(t *testing.T) {
+	engine, rds := newTestEngine(t, func(c *Config) {
+		c.LockoutOptions.Permanent = true
+	})
+
+	user := createUser(t, engine, "permanentuser")
+	status := func() string {
+		t.Helper()
+
+		var userM model.UserM
+		if err := store.S.DB(context.Background()).Where("userId = ?", user.UserID).First(&userM).Error; err != nil {
+			t.Fatalf("get user: %v", err)
+		}
+		return userM.Status
+	}
+	login := &v1.LoginRequest{Username: user.Username, Password: user.Password}
+
+	// A permanent lock is kept on the user, and outlives the lock in Redis.
+	for i := 0; i < 3; i++ {
+		do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: "wrong123456"}, nil)
+	}
+	if got := status(); got != known.UserStatusLocked {
+		t.Fatalf("status after lockout: got %q", got)
+	}
+	rds.FlushAll()
+	if code, reason := do(t, engine, "/v1/auth/login", "", login, nil); code != http.StatusForbidden || reason != v1.ErrorReason_UserLocked.String() {
+		t.Fatalf("login of a locked user: got status %d (%s)", code, reason)
+	}
+
+	// Unlocking clears the status.
+	admin := loginAdmin(t, engine)
+	if code, _ := do(t, engine, "/v1/users/"+user.UserID+"/unlock", admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("unlock: got status %d", code)
+	}
+	if got := status(); got != known.UserStatusActived {
+		t.Fatalf("status after unlock: got %q", got)
+	}
+	user.login(t, engine)
+
+	// Disabled users can not log in either.
+	if err := store.S.DB(context.Background()).Exec("UPDATE user SET status = ? WHERE userId = ?", known.UserStatusDisabled, user.UserID).Error; err != nil {
+		t.Fatalf("disable user: %v", err)
+	}
+	if code, reason := do(t, engine, "/v1/auth/login", "", login, nil); code != http.StatusForbidden || reason != v1.ErrorReason_UserDisabled.String() {
+		t.Fatalf("login of a disabled user: got status %d (%s)", code, reason)
+	}
+}
+
+func TestLoginCaptcha(t *testing.T) {
+	engine, rds := newTestEngine(t, func(c *Config) {
+		c.CaptchaOptions.EnableLogin = true
+		c.CaptchaOptions.LoginFailures = 1
+		c.CaptchaOptions.EnableRegister = true
+	})
+
+	getCaptcha := func() (string, string) {
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/auth/captcha", nil))
+		var resp v1.GetCaptchaResponse
+		if err := json.Unmarshal(w.Body.Bytes(), &resp); err != nil || resp.CaptchaID == "" || !strings.HasPrefix(resp.Image, "data:image/png;base64,") {
+			t.Fatalf("get captcha: got status %d body %.100s", w.Code, w.Body.String())
+		}
+		answer, err := rds.Get("captcha_" + resp.CaptchaID)
+		if err != nil {
+			t.Fatalf("captcha answer: %v", err)
+		}
+		return resp.CaptchaID, answer
+	}
+
+	user := newUserRequest("captchauser")
+	if code, reason := do(t, engine, "/v1/users", "", user, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidCaptcha.String() {
+		t.Fatalf("register without captcha: got status %d (%s)", code, reason)
+	}
+	user.CaptchaID, user.Captcha = getCaptcha()
+	if code, _ := do(t, engine, "/v1/users", "", user, nil); code != http.StatusOK {
+		t.Fatalf("register with captcha: got status %d", code)
+	}
+
+	// The captcha is only enforced after the first failure.
+	if code, reason := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: "wrong123456"}, nil); reason == v1.ErrorReason_InvalidCaptcha.String() {
+		t.Fatalf("first login: got status %d (%s)", code, reason)
+	}
+	login := &v1.LoginRequest{Username: user.Username, Password: user.Password}
+	if code, reason := do(t, engine, "/v1/auth/login", "", login, nil); reason != v1.ErrorReason_InvalidCaptcha.String() {
+		t.Fatalf("login without captcha: got status %d (%s)", code, reason)
+	}
+
+	// A captcha can only be used once.
+	id, answer := getCaptcha()
+	login.CaptchaID, login.Captcha = id, answer
+	if code, _ := do(t, engine, "/v1/auth/login", "", login, nil); code != http.StatusOK {
+		t.Fatalf("login with captcha: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: "wrong123456"}, nil); code == http.StatusOK {
+		t.Fatalf("failed login: got status %d (%s)", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/auth/login", "", login, nil); reason != v1.ErrorReason_InvalidCaptcha.String() {
+		t.Fatalf("reused captcha: got status %d (%s)", code, reason)
+	}
+}
+
+func TestLoginMFA(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	user := createUser(t, engine, "mfauser")
+	credentials := &v1.LoginRequest{Username: user.Username, Password: user.Password}
+	var login v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &login); code != http.StatusOK || login.MfaRequired {
+		t.Fatalf("login without mfa: got status %d (mfaRequired=%v)", code, login.MfaRequired)
+	}
+
+	var enrolled v1.EnrollMFAResponse
+	if code, _ := do(t, engine, "/v1/mfa/enroll", login.AccessToken, struct{}{}, &enrolled); code != http.StatusOK || !strings.HasPrefix(enrolled.Uri, "otpauth://totp/") {
+		t.Fatalf("enroll: got status %d (uri=%s)", code, enrolled.Uri)
+	}
+	step := totp.Step(time.Now())
+	code0, _ := totp.Code(enrolled.Secret, step)
+	code1, _ := totp.Code(enrolled.Secret, step+1)
+	if code, reason := do(t, engine, "/v1/mfa/confirm", login.AccessToken, &v1.ConfirmMFARequest{Code: "000000"}, nil); reason != v1.ErrorReason_InvalidMFACode.String() {
+		t.Fatalf("confirm with wrong code: got status %d (%s)", code, reason)
+	}
+	var confirmed v1.ConfirmMFAResponse
+	if code, _ := do(t, engine, "/v1/mfa/confirm", login.AccessToken, &v1.ConfirmMFARequest{Code: code0}, &confirmed); code != http.StatusOK || len(confirmed.RecoveryCodes) != 10 {
+		t.Fatalf("confirm: got status %d (%d recovery codes)", code, len(confirmed.RecoveryCodes))
+	}
+
+	// The password alone only yields a challenge token.
+	var challenge v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &challenge); code != http.StatusOK || !challenge.MfaRequired || challenge.AccessToken != "" {
+		t.Fatalf("login with mfa: got status %d (%+v)", code, &challenge)
+	}
+	if code, _ := do(t, engine, "/v1/auth/logout", challenge.MfaToken, &v1.LogoutRequest{RefreshToken: login.RefreshToken}, nil); code != http.StatusUnauthorized {
+		t.Fatalf("challenge token used as access token: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/mfa/verify", "", &v1.VerifyMFARequest{MfaToken: login.AccessToken, Code: code1}, nil); code != http.StatusUnauthorized {
+		t.Fatalf("access token used as challenge token: got status %d (%s)", code, reason)
+	}
+	// The code used by the confirmation can not be replayed.
+	if code, reason := do(t, engine, "/v1/auth/mfa/verify", "", &v1.VerifyMFARequest{MfaToken: challenge.MfaToken, Code: code0}, nil); reason != v1.ErrorReason_InvalidMFACode.String() {
+		t.Fatalf("replayed code: got status %d (%s)", code, reason)
+	}
+	var tokens v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/mfa/verify", "", &v1.VerifyMFARequest{MfaToken: challenge.MfaToken, Code: code1}, &tokens); code != http.StatusOK || tokens.AccessToken == "" {
+		t.Fatalf("verify: got status %d", code)
+	}
+
+	// Recovery codes can only be used once.
+	recovery := &v1.VerifyMFARequest{Code: strings.ToUpper(confirmed.RecoveryCodes[0])}
+	for i, want := range []int{http.StatusOK, http.StatusUnauthorized} {
+		if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &challenge); code != http.StatusOK {
+			t.Fatalf("login %d: got status %d", i, code)
+		}
+		recovery.MfaToken = challenge.MfaToken
+		if code, reason := do(t, engine, "/v1/auth/mfa/verify", "", recovery, nil); code != want {
+			t.Fatalf("recovery code %d: got status %d (%s)", i, code, reason)
+		}
+	}
+
+	// A code verified against a record, which a concurrent request has updated since, is rejected.
+	stale, err := store.S.UserMFA().Get(context.Background(), where.F("userID", user.UserID))
+	if err != nil {
+		t.Fatalf("get mfa: %v", err)
+	}
+	if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &challenge); code != http.StatusOK {
+		t.Fatalf("login: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/mfa/verify", "", &v1.VerifyMFARequest{MfaToken: challenge.MfaToken, Code: confirmed.RecoveryCodes[2]}, nil); code != http.StatusOK {
+		t.Fatalf("verify: got status %d", code)
+	}
+	if updated, err := store.S.UserMFA().UpdateUsed(context.Background(), stale, stale.LastUsedStep, stale.RecoveryCodes); err != nil || updated {
+		t.Fatalf("update from a stale record: got updated %v (%v)", updated, err)
+	}
+
+	// A user disabled after the password step gets no tokens.
+	if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &challenge); code != http.StatusOK {
+		t.Fatalf("login: got status %d", code)
+	}
+	setStatus := func(status string) {
+		t.Helper()
+		if err := store.S.DB(context.Background()).Exec("UPDATE user SET status = ? WHERE userId = ?", status, user.UserID).Error; err != nil {
+			t.Fatalf("set user status: %v", err)
+		}
+	}
+	setStatus(known.UserStatusDisabled)
+	if code, reason := do(t, engine, "/v1/auth/mfa/verify", "", &v1.VerifyMFARequest{MfaToken: challenge.MfaToken, Code: confirmed.RecoveryCodes[3]}, nil); code != http.StatusForbidden || reason != v1.ErrorReason_UserDisabled.String() {
+		t.Fatalf("verify of a disabled user: got status %d (%s)", code, reason)
+	}
+	setStatus(known.UserStatusActived)
+
+	if code, _ := do(t, engine, "/v1/mfa/disable", tokens.AccessToken, &v1.DisableMFARequest{Code: confirmed.RecoveryCodes[1]}, nil); code != http.StatusOK {
+		t.Fatalf("disable: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/login", "", credentials, &login); code != http.StatusOK || login.MfaRequired {
+		t.Fatalf("login after disable: got status %d (mfaRequired=%v)", code, login.MfaRequired)
+	}
+}
+
+func TestSignedRequest(t *testing.T) {
+	engine, _ := newTestEngine(t, func(c *Config) { c.SignatureOptions.MaxBodySize = 64 })
+
+	user := createUser(t, engine, "signeduser")
+	newSecret := func(name string, status int32, expires int64) *model.SecretM {
+		secret := &model.SecretM{UserID: user.UserID, Name: name, Expires: expires}
+		if err := store.S.Secret().Create(context.Background(), secret); err != nil {
+			t.Fatalf("create secret: %v", err)
+		}
+		// The status column defaults to enabled, so a disabled status must be set afterwards.
+		if err := store.S.DB(context.Background()).Model(secret).Update("status", status).Error; err != nil {
+			t.Fatalf("update secret: %v", err)
+		}
+		return secret
+	}
+	secret := newSecret("script", known.SecretStatusNormal, 0)
+
+	// send signs a request to an endpoint which only requires authentication, and
+	// lets mutate tamper with the signed request before it is sent.
+	send := func(secretID, secretKey string, mutate func(*http.Request)) (*http.Request, int, string) {
+		rq := httptest.NewRequest(http.MethodPost, "/v1/mfa/enroll?b=2&a=z&a=y", strings.NewReader("{}"))
+		rq.Header.Set("Content-Type", "application/json")
+		if err := auth.SignRequest(rq, secretID, secretKey); err != nil {
+			t.Fatalf("sign request: %v", err)
+		}
+		if mutate != nil {
+			mutate(rq)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, rq)
+		var resp core.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return rq, w.Code, resp.Reason
+	}
+	resend := func(signed *http.Request, body string) (int, string) {
+		rq := httptest.NewRequest(signed.Method, signed.URL.String(), strings.NewReader(body))
+		rq.Header = signed.Header.Clone()
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, rq)
+		var resp core.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp.Reason
+	}
+
+	signed, code, reason := send(secret.SecretID, secret.SecretKey, nil)
+	if code != http.StatusOK {
+		t.Fatalf("signed request: got status %d (%s)", code, reason)
+	}
+	if code, reason := resend(signed, "{}"); code != http.StatusUnauthorized || reason != "ReplayedRequest" {
+		t.Fatalf("replayed request: got status %d (%s)", code, reason)
+	}
+
+	tests := map[string]struct {
+		secretID, secretKey string
+		mutate              func(*http.Request)
+	}{
+		"wrong key":      {secret.SecretID, "wrong", nil},
+		"unknown secret": {"unknown", secret.SecretKey, nil},
+		"tampered query": {secret.SecretID, secret.SecretKey, func(rq *http.Request) { rq.URL.RawQuery = "a=x" }},
+		"tampered body": {secret.SecretID, secret.SecretKey, func(rq *http.Request) {
+			rq.Body = io.NopCloser(strings.NewReader(`{"tampered":true}`))
+		}},
+		"expired timestamp": {secret.SecretID, secret.SecretKey, func(rq *http.Request) {
+			old := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+			header := rq.Header.Get("Authorization")
+			start := strings.Index(header, "Timestamp=") + len("Timestamp=")
+			end := start + strings.Index(header[start:], ",")
+			rq.Header.Set("Authorization", header[:start]+old+header[end:])
+		}},
+		"malformed header": {secret.SecretID, secret.SecretKey, func(rq *http.Request) {
+			rq.Header.Set("Authorization", auth.SignatureAlgorithm+" Credential="+secret.SecretID)
+		}},
+	}
+	for name, tt := range tests {
+		if _, code, reason := send(tt.secretID, tt.secretKey, tt.mutate); code != http.StatusUnauthorized {
+			t.Errorf("%s: got status %d (%s)", name, code, reason)
+		}
+	}
+
+	disabled := newSecret("disabled", known.SecretStatusDisabled, 0)
+	if _, code, reason := send(disabled.SecretID, disabled.SecretKey, nil); code != http.StatusUnauthorized {
+		t.Errorf("disabled secret: got status %d (%s)", code, reason)
+	}
+	expired := newSecret("expired", known.SecretStatusNormal, time.Now().Add(-time.Minute).Unix())
+	if _, code, reason := send(expired.SecretID, expired.SecretKey, nil); code != http.StatusUnauthorized {
+		t.Errorf("expired secret: got status %d (%s)", code, reason)
+	}
+
+	// The body is hashed in memory, a signed request with a body over the limit is refused before it is read in full.
+	large := httptest.NewRequest(http.MethodPost, "/v1/mfa/enroll", strings.NewReader(`{"padding":"`+strings.Repeat("x", 64)+`"}`))
+	large.Header.Set("Content-Type", "application/json")
+	if err := auth.SignRequest(large, secret.SecretID, secret.SecretKey); err != nil {
+		t.Fatalf("sign request: %v", err)
+	}
+	if code, reason := resend(large, `{"padding":"`+strings.Repeat("x", 64)+`"}`); code != http.StatusRequestEntityTooLarge || reason != "InvalidArgument.RequestTooLarge" {
+		t.Errorf("large body: got status %d (%s)", code, reason)
+	}
+}
+
+func TestLoginLockoutClientIP(t *testing.T) {
+	failFrom := func(engine *gin.Engine, forwardedFor string) (int, string) {
+		body, _ := json.Marshal(&v1.LoginRequest{Username: "unknown_" + strings.ReplaceAll(forwardedFor, ".", "_"), Password: "wrong123456"})
+		rq := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+		rq.Header.Set("Content-Type", "application/json")
+		rq.Header.Set("X-Forwarded-For", forwardedFor)
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, rq)
+		var resp core.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp.Reason
+	}
+	lockIP := func(c *Config) {
+		c.LockoutOptions.MaxIPFailures = 2
+	}
+
+	// Without trusted proxies X-Forwarded-For is ignored, rotating it does not escape the per-IP limit.
+	engine, _ := newTestEngine(t, lockIP)
+	for _, ip := range []string{"198.51.100.1", "198.51.100.2"} {
+		failFrom(engine, ip)
+	}
+	if code, reason := failFrom(engine, "198.51.100.3"); code != http.StatusForbidden || reason != v1.ErrorReason_UserLocked.String() {
+		t.Fatalf("login with a rotated X-Forwarded-For: got status %d (%s)", code, reason)
+	}
+
+	// Behind a trusted proxy every forwarded client is counted on its own.
+	engine, _ = newTestEngine(t, lockIP, func(c *Config) {
+		// httptest requests come from 192.0.2.1.
+		c.TrustedProxies = []string{"192.0.2.0/24"}
+	})
+	for _, ip := range []string{"198.51.100.1", "198.51.100.2"} {
+		failFrom(engine, ip)
+	}
+	if code, reason := failFrom(engine, "198.51.100.3"); reason == v1.ErrorReason_UserLocked.String() {
+		t.Fatalf("login of another forwarded client: got status %d (%s)", code, reason)
+	}
+}
@@ -6,11 +6,26 @@ import (
 	"github.com/google/wire"
 	"github.com/moweilong/milady/pkg/authn"
 
+	auditv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/audit"
 	authv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/auth"
+	departmentv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/department"
+	menuv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/menu"
+	mfav1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/mfa"
+	policyv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/policy"
+	rolev1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/role"
 	secretv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/secret"
+	sessionv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
 	userv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/user"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/lockout"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdreset"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
 )
 
 // ProviderSet is a Wire provider set used to declare dependency injection rules.
@@ -27,6 +42,20 @@ type IBiz interface {
 	SecretV1() secretv1.SecretBiz
 	// AuthV1 returns the AuthBiz business interface.
 	AuthV1() authv1.AuthBiz
+	// MFAV1 returns the MFABiz business interface.
+	MFAV1() mfav1.MFABiz
+	// SessionV1 returns the SessionBiz business interface.
+	SessionV1() sessionv1.SessionBiz
+	// AuditV1 returns the AuditBiz business interface.
+	AuditV1() auditv1.AuditBiz
+	// RoleV1 returns the RoleBiz business interface.
+	RoleV1() rolev1.RoleBiz
+	// PolicyV1 returns the PolicyBiz business interface.
+	PolicyV1() policyv1.PolicyBiz
+	// MenuV1 returns the MenuBiz business interface.
+	MenuV1() menuv1.MenuBiz
+	// DepartmentV1 returns the DepartmentBiz business interface.
+	DepartmentV1() departmentv1.DepartmentBiz
 }
 
 // biz is a concrete implementation of IBiz.
@@ -34,27 +63,80 @@ type biz struct {
 	store store.IStore
 	authn authn.Authenticator
 	auth  auth.AuthProvider
+	// lockout protects the login from brute-force attacks.
+	lockout lockout.Lockout
+	// captcha protects the login and registration from bots.
+	captcha captcha.Captcha
+	// oidc runs the single sign-on against the identity providers.
+	oidc oidc.OIDC
+	// ldap checks the passwords of the directory users.
+	ldap ldap.LDAP
+	// sessions revokes the login sessions on all replicas.
+	sessions auth.SessionStore
+	// policy checks the new passwords and decides when passwords expire.
+	policy *pwdpolicy.Policy
+	// reset rate limits the password reset requests and keeps the reset tokens.
+	reset pwdreset.Reset
+	// registration keeps the email verification tokens of the registered users.
+	registration registration.Registration
+	// mailer sends the mails to the users.
+	mailer mail.Mailer
 }
 
 // Ensure that biz implements the IBiz.
 var _ IBiz = (*biz)(nil)
 
 // NewBiz creates an instance of IBiz.
-func NewBiz(store store.IStore, authn authn.Authenticator, auth auth.AuthProvider) *biz {
-	return &biz{store: store, authn: authn, auth: auth}
+func NewBiz(store store.IStore, authn authn.Authenticator, auth auth.AuthProvider, lockout lockout.Lockout, captcha captcha.Captcha, oidc oidc.OIDC, ldap ldap.LDAP, sessions auth.SessionStore, policy *pwdpolicy.Policy, reset pwdreset.Reset, registration registration.Registration, mailer mail.Mailer) *biz {
+	return &biz{store: store, authn: authn, auth: auth, lockout: lockout, captcha: captcha, oidc: oidc, ldap: ldap, sessions: sessions, policy: policy, reset: reset, registration: registration, mailer: mailer}
 }
 
 // UserV1 returns an instance that implements the UserBiz.
 func (b *biz) UserV1() userv1.UserBiz {
-	return userv1.New(b.store)
+	return userv1.New(b.store, b.auth, b.captcha, b.policy, b.registration, b.mailer, b.sessions)
 }
 
 // SecretV1 returns an instance that implements the SecretBiz.
 func (b *biz) SecretV1() secretv1.SecretBiz {
-	return secretv1.New(b.store)
+	return secretv1.New(b.store, b.auth)
 }
 
 // AuthV1 returns an instance that implements the AuthBiz.
 func (b *biz) AuthV1() authv1.AuthBiz {
-	return authv1.New(b.store, b.authn, b.auth)
+	return authv1.New(b.store, b.authn, b.auth, b.lockout, b.captcha, b.oidc, b.ldap, b.sessions, b.policy, b.reset, b.registration, b.mailer)
+}
+
+// MFAV1 returns an instance that implements the MFABiz.
+func (b *biz) MFAV1() mfav1.MFABiz {
+	return mfav1.New(b.store)
+}
+
+// SessionV1 returns an instance that implements the SessionBiz.
+func (b *biz) SessionV1() sessionv1.SessionBiz {
+	return sessionv1.New(b.store, b.sessions)
+}
+
+// AuditV1 returns an instance that implements the AuditBiz.
+func (b *biz) AuditV1() auditv1.AuditBiz {
+	return auditv1.New(b.store)
+}
+
+// RoleV1 returns an instance that implements the RoleBiz.
+func (b *biz) RoleV1() rolev1.RoleBiz {
+	return rolev1.New(b.store, b.auth)
+}
+
+// PolicyV1 returns an instance that implements the PolicyBiz.
+func (b *biz) PolicyV1() policyv1.PolicyBiz {
+	return policyv1.New(b.auth)
+}
+
+// MenuV1 returns an instance that implements the MenuBiz.
+func (b *biz) MenuV1() menuv1.MenuBiz {
+	return menuv1.New(b.store, b.auth)
+}
+
+// DepartmentV1 returns an instance that implements the DepartmentBiz.
+func (b *biz) DepartmentV1() departmentv1.DepartmentBiz {
+	return departmentv1.New(b.store)
 }
@@ -0,0 +1,64 @@
+package audit
+
+//go:generate mockgen -destination mock_audit.go -package audit github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/audit AuditBiz
+
+import (
+	"context"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// AuditBiz defines the interface that contains methods for reading the audit trail.
+// The requests carry a userID only on the administrator routes, the logged-in
+// user is used otherwise.
+type AuditBiz interface {
+	// List returns the audit trail of a user, newest first.
+	List(ctx context.Context, rq *v1.ListAuditLogRequest) (*v1.ListAuditLogResponse, error)
+
+	// AuditExpansion defines additional methods for extended audit operations, if needed.
+	AuditExpansion
+}
+
+// AuditExpansion defines additional methods for audit operations.
+type AuditExpansion interface{}
+
+// auditBiz is the implementation of the AuditBiz.
+type auditBiz struct {
+	store store.IStore
+}
+
+// Ensure that *auditBiz implements the AuditBiz.
+var _ AuditBiz = (*auditBiz)(nil)
+
+// New creates and returns a new instance of *auditBiz.
+func New(store store.IStore) *auditBiz {
+	return &auditBiz{store: store}
+}
+
+// List implements the List method of the AuditBiz.
+func (b *auditBiz) List(ctx context.Context, rq *v1.ListAuditLogRequest) (*v1.ListAuditLogResponse, error) {
+	userID := rq.GetUserID()
+	if userID == "" {
+		userID = contextx.UserID(ctx)
+	}
+
+	whr := where.O(int(rq.GetOffset())).L(int(rq.GetLimit())).F("userID", userID)
+	count, auditLogList, err := b.store.AuditLog().List(ctx, whr)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list audit logs from storage")
+		return nil, err
+	}
+
+	auditLogs := make([]*v1.AuditLog, 0, len(auditLogList))
+	for _, auditLogM := range auditLogList {
+		auditLogs = append(auditLogs, conversion.AuditLogMToAuditLogV1(auditLogM))
+	}
+
+	return &v1.ListAuditLogResponse{Total: count, AuditLogs: auditLogs}, nil
+}
@@ -4,16 +4,35 @@ package auth
 
 import (
 	"context"
+	"errors"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/moweilong/milady/pkg/authn"
+	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
 	"github.com/moweilong/milady/pkg/i18n"
 	"github.com/moweilong/milady/pkg/log"
 	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
 
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/mfa"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/user"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/lockout"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdreset"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
 	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
 )
 
@@ -28,6 +47,24 @@ type AuthBiz interface {
 	// Login authenticates a user and returns a token.
 	Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginReply, error)
 
+	// VerifyMFA exchanges an MFA challenge token and a code for tokens.
+	VerifyMFA(ctx context.Context, rq *v1.VerifyMFARequest) (*v1.LoginReply, error)
+
+	// ChangeExpiredPassword replaces an expired password and completes the login.
+	ChangeExpiredPassword(ctx context.Context, rq *v1.ChangeExpiredPasswordRequest) (*v1.LoginReply, error)
+
+	// ForgotPassword mails a password reset link to the user.
+	ForgotPassword(ctx context.Context, rq *v1.ForgotPasswordRequest) (*v1.ForgotPasswordResponse, error)
+
+	// ResetPassword sets a new password with a password reset token.
+	ResetPassword(ctx context.Context, rq *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error)
+
+	// VerifyEmail activates a registered user with an email verification token.
+	VerifyEmail(ctx context.Context, rq *v1.VerifyEmailRequest) (*v1.VerifyEmailResponse, error)
+
+	// ResendVerification mails a new email verification link to a registered user.
+	ResendVerification(ctx context.Context, rq *v1.ResendVerificationRequest) (*v1.ResendVerificationResponse, error)
+
 	// Logout invalidates a token.
 	Logout(ctx context.Context, rq *v1.LogoutRequest) (*v1.LogoutResponse, error)
 
@@ -40,6 +77,33 @@ type AuthBiz interface {
 	// Authorize checks if a user has the necessary permissions to perform an action on an object.
 	Authorize(ctx context.Context, sub, obj, act string) (*v1.AuthorizeResponse, error)
 
+	// JWKS returns the public keys which can be used to verify tokens.
+	JWKS(ctx context.Context, rq *v1.JWKSRequest) (*v1.JWKSResponse, error)
+
+	// ListJWTKey returns the configured signing keys and their states.
+	ListJWTKey(ctx context.Context, rq *v1.ListJWTKeyRequest) (*v1.ListJWTKeyResponse, error)
+
+	// PromoteJWTKey makes a signing key the active one.
+	PromoteJWTKey(ctx context.Context, rq *v1.PromoteJWTKeyRequest) (*v1.PromoteJWTKeyResponse, error)
+
+	// GetCaptcha generates a new image captcha.
+	GetCaptcha(ctx context.Context, rq *v1.GetCaptchaRequest) (*v1.GetCaptchaResponse, error)
+
+	// ListOIDCProvider returns the identity providers users can log in with.
+	ListOIDCProvider(ctx context.Context, rq *v1.ListOIDCProviderRequest) (*v1.ListOIDCProviderResponse, error)
+
+	// OIDCLogin starts a single sign-on and returns the authorization URL of the identity provider.
+	OIDCLogin(ctx context.Context, rq *v1.OIDCLoginRequest) (*v1.OIDCLoginResponse, error)
+
+	// OIDCCallback completes a single sign-on and returns a token.
+	OIDCCallback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.LoginReply, error)
+
+	// UnlockUser unlocks a user locked by too many failed logins.
+	UnlockUser(ctx context.Context, rq *v1.UnlockUserRequest) (*v1.UnlockUserResponse, error)
+
+	// ImpersonateUser issues a short-lived access token to act as another user.
+	ImpersonateUser(ctx context.Context, rq *v1.ImpersonateUserRequest) (*v1.ImpersonateUserResponse, error)
+
 	// AuthExpansion defines additional methods for extended auth operations, if needed.
 	AuthExpansion
 }
@@ -52,96 +116,383 @@ type authBiz struct {
 	store store.IStore
 	authn authn.Authenticator
 	auth  auth.AuthProvider
+	// lockout counts failed logins and locks the attacked accounts.
+	lockout lockout.Lockout
+	// captcha is enforced on login after too many failures.
+	captcha captcha.Captcha
+	// oidc authenticates users at the identity providers.
+	oidc oidc.OIDC
+	// sessions revokes the login sessions on logout.
+	sessions auth.SessionStore
+	// policy decides when passwords expire and rejects the reuse of recent passwords.
+	policy *pwdpolicy.Policy
+	// reset rate limits the password reset requests and keeps the reset tokens.
+	reset pwdreset.Reset
+	// registration keeps the email verification tokens of the registered users.
+	registration registration.Registration
+	// mailer sends the password reset and email verification links.
+	mailer mail.Mailer
+	// verifiers check the login passwords in order, see verifyCredentials.
+	verifiers []CredentialVerifier
 }
 
 // Ensure that *authBiz implements the AuthBiz.
 var _ AuthBiz = (*authBiz)(nil)
 
 // New creates and returns a new instance of *authBiz.
-func New(store store.IStore, authn authn.Authenticator, auth auth.AuthProvider) *authBiz {
-	return &authBiz{store: store, authn: authn, auth: auth}
+func New(store store.IStore, authn authn.Authenticator, auth auth.AuthProvider, lockout lockout.Lockout, captcha captcha.Captcha, oidc oidc.OIDC, ldap ldap.LDAP, sessions auth.SessionStore, policy *pwdpolicy.Policy, reset pwdreset.Reset, registration registration.Registration, mailer mail.Mailer) *authBiz {
+	b := &authBiz{store: store, authn: authn, auth: auth, lockout: lockout, captcha: captcha, oidc: oidc, sessions: sessions, policy: policy, reset: reset, registration: registration, mailer: mailer}
+	// Directory users take precedence over local users of the same name.
+	if ldap.Enabled() {
+		b.verifiers = append(b.verifiers, &ldapVerifier{ldap: ldap, auth: auth, biz: b})
+	}
+	b.verifiers = append(b.verifiers, &localVerifier{store: store, policy: policy})
+
+	return b
 }
 
 // Login authenticates a user and returns a token.
 func (b *authBiz) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginReply, error) {
-	// Retrieve user information from the data storage by username.
-	userM, err := b.store.User().Get(ctx, where.F("username", rq.Username))
+	// Refuse locked usernames and client IPs before checking the password,
+	// so that a locked account can not be used to probe passwords.
+	clientIP := contextx.ClientIP(ctx)
+	locked, err := b.lockout.Locked(ctx, rq.Username, clientIP)
 	if err != nil {
-		log.W(ctx).Errorw(err, "Failed to retrieve user by username")
-		return nil, i18n.FromContext(ctx).E(locales.RecordNotFound)
+		log.W(ctx).Errorw(err, "Failed to check login lockout")
+		return nil, err
+	}
+	if locked {
+		return nil, v1.ErrorUserLocked("%s", i18n.FromContext(ctx).T(locales.UserLocked))
+	}
+
+	if err := b.verifyLoginCaptcha(ctx, rq, clientIP); err != nil {
+		return nil, err
 	}
 
-	if err = authn.Compare(userM.Password, rq.Password); err != nil {
+	userM, err := b.verifyCredentials(ctx, rq.Username, rq.Password)
+	switch {
+	case errors.Is(err, ErrUnknownUser):
+		log.W(ctx).Errorw(err, "Failed to retrieve user by username")
+		// Unknown usernames count as failures too, otherwise they could be probed without limit.
+		b.loginFailed(ctx, rq.Username, clientIP)
+		return nil, i18n.FromContext(ctx).E(locales.RecordNotFound)
+	case errors.Is(err, ErrInvalidPassword):
 		log.W(ctx).Errorw(err, "Password does not match")
+		b.loginFailed(ctx, rq.Username, clientIP)
 		return nil, i18n.FromContext(ctx).E(locales.IncorrectPassword)
+	case err != nil && !errors.Is(err, ErrPasswordExpired):
+		return nil, err
+	}
+
+	if err := b.lockout.Succeed(ctx, rq.Username); err != nil {
+		log.W(ctx).Errorw(err, "Failed to clear login failures")
+	}
+
+	if err := checkStatus(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	// Users with an expired password only get a challenge token, which is
+	// exchanged for the real tokens by ChangeExpiredPassword.
+	if errors.Is(err, ErrPasswordExpired) {
+		passwordToken, err := b.auth.SignPasswordChallenge(userM.UserID)
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to generate password change token")
+			return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		}
+
+		return &v1.LoginReply{PasswordExpired: true, PasswordToken: passwordToken}, nil
+	}
+
+	return b.completeLogin(ctx, userM)
+}
+
+// checkStatus refuses users whose status does not allow them to log in.
+func checkStatus(ctx context.Context, userM *model.UserM) error {
+	switch userM.Status {
+	// Registered users have to verify their email before they can log in.
+	case known.UserStatusRegistered:
+		return v1.ErrorUserNotActivated("%s", i18n.FromContext(ctx).T(locales.UserNotActivated))
+	// Users locked permanently stay locked until an administrator unlocks them,
+	// even if the lock in Redis is gone.
+	case known.UserStatusLocked:
+		return v1.ErrorUserLocked("%s", i18n.FromContext(ctx).T(locales.UserLocked))
+	case known.UserStatusDisabled, known.UserStatusBlacklisted, known.UserStatusDeleted:
+		return v1.ErrorUserDisabled("%s", i18n.FromContext(ctx).T(locales.UserDisabled))
+	default:
+		return nil
+	}
+}
+
+// completeLogin issues the tokens of a user whose password was verified, or
+// an MFA challenge token if the user has MFA enabled.
+func (b *authBiz) completeLogin(ctx context.Context, userM *model.UserM) (*v1.LoginReply, error) {
+	// Users with MFA enabled only get a challenge token, which is exchanged
+	// for the real tokens by VerifyMFA.
+	mfaM, err := b.store.UserMFA().Get(ctx, where.F("userID", userM.UserID))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.W(ctx).Errorw(err, "Failed to retrieve mfa of user")
+		return nil, err
+	}
+	if mfa.Enabled(mfaM) {
+		mfaToken, err := b.auth.SignMFAChallenge(userM.UserID)
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to generate mfa challenge token")
+			return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		}
+
+		return &v1.LoginReply{MfaRequired: true, MfaToken: mfaToken}, nil
 	}
 
-	refreshToken, err := b.authn.Sign(ctx, userM.UserID)
+	return b.issueTokens(ctx, userM.UserID)
+}
+
+// verifyCredentials asks the verifiers in order until one knows the username.
+// A verifier which fails, for example because the directory is down, is
+// skipped, so that local users can still log in.
+func (b *authBiz) verifyCredentials(ctx context.Context, username, password string) (*model.UserM, error) {
+	lastErr := ErrUnknownUser
+	for _, verifier := range b.verifiers {
+		userM, err := verifier.Verify(ctx, username, password)
+		if err == nil || errors.Is(err, ErrInvalidPassword) || errors.Is(err, ErrPasswordExpired) {
+			return userM, err
+		}
+		if !errors.Is(err, ErrUnknownUser) {
+			log.W(ctx).Errorw(err, "Failed to verify credentials", "username", username)
+			lastErr = err
+		}
+	}
+
+	return nil, lastErr
+}
+
+// VerifyMFA exchanges a challenge token and a valid TOTP or recovery code for tokens.
+func (b *authBiz) VerifyMFA(ctx context.Context, rq *v1.VerifyMFARequest) (*v1.LoginReply, error) {
+	userID, err := b.auth.VerifyMFAChallenge(rq.GetMfaToken())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to verify mfa challenge token")
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("%s", err.Error())
+		}
+		return nil, err
+	}
+
+	// Wrong codes count as failed logins, so that codes can not be brute-forced
+	// within the lifetime of the challenge token.
+	clientIP := contextx.ClientIP(ctx)
+	locked, err := b.lockout.Locked(ctx, userM.Username, clientIP)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to check login lockout")
+		return nil, err
+	}
+	if locked {
+		return nil, v1.ErrorUserLocked("%s", i18n.FromContext(ctx).T(locales.UserLocked))
+	}
+
+	// The user may have been locked or disabled since the password was checked.
+	if err := checkStatus(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	mfaM, err := b.store.UserMFA().Get(ctx, where.F("userID", userID))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.W(ctx).Errorw(err, "Failed to retrieve mfa of user")
+		return nil, err
+	}
+	if !mfa.Enabled(mfaM) {
+		return nil, v1.ErrorMFANotEnabled("%s", i18n.FromContext(ctx).T(locales.MFANotEnabled))
+	}
+
+	lastUsedStep, recoveryCodes := mfaM.LastUsedStep, mfaM.RecoveryCodes
+	if !mfa.VerifyCode(mfaM, rq.GetCode()) {
+		b.loginFailed(ctx, userM.Username, clientIP)
+		return nil, v1.ErrorInvalidMFACode("%s", i18n.FromContext(ctx).T(locales.InvalidMFACode))
+	}
+
+	// Save the used time step or recovery code before issuing tokens. The record
+	// is only updated if no concurrent request used a code in the meantime, so
+	// that a code can not be replayed.
+	updated, err := b.store.UserMFA().UpdateUsed(ctx, mfaM, lastUsedStep, recoveryCodes)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to update mfa of user")
+		return nil, err
+	}
+	if !updated {
+		return nil, v1.ErrorInvalidMFACode("%s", i18n.FromContext(ctx).T(locales.InvalidMFACode))
+	}
+
+	return b.issueTokens(ctx, userID)
+}
+
+// ChangeExpiredPassword exchanges a password change token and a new password
+// for tokens, or for an MFA challenge token if the user has MFA enabled.
+func (b *authBiz) ChangeExpiredPassword(ctx context.Context, rq *v1.ChangeExpiredPasswordRequest) (*v1.LoginReply, error) {
+	userID, err := b.auth.VerifyPasswordChallenge(rq.GetPasswordToken())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to verify password change token")
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("%s", err.Error())
+		}
+		return nil, err
+	}
+
+	// Once the password has been changed the token can not be used again.
+	if !b.policy.Expired(userM.PasswordChangedAt) {
+		return nil, jwtauthn.ErrTokenInvalid
+	}
+
+	if err := user.ChangePassword(ctx, b.store, b.policy, userM, rq.GetNewPassword()); err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Expired password changed", "userID", userID)
+
+	return b.completeLogin(ctx, userM)
+}
+
+// issueTokens starts a new login session of userID and signs its refresh and access tokens.
+func (b *authBiz) issueTokens(ctx context.Context, userID string) (*v1.LoginReply, error) {
+	sessionID := uuid.New().String()
+	ctx = contextx.WithSessionID(ctx, sessionID)
+
+	reply, expiresAt, err := b.signTokens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.store.UserSession().Create(ctx, session.NewUserSessionM(ctx, userID, sessionID, expiresAt)); err != nil {
+		log.W(ctx).Errorw(err, "Failed to create session")
+		return nil, err
+	}
+
+	return reply, nil
+}
+
+// signTokens signs a new pair of refresh and access tokens for userID, both
+// bound to the session in ctx. It also returns when the refresh token expires.
+func (b *authBiz) signTokens(ctx context.Context, userID string) (*v1.LoginReply, time.Time, error) {
+	refreshToken, err := b.authn.Sign(ctx, userID)
 	if err != nil {
 		log.W(ctx).Errorw(err, "Failed to generate refresh token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		return nil, time.Time{}, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
 	}
 
 	// Generate an access token for resource access.
-	accessToken, err := b.auth.Sign(ctx, userM.UserID)
+	accessToken, err := b.auth.Sign(ctx, userID)
 	if err != nil {
 		log.W(ctx).Errorw(err, "Failed to generate access token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		return nil, time.Time{}, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
 	}
 
-	// Return
 	return &v1.LoginReply{
 		RefreshToken: refreshToken.GetToken(),
 		AccessToken:  accessToken.GetToken(),
 		Type:         accessToken.GetTokenType(),
 		ExpiresAt:    accessToken.GetExpiresAt(),
-	}, nil
+	}, time.Unix(refreshToken.GetExpiresAt(), 0), nil
 }
 
 // Logout invalidates a token.
 func (b *authBiz) Logout(ctx context.Context, rq *v1.LogoutRequest) (*v1.LogoutResponse, error) {
-	if err := b.authn.Destroy(ctx, contextx.AccessToken(ctx)); err != nil {
+	claims, err := b.authn.ParseClaims(ctx, rq.RefreshToken)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to parse refresh token")
+		return nil, err
+	}
+
+	// Users can only revoke their own refresh tokens.
+	if claims.Subject != contextx.UserID(ctx) {
+		return nil, errno.ErrPermissionDenied.WithMessage("The refresh token does not belong to the logged-in user `%s`", contextx.UserID(ctx))
+	}
+
+	if err := b.authn.Destroy(ctx, rq.RefreshToken); err != nil {
 		log.W(ctx).Errorw(err, "Failed to remove token from cache")
 		return nil, err
 	}
+
+	// Logging out ends the session, so that its access tokens stop working too.
+	if refreshClaims, err := auth.ParseUnverified(rq.RefreshToken); err == nil && refreshClaims.SessionID != "" {
+		sessionM := &model.UserSessionM{SessionID: refreshClaims.SessionID, ExpiresAt: claims.ExpiresAt.Time}
+		if err := session.Revoke(ctx, b.store, b.sessions, sessionM); err != nil {
+			log.W(ctx).Errorw(err, "Failed to revoke session", "sessionID", sessionM.SessionID)
+			return nil, err
+		}
+	}
+
 	return &v1.LogoutResponse{}, nil
 }
 
 // RefreshToken refreshes an existing token and returns a new one.
 func (b *authBiz) RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.LoginReply, error) {
-	// Because a new token is issued, the old token needs to be destroyed.
-	_ = b.authn.Destroy(ctx, contextx.AccessToken(ctx))
-
 	userID := contextx.UserID(ctx)
-	refreshToken, err := b.authn.Sign(ctx, userID)
+
+	// Refresh tokens issued before sessions were introduced start a new session.
+	sessionID := contextx.SessionID(ctx)
+	if sessionID == "" {
+		reply, err := b.issueTokens(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		_ = b.authn.Destroy(ctx, contextx.RefreshToken(ctx))
+		return reply, nil
+	}
+
+	// A missing session has been revoked or has expired, revocation is terminal
+	// so the session is never created again.
+	sessionM, err := b.store.UserSession().Get(ctx, where.F("sessionID", sessionID))
 	if err != nil {
-		log.W(ctx).Errorw(err, "Failed to generate refresh token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			_ = b.authn.Destroy(ctx, contextx.RefreshToken(ctx))
+			return nil, v1.ErrorSessionRevoked("session %s has been revoked", sessionID)
+		}
+		log.W(ctx).Errorw(err, "Failed to retrieve session", "sessionID", sessionID)
+		return nil, err
 	}
 
-	accessToken, err := b.auth.Sign(ctx, userID)
+	reply, expiresAt, err := b.signTokens(ctx, userID)
 	if err != nil {
-		log.W(ctx).Errorw(err, "Failed to generate access token")
-		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+		return nil, err
 	}
 
-	return &v1.LoginReply{
-		RefreshToken: refreshToken.GetToken(),
-		AccessToken:  accessToken.GetToken(),
-		Type:         accessToken.GetTokenType(),
-		ExpiresAt:    accessToken.GetExpiresAt(),
-	}, nil
+	// Because a new token is issued, the old token needs to be destroyed.
+	_ = b.authn.Destroy(ctx, contextx.RefreshToken(ctx))
+
+	// The session lives as long as its latest refresh token.
+	sessionM.LastSeenAt = time.Now()
+	sessionM.ExpiresAt = expiresAt
+	if err := b.store.UserSession().Update(ctx, sessionM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to update session", "sessionID", sessionID)
+		return nil, err
+	}
+
+	return reply, nil
 }
 
 // Authenticate validates an access token and returns the associated user ID.
 func (b *authBiz) Authenticate(ctx context.Context, accessToken string) (*v1.AuthenticateResponse, error) {
-	userID, err := b.auth.Verify(accessToken)
+	userID, err := b.auth.Verify(ctx, accessToken)
 	if err != nil {
 		log.W(ctx).Errorw(err, "Failed to verify access token")
 		return nil, err
 	}
 
-	return &v1.AuthenticateResponse{UserID: userID}, nil
+	resp := &v1.AuthenticateResponse{UserID: userID}
+	// The token has been verified, so its claims can be trusted.
+	if claims, err := auth.ParseUnverified(accessToken); err == nil {
+		resp.SessionID = claims.SessionID
+		resp.ActorID = claims.ActorID()
+	}
+	return resp, nil
 }
 
 // Authorize checks if a user has the necessary permissions to perform an action on an object.
@@ -153,3 +504,118 @@ func (b *authBiz) Authorize(ctx context.Context, sub, obj, act string) (*v1.Auth
 	}
 	return &v1.AuthorizeResponse{Allowed: allowed}, nil
 }
+
+// JWKS returns the public keys which can be used to verify tokens.
+func (b *authBiz) JWKS(ctx context.Context, rq *v1.JWKSRequest) (*v1.JWKSResponse, error) {
+	return &v1.JWKSResponse{Keys: b.auth.JWKS()}, nil
+}
+
+// ListJWTKey returns the configured signing keys and their states.
+func (b *authBiz) ListJWTKey(ctx context.Context, rq *v1.ListJWTKeyRequest) (*v1.ListJWTKeyResponse, error) {
+	return &v1.ListJWTKeyResponse{Keys: b.auth.JWTKeys()}, nil
+}
+
+// PromoteJWTKey makes a signing key the active one. Tokens signed with the
+// previous active key remain valid until that key retires.
+func (b *authBiz) PromoteJWTKey(ctx context.Context, rq *v1.PromoteJWTKeyRequest) (*v1.PromoteJWTKeyResponse, error) {
+	key, err := b.auth.PromoteJWTKey(ctx, rq.GetKid())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to promote jwt key", "kid", rq.GetKid())
+		return nil, err
+	}
+
+	log.W(ctx).Infow("JWT key promoted", "kid", key.GetKid(), "operator", contextx.UserID(ctx))
+
+	return &v1.PromoteJWTKeyResponse{Key: key}, nil
+}
+
+// loginFailed records a failed login. Errors are only logged, the client
+// still gets the original login error.
+func (b *authBiz) loginFailed(ctx context.Context, username, clientIP string) {
+	permanent, err := b.lockout.Fail(ctx, username, clientIP)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to record login failure", "username", username, "clientIP", clientIP)
+		return
+	}
+	if !permanent {
+		return
+	}
+
+	// A permanent lock is kept on the user too, so that it outlives the lock in Redis.
+	userM, err := b.store.User().Get(ctx, where.F("username", username))
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.W(ctx).Errorw(err, "Failed to retrieve locked user", "username", username)
+		}
+		return
+	}
+	userM.Status = known.UserStatusLocked
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to lock user", "userID", userM.UserID)
+	}
+}
+
+// UnlockUser unlocks a user locked by too many failed logins.
+func (b *authBiz) UnlockUser(ctx context.Context, rq *v1.UnlockUserRequest) (*v1.UnlockUserResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("%s", err.Error())
+		}
+		return nil, err
+	}
+
+	if err := b.lockout.Unlock(ctx, userM.Username); err != nil {
+		log.W(ctx).Errorw(err, "Failed to unlock user", "userID", userM.UserID)
+		return nil, err
+	}
+	if userM.Status == known.UserStatusLocked {
+		userM.Status = known.UserStatusActived
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			log.W(ctx).Errorw(err, "Failed to unlock user", "userID", userM.UserID)
+			return nil, err
+		}
+	}
+
+	log.W(ctx).Infow("User unlocked", "userID", userM.UserID, "operator", contextx.UserID(ctx))
+
+	return &v1.UnlockUserResponse{}, nil
+}
+
+// verifyLoginCaptcha checks the captcha of the login request when it is enforced.
+func (b *authBiz) verifyLoginCaptcha(ctx context.Context, rq *v1.LoginRequest, clientIP string) error {
+	failures, err := b.lockout.Failures(ctx, rq.Username, clientIP)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to count login failures")
+		return err
+	}
+	if !b.captcha.LoginRequired(failures) {
+		return nil
+	}
+
+	ok, err := b.captcha.Verify(ctx, rq.CaptchaID, rq.Captcha)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to verify captcha")
+		return err
+	}
+	if !ok {
+		return v1.ErrorInvalidCaptcha("%s", i18n.FromContext(ctx).T(locales.InvalidCaptcha))
+	}
+
+	return nil
+}
+
+// GetCaptcha generates a new image captcha.
+func (b *authBiz) GetCaptcha(ctx context.Context, rq *v1.GetCaptchaRequest) (*v1.GetCaptchaResponse, error) {
+	id, image, err := b.captcha.Generate(ctx)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to generate captcha")
+		return nil, err
+	}
+
+	return &v1.GetCaptchaResponse{
+		CaptchaID: id,
+		Image:     image,
+		ExpiresIn: int64(b.captcha.Options().Expiration.Seconds()),
+	}, nil
+}
@@ -0,0 +1,78 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/moweilong/milady/pkg/i18n"
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ActionImpersonate is the audit action recorded when an administrator starts to act as a user.
+const ActionImpersonate = "impersonate"
+
+// ImpersonateUser issues a short-lived access token which lets the logged-in
+// administrator act as another user. The token starts a session of the user,
+// which the user can see and revoke, and is recorded in the audit trail of the user.
+func (b *authBiz) ImpersonateUser(ctx context.Context, rq *v1.ImpersonateUserRequest) (*v1.ImpersonateUserResponse, error) {
+	actorID := contextx.UserID(ctx)
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("user %s not found", rq.GetUserID())
+		}
+		return nil, err
+	}
+
+	sessionID := uuid.New().String()
+	ctx = contextx.WithSessionID(ctx, sessionID)
+
+	// There is no refresh token, so the session ends with the access token.
+	token, err := b.auth.SignImpersonation(ctx, userM.UserID, actorID)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to generate impersonation token")
+		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+	}
+
+	sessionM := session.NewUserSessionM(ctx, userM.UserID, sessionID, time.Unix(token.GetExpiresAt(), 0))
+	sessionM.ActorID = actorID
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.UserSession().Create(ctx, sessionM); err != nil {
+			return err
+		}
+
+		return b.store.AuditLog().Create(ctx, &model.AuditLogM{
+			UserID:    userM.UserID,
+			ActorID:   actorID,
+			Action:    ActionImpersonate,
+			Path:      "/v1/users/" + userM.UserID + "/impersonate",
+			IP:        contextx.ClientIP(ctx),
+			SessionID: sessionID,
+		})
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to start impersonation", "userID", userM.UserID)
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Impersonation started", "userID", userM.UserID, "actorID", actorID, "sessionID", sessionID, "expiresIn", known.ImpersonationTokenExpire)
+
+	return &v1.ImpersonateUserResponse{
+		AccessToken: token.GetToken(),
+		Type:        token.GetTokenType(),
+		ExpiresAt:   token.GetExpiresAt(),
+		SessionID:   sessionID,
+	}, nil
+}
@@ -0,0 +1,71 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ListOIDCProvider returns the identity providers users can log in with.
+func (b *authBiz) ListOIDCProvider(ctx context.Context, rq *v1.ListOIDCProviderRequest) (*v1.ListOIDCProviderResponse, error) {
+	providers := make([]*v1.OIDCProvider, 0, len(b.oidc.Providers()))
+	for _, p := range b.oidc.Providers() {
+		displayName := p.DisplayName
+		if displayName == "" {
+			displayName = p.Name
+		}
+		providers = append(providers, &v1.OIDCProvider{Name: p.Name, DisplayName: displayName})
+	}
+
+	return &v1.ListOIDCProviderResponse{Providers: providers}, nil
+}
+
+// OIDCLogin starts a single sign-on at the requested identity provider.
+func (b *authBiz) OIDCLogin(ctx context.Context, rq *v1.OIDCLoginRequest) (*v1.OIDCLoginResponse, error) {
+	authURL, err := b.oidc.AuthCodeURL(ctx, rq.GetProvider(), contextx.OIDCBinding(ctx))
+	if err != nil {
+		if errors.Is(err, oidc.ErrProviderNotFound) {
+			return nil, v1.ErrorOIDCProviderNotFound("oidc provider %s not found", rq.GetProvider())
+		}
+		log.W(ctx).Errorw(err, "Failed to start oidc login", "provider", rq.GetProvider())
+		return nil, err
+	}
+
+	return &v1.OIDCLoginResponse{AuthURL: authURL}, nil
+}
+
+// OIDCCallback completes a single sign-on. Users are provisioned on their
+// first login, and get the same tokens as with a password. The second factor
+// is left to the identity provider, so MFA is not asked again.
+func (b *authBiz) OIDCCallback(ctx context.Context, rq *v1.OIDCCallbackRequest) (*v1.LoginReply, error) {
+	identity, err := b.oidc.Exchange(ctx, rq.GetProvider(), rq.GetCode(), rq.GetState(), contextx.OIDCBinding(ctx))
+	if err != nil {
+		if errors.Is(err, oidc.ErrProviderNotFound) {
+			return nil, v1.ErrorOIDCProviderNotFound("oidc provider %s not found", rq.GetProvider())
+		}
+		log.W(ctx).Errorw(err, "Failed to complete oidc login", "provider", rq.GetProvider())
+		return nil, v1.ErrorOIDCLoginFailed("%s", err.Error())
+	}
+
+	userM, err := b.provision(ctx, &externalIdentity{
+		Provider: "oidc:" + identity.Provider,
+		Subject:  identity.Subject,
+		Username: identity.Username,
+		Nickname: identity.Name,
+		Email:    identity.Email,
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to provision oidc user", "provider", identity.Provider, "subject", identity.Subject)
+		return nil, err
+	}
+	if err := checkStatus(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return b.issueTokens(ctx, userM.UserID)
+}
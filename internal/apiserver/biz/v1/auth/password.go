@@ -0,0 +1,135 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/moweilong/milady/pkg/i18n"
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/user"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ForgotPassword mails a password reset link to the user. The reply is the
+// same whether the user exists or not, so that usernames can not be probed.
+func (b *authBiz) ForgotPassword(ctx context.Context, rq *v1.ForgotPasswordRequest) (*v1.ForgotPasswordResponse, error) {
+	// Unknown usernames are counted too, otherwise they could be probed without limit.
+	allowed, err := b.reset.Allow(ctx, rq.GetUsername(), contextx.ClientIP(ctx))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to check password reset rate limit")
+		return nil, err
+	}
+	if !allowed {
+		return nil, v1.ErrorPasswordResetRateLimited("%s", i18n.FromContext(ctx).T(locales.TooManyRequests))
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &v1.ForgotPasswordResponse{}, nil
+		}
+		return nil, err
+	}
+	if userM.Email == "" {
+		log.W(ctx).Infow("Password reset requested for a user without email", "userID", userM.UserID)
+		return &v1.ForgotPasswordResponse{}, nil
+	}
+
+	opts := b.reset.Options()
+	tokenID, err := b.reset.Issue(ctx, userM.UserID)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to issue password reset token")
+		return nil, err
+	}
+	token, err := b.auth.SignPasswordResetToken(userM.UserID, tokenID, opts.Expiration)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to sign password reset token")
+		return nil, i18n.FromContext(ctx).E(locales.JWTTokenSignFail)
+	}
+
+	translator := i18n.FromContext(ctx)
+	msg := &mail.Message{
+		To:      userM.Email,
+		Subject: translator.T(locales.PasswordResetSubject),
+		Body:    fmt.Sprintf(translator.T(locales.PasswordResetBody), userM.Nickname, mail.TokenLink(opts.URL, token), int(opts.Expiration.Minutes())),
+	}
+	if err := b.mailer.Send(ctx, msg); err != nil {
+		log.W(ctx).Errorw(err, "Failed to send password reset mail", "userID", userM.UserID)
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Password reset mail sent", "userID", userM.UserID)
+
+	return &v1.ForgotPasswordResponse{}, nil
+}
+
+// ResetPassword sets a new password with a mailed reset token, and signs the
+// user out everywhere.
+func (b *authBiz) ResetPassword(ctx context.Context, rq *v1.ResetPasswordRequest) (*v1.ResetPasswordResponse, error) {
+	invalid := v1.ErrorInvalidPasswordResetToken("%s", i18n.FromContext(ctx).T(locales.InvalidPasswordResetToken))
+
+	userID, tokenID, err := b.auth.VerifyPasswordResetToken(rq.GetToken())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to verify password reset token")
+		return nil, invalid
+	}
+
+	// Tokens which were used, or replaced by a newer one, are no longer current.
+	valid, err := b.reset.Valid(ctx, userID, tokenID)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to check password reset token")
+		return nil, err
+	}
+	if !valid {
+		return nil, invalid
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	// The token is consumed only once the password has been changed, so that a
+	// password rejected by the policy can be corrected with the same link. It is
+	// consumed atomically before the change is committed, so that of concurrent
+	// requests with the same token only one changes the password.
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := user.ChangePassword(ctx, b.store, b.policy, userM, rq.GetNewPassword()); err != nil {
+			return err
+		}
+
+		consumed, err := b.reset.Consume(ctx, userID, tokenID)
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to consume password reset token")
+			return err
+		}
+		if !consumed {
+			return invalid
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Whoever knew the old password must not stay logged in.
+	count, err := session.RevokeAll(ctx, b.store, b.sessions, userID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Password reset", "userID", userID, "revokedSessions", count)
+
+	return &v1.ResetPasswordResponse{}, nil
+}
@@ -0,0 +1,144 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"strings"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+)
+
+const (
+	// maxUsernameLength matches the longest username accepted on registration.
+	maxUsernameLength = 20
+	// maxUsernameAttempts bounds the search for a free username.
+	maxUsernameAttempts = 5
+)
+
+// invalidUsernameChars matches the characters not allowed in usernames.
+var invalidUsernameChars = regexp.MustCompile(`[^A-Za-z0-9_]+`)
+
+// externalIdentity is a user authenticated by an external identity provider.
+type externalIdentity struct {
+	// Provider and Subject identify the user at the identity provider.
+	Provider string
+	Subject  string
+	// Username, Nickname and Email are used when the local user is provisioned.
+	Username string
+	Nickname string
+	Email    string
+}
+
+// provision returns the local user linked to the external identity. On the
+// first login of the identity, a local user is created and linked to it.
+// Users are never linked by username or email, which may be reused by
+// another person at the identity provider.
+func (b *authBiz) provision(ctx context.Context, identity *externalIdentity) (*model.UserM, error) {
+	userM, err := b.linkedUser(ctx, identity)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return userM, err
+	}
+
+	username, err := b.freeUsername(ctx, identity)
+	if err != nil {
+		return nil, err
+	}
+	// Provisioned users log in through the identity provider, their password is never disclosed.
+	password := make([]byte, 24)
+	if _, err := rand.Read(password); err != nil {
+		return nil, err
+	}
+	nickname := identity.Nickname
+	if nickname == "" {
+		nickname = username
+	}
+
+	userM = &model.UserM{
+		Username: username,
+		Nickname: nickname,
+		Password: hex.EncodeToString(password),
+		Email:    identity.Email,
+	}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Create(ctx, userM); err != nil {
+			return err
+		}
+
+		return b.link(ctx, userM, identity)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The provisioned users get the same role as the users created through the API.
+	if _, err := b.auth.AddRoleForUser(userM.UserID, known.RoleUser); err != nil {
+		log.W(ctx).Errorw(err, "Failed to add role for user", "userID", userM.UserID, "role", known.RoleUser)
+		return nil, err
+	}
+
+	log.W(ctx).Infow("User provisioned", "userID", userM.UserID, "username", username, "provider", identity.Provider)
+
+	return userM, nil
+}
+
+// linkedUser returns the local user linked to the external identity, or
+// gorm.ErrRecordNotFound if the identity is not linked yet.
+func (b *authBiz) linkedUser(ctx context.Context, identity *externalIdentity) (*model.UserM, error) {
+	identityM, err := b.store.UserIdentity().Get(ctx, where.F("provider", identity.Provider, "subject", identity.Subject))
+	if err != nil {
+		return nil, err
+	}
+
+	return b.store.User().Get(ctx, where.F("userID", identityM.UserID))
+}
+
+// link links the external identity to an existing local user.
+func (b *authBiz) link(ctx context.Context, userM *model.UserM, identity *externalIdentity) error {
+	return b.store.UserIdentity().Create(ctx, &model.UserIdentityM{
+		UserID:   userM.UserID,
+		Provider: identity.Provider,
+		Subject:  identity.Subject,
+	})
+}
+
+// freeUsername derives a username which is not taken yet from the identity.
+func (b *authBiz) freeUsername(ctx context.Context, identity *externalIdentity) (string, error) {
+	base := identity.Username
+	if base == "" {
+		base, _, _ = strings.Cut(identity.Email, "@")
+	}
+	base = invalidUsernameChars.ReplaceAllString(base, "_")
+	if len(base) < 3 {
+		base = "user_" + base
+	}
+
+	candidate := base[:min(len(base), maxUsernameLength)]
+	for range maxUsernameAttempts {
+		_, err := b.store.User().Get(ctx, where.F("username", candidate))
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return candidate, nil
+		}
+		if err != nil {
+			return "", err
+		}
+
+		n, err := rand.Int(rand.Reader, big.NewInt(10000))
+		if err != nil {
+			return "", err
+		}
+		suffix := fmt.Sprintf("_%04d", n.Int64())
+		candidate = base[:min(len(base), maxUsernameLength-len(suffix))] + suffix
+	}
+
+	return "", fmt.Errorf("no free username for %q", base)
+}
@@ -0,0 +1,85 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"github.com/moweilong/milady/pkg/i18n"
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/user"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// VerifyEmail activates the registered user the mailed token belongs to.
+func (b *authBiz) VerifyEmail(ctx context.Context, rq *v1.VerifyEmailRequest) (*v1.VerifyEmailResponse, error) {
+	invalid := v1.ErrorInvalidEmailVerificationToken("%s", i18n.FromContext(ctx).T(locales.InvalidEmailVerificationToken))
+
+	// Expired tokens have been dropped by Redis, and resending replaces the previous token.
+	userID, err := b.registration.Consume(ctx, rq.GetToken())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to consume email verification token")
+		return nil, err
+	}
+	if userID == "" {
+		return nil, invalid
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, invalid
+		}
+		return nil, err
+	}
+
+	// Activating twice, for example by opening the link in two tabs, is harmless.
+	if userM.Status != known.UserStatusRegistered {
+		return &v1.VerifyEmailResponse{}, nil
+	}
+
+	userM.Status = known.UserStatusActived
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to activate user", "userID", userID)
+		return nil, err
+	}
+
+	log.W(ctx).Infow("User activated", "userID", userID)
+
+	return &v1.VerifyEmailResponse{}, nil
+}
+
+// ResendVerification mails a new verification link to a registered user. The
+// reply is the same for unknown and active users, so that usernames can not be probed.
+func (b *authBiz) ResendVerification(ctx context.Context, rq *v1.ResendVerificationRequest) (*v1.ResendVerificationResponse, error) {
+	// The cooldown applies to any username, otherwise it would tell which users are registered.
+	allowed, err := b.registration.AllowResend(ctx, rq.GetUsername())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to check email verification rate limit")
+		return nil, err
+	}
+	if !allowed {
+		return nil, v1.ErrorEmailVerificationRateLimited("%s", i18n.FromContext(ctx).T(locales.TooManyRequests))
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("username", rq.GetUsername()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &v1.ResendVerificationResponse{}, nil
+		}
+		return nil, err
+	}
+	if userM.Status != known.UserStatusRegistered {
+		return &v1.ResendVerificationResponse{}, nil
+	}
+
+	if err := user.SendVerification(ctx, b.registration, b.mailer, userM); err != nil {
+		return nil, err
+	}
+
+	return &v1.ResendVerificationResponse{}, nil
+}
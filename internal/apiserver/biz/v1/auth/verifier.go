@@ -0,0 +1,187 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+)
+
+var (
+	// ErrUnknownUser is returned by a CredentialVerifier which does not know the
+	// username, so that the next verifier is asked.
+	ErrUnknownUser = errors.New("unknown user")
+	// ErrInvalidPassword is returned by a CredentialVerifier which knows the
+	// username, but not with this password.
+	ErrInvalidPassword = errors.New("invalid password")
+	// ErrPasswordExpired is returned together with the user by a
+	// CredentialVerifier whose user has to change the password before logging in.
+	ErrPasswordExpired = errors.New("password expired")
+)
+
+// CredentialVerifier checks the username and password of a login.
+type CredentialVerifier interface {
+	// Verify returns the local user the credentials belong to.
+	Verify(ctx context.Context, username, password string) (*model.UserM, error)
+}
+
+// localVerifier checks the passwords against the hashes of the local users.
+type localVerifier struct {
+	store  store.IStore
+	policy *pwdpolicy.Policy
+}
+
+// Verify returns the local user named username if password matches.
+func (v *localVerifier) Verify(ctx context.Context, username, password string) (*model.UserM, error) {
+	userM, err := v.store.User().Get(ctx, where.F("username", username))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnknownUser
+		}
+		return nil, err
+	}
+
+	if err := pwdhash.Verify(userM.Password, password); err != nil {
+		if !errors.Is(err, pwdhash.ErrMismatch) {
+			log.W(ctx).Errorw(err, "Failed to verify password", "userID", userM.UserID)
+		}
+		return nil, ErrInvalidPassword
+	}
+
+	// The password is only known while logging in, which is the only chance to
+	// replace a hash created with an older algorithm or weaker parameters.
+	if pwdhash.NeedsRehash(userM.Password) {
+		v.rehash(ctx, userM, password)
+	}
+
+	if v.policy.Expired(userM.PasswordChangedAt) {
+		return userM, ErrPasswordExpired
+	}
+
+	return userM, nil
+}
+
+// rehash replaces the hash of the password of userM with one using the current
+// settings. The password is unchanged, so neither its history nor its age is touched.
+// Failures are only logged, the old hash keeps working.
+func (v *localVerifier) rehash(ctx context.Context, userM *model.UserM, password string) {
+	hash, err := pwdhash.Hash(password)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to rehash password", "userID", userM.UserID)
+		return
+	}
+
+	userM.Password = hash
+	if err := v.store.User().Update(ctx, userM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to update rehashed password", "userID", userM.UserID)
+		return
+	}
+
+	log.W(ctx).Infow("Password rehashed", "userID", userM.UserID)
+}
+
+// ldapVerifier checks the passwords against the directory, links the directory
+// users to local users, and grants the roles mapped from their groups.
+type ldapVerifier struct {
+	ldap ldap.LDAP
+	auth auth.AuthzInterface
+	biz  *authBiz
+}
+
+// Verify authenticates username at the directory and returns the linked local user.
+func (v *ldapVerifier) Verify(ctx context.Context, username, password string) (*model.UserM, error) {
+	entry, err := v.ldap.Authenticate(ctx, username, password)
+	if err != nil {
+		switch {
+		case errors.Is(err, ldap.ErrUserNotFound):
+			return nil, ErrUnknownUser
+		case errors.Is(err, ldap.ErrInvalidCredentials):
+			return nil, ErrInvalidPassword
+		}
+		return nil, err
+	}
+
+	identity := &externalIdentity{
+		Provider: "ldap",
+		Subject:  entry.ID,
+		Username: entry.Username,
+		Nickname: entry.Name,
+		Email:    entry.Email,
+	}
+	if identity.Username == "" {
+		identity.Username = username
+	}
+
+	var userM *model.UserM
+	if v.ldap.Options().AutoProvision {
+		userM, err = v.biz.provision(ctx, identity)
+	} else {
+		userM, err = v.linkExisting(ctx, identity)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := v.syncRoles(ctx, userM.UserID, entry.Roles); err != nil {
+		return nil, err
+	}
+
+	return userM, nil
+}
+
+// linkExisting returns the local user linked to the directory user. Without
+// auto-provisioning, the directory user is linked on the first login to the
+// local user of the same name, which must have been created by an administrator.
+func (v *ldapVerifier) linkExisting(ctx context.Context, identity *externalIdentity) (*model.UserM, error) {
+	userM, err := v.biz.linkedUser(ctx, identity)
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return userM, err
+	}
+
+	userM, err = v.biz.store.User().Get(ctx, where.F("username", identity.Username))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrUnknownUser
+		}
+		return nil, err
+	}
+	if err := v.biz.link(ctx, userM, identity); err != nil {
+		return nil, err
+	}
+
+	return userM, nil
+}
+
+// syncRoles grants the mapped roles of the user and revokes the other mapped
+// roles, so that the roles follow the group membership. Roles granted by other
+// means are left alone.
+func (v *ldapVerifier) syncRoles(ctx context.Context, userID string, roles []string) error {
+	for _, role := range v.ldap.ManagedRoles() {
+		var changed bool
+		var err error
+		if slices.Contains(roles, role) {
+			changed, err = v.auth.AddRoleForUser(userID, role)
+		} else {
+			changed, err = v.auth.DeleteRoleForUser(userID, role)
+		}
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to sync ldap role", "userID", userID, "role", role)
+			return err
+		}
+		if changed {
+			log.W(ctx).Infow("LDAP role synced", "userID", userID, "role", role, "granted", slices.Contains(roles, role))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+package department
+
+//go:generate mockgen -destination mock_department.go -package department github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/department DepartmentBiz
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// DepartmentBiz defines the interface that contains methods for handling department requests.
+type DepartmentBiz interface {
+	// Create creates a new department based on the provided request parameters.
+	Create(ctx context.Context, rq *v1.CreateDepartmentRequest) (*v1.CreateDepartmentResponse, error)
+
+	// Update updates an existing department based on the provided request parameters.
+	Update(ctx context.Context, rq *v1.UpdateDepartmentRequest) (*v1.UpdateDepartmentResponse, error)
+
+	// Delete removes a department without children and users, and unbinds it from the roles.
+	Delete(ctx context.Context, rq *v1.DeleteDepartmentRequest) (*v1.DeleteDepartmentResponse, error)
+
+	// Get retrieves the details of a specific department based on the provided request parameters.
+	Get(ctx context.Context, rq *v1.GetDepartmentRequest) (*v1.GetDepartmentResponse, error)
+
+	// List retrieves the whole department tree.
+	List(ctx context.Context, rq *v1.ListDepartmentRequest) (*v1.ListDepartmentResponse, error)
+
+	// DepartmentExpansion defines additional methods for extended department operations, if needed.
+	DepartmentExpansion
+}
+
+// DepartmentExpansion defines additional methods for department operations.
+type DepartmentExpansion interface {
+	// GetSubtree retrieves a department with its descendants.
+	GetSubtree(ctx context.Context, rq *v1.GetDepartmentSubtreeRequest) (*v1.GetDepartmentSubtreeResponse, error)
+	// ListAncestor retrieves the ancestors of a department.
+	ListAncestor(ctx context.Context, rq *v1.ListDepartmentAncestorRequest) (*v1.ListDepartmentAncestorResponse, error)
+	// UpdateUserDepartment moves a user to a department.
+	UpdateUserDepartment(ctx context.Context, rq *v1.UpdateUserDepartmentRequest) (*v1.UpdateUserDepartmentResponse, error)
+}
+
+// departmentBiz is the implementation of the DepartmentBiz.
+type departmentBiz struct {
+	store store.IStore
+}
+
+// Ensure that *departmentBiz implements the DepartmentBiz.
+var _ DepartmentBiz = (*departmentBiz)(nil)
+
+// New creates and returns a new instance of *departmentBiz.
+func New(store store.IStore) *departmentBiz {
+	return &departmentBiz{store: store}
+}
+
+// Create implements the Create method of the DepartmentBiz.
+func (b *departmentBiz) Create(ctx context.Context, rq *v1.CreateDepartmentRequest) (*v1.CreateDepartmentResponse, error) {
+	if rq.GetParentID() != "" {
+		if _, err := b.get(ctx, rq.GetParentID()); err != nil {
+			return nil, err
+		}
+	}
+	if err := b.checkLeader(ctx, rq.GetLeaderID()); err != nil {
+		return nil, err
+	}
+
+	departmentM := &model.DepartmentM{
+		ParentID: rq.GetParentID(),
+		Name:     rq.GetName(),
+		Sort:     rq.GetSort(),
+		LeaderID: rq.GetLeaderID(),
+		Status:   known.DepartmentStatusEnabled,
+	}
+	if err := b.store.Department().Create(ctx, departmentM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to create department", "name", departmentM.Name)
+		return nil, err
+	}
+
+	return &v1.CreateDepartmentResponse{DepartmentID: departmentM.DepartmentID}, nil
+}
+
+// Update implements the Update method of the DepartmentBiz.
+func (b *departmentBiz) Update(ctx context.Context, rq *v1.UpdateDepartmentRequest) (*v1.UpdateDepartmentResponse, error) {
+	departmentM, err := b.get(ctx, rq.GetDepartmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	// The descendants keep their parents, so they are moved together with the department.
+	if rq.ParentID != nil && *rq.ParentID != departmentM.ParentID {
+		if err := b.checkMove(ctx, departmentM, *rq.ParentID); err != nil {
+			return nil, err
+		}
+		departmentM.ParentID = *rq.ParentID
+	}
+
+	// Update the fields if provided in the request.
+	if rq.Name != nil {
+		departmentM.Name = *rq.Name
+	}
+	if rq.Sort != nil {
+		departmentM.Sort = *rq.Sort
+	}
+	if rq.LeaderID != nil {
+		if err := b.checkLeader(ctx, *rq.LeaderID); err != nil {
+			return nil, err
+		}
+		departmentM.LeaderID = *rq.LeaderID
+	}
+	if rq.Status != nil {
+		departmentM.Status = *rq.Status
+	}
+
+	if err := b.store.Department().Update(ctx, departmentM); err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateDepartmentResponse{}, nil
+}
+
+// Delete implements the Delete method of the DepartmentBiz.
+func (b *departmentBiz) Delete(ctx context.Context, rq *v1.DeleteDepartmentRequest) (*v1.DeleteDepartmentResponse, error) {
+	departmentM, err := b.get(ctx, rq.GetDepartmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	// The users and the descendants would be left in a department which does not exist.
+	children, _, err := b.store.Department().List(ctx, where.F("parentId", departmentM.DepartmentID).L(1))
+	if err != nil {
+		return nil, err
+	}
+	if children > 0 {
+		return nil, v1.ErrorDepartmentHasChildren("department %s has %d children", departmentM.DepartmentID, children)
+	}
+	users, _, err := b.store.User().List(ctx, where.F("departmentId", departmentM.DepartmentID).L(1))
+	if err != nil {
+		return nil, err
+	}
+	if users > 0 {
+		return nil, v1.ErrorDepartmentHasUsers("department %s has %d users", departmentM.DepartmentID, users)
+	}
+
+	// The roles no longer access the data of the deleted department through their custom data scopes.
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Department().Delete(ctx, where.F("departmentId", departmentM.DepartmentID)); err != nil {
+			return err
+		}
+		return b.store.RoleDepartment().Delete(ctx, where.F("departmentId", departmentM.DepartmentID))
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete department", "departmentID", departmentM.DepartmentID)
+		return nil, err
+	}
+
+	return &v1.DeleteDepartmentResponse{}, nil
+}
+
+// Get implements the Get method of the DepartmentBiz.
+func (b *departmentBiz) Get(ctx context.Context, rq *v1.GetDepartmentRequest) (*v1.GetDepartmentResponse, error) {
+	departmentM, err := b.get(ctx, rq.GetDepartmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetDepartmentResponse{Department: conversion.DepartmentMToDepartmentV1(departmentM)}, nil
+}
+
+// List implements the List method of the DepartmentBiz.
+func (b *departmentBiz) List(ctx context.Context, rq *v1.ListDepartmentRequest) (*v1.ListDepartmentResponse, error) {
+	_, departmentList, err := b.store.Department().List(ctx, where.NewWhere())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list departments from storage")
+		return nil, err
+	}
+
+	return &v1.ListDepartmentResponse{Departments: buildTree(departmentList, func(*model.DepartmentM) bool { return true })}, nil
+}
+
+// GetSubtree implements the GetSubtree method of the DepartmentBiz.
+func (b *departmentBiz) GetSubtree(ctx context.Context, rq *v1.GetDepartmentSubtreeRequest) (*v1.GetDepartmentSubtreeResponse, error) {
+	if _, err := b.get(ctx, rq.GetDepartmentID()); err != nil {
+		return nil, err
+	}
+
+	descendants, err := b.store.Department().Descendants(ctx, rq.GetDepartmentID())
+	if err != nil {
+		return nil, err
+	}
+	_, departmentList, err := b.store.Department().List(ctx, where.F("departmentId", descendants))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list departments from storage")
+		return nil, err
+	}
+
+	// The department is the only one whose parent is not in the subtree.
+	roots := buildTree(departmentList, func(*model.DepartmentM) bool { return true })
+	return &v1.GetDepartmentSubtreeResponse{Department: roots[0]}, nil
+}
+
+// ListAncestor implements the ListAncestor method of the DepartmentBiz.
+func (b *departmentBiz) ListAncestor(ctx context.Context, rq *v1.ListDepartmentAncestorRequest) (*v1.ListDepartmentAncestorResponse, error) {
+	departmentM, err := b.get(ctx, rq.GetDepartmentID())
+	if err != nil {
+		return nil, err
+	}
+
+	ancestors := make([]*v1.Department, 0)
+	for parentID := departmentM.ParentID; parentID != ""; {
+		parent, err := b.get(ctx, parentID)
+		if err != nil {
+			return nil, err
+		}
+		ancestors = append(ancestors, conversion.DepartmentMToDepartmentV1(parent))
+		parentID = parent.ParentID
+	}
+	slices.Reverse(ancestors)
+
+	return &v1.ListDepartmentAncestorResponse{Departments: ancestors}, nil
+}
+
+// UpdateUserDepartment implements the UpdateUserDepartment method of the DepartmentBiz.
+func (b *departmentBiz) UpdateUserDepartment(ctx context.Context, rq *v1.UpdateUserDepartmentRequest) (*v1.UpdateUserDepartmentResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("user %s not found", rq.GetUserID())
+		}
+		return nil, err
+	}
+
+	if rq.GetDepartmentID() != "" {
+		departmentM, err := b.get(ctx, rq.GetDepartmentID())
+		if err != nil {
+			return nil, err
+		}
+		if departmentM.Status == known.DepartmentStatusDisabled {
+			return nil, v1.ErrorDepartmentDisabled("department %s is disabled", departmentM.DepartmentID)
+		}
+	}
+
+	userM.DepartmentID = rq.GetDepartmentID()
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateUserDepartmentResponse{}, nil
+}
+
+// get returns the department of departmentID, or a DepartmentNotFound error.
+func (b *departmentBiz) get(ctx context.Context, departmentID string) (*model.DepartmentM, error) {
+	departmentM, err := b.store.Department().Get(ctx, where.F("departmentId", departmentID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorDepartmentNotFound("department %s not found", departmentID)
+		}
+		return nil, err
+	}
+	return departmentM, nil
+}
+
+// checkMove checks that departmentM can be moved under the department of parentID,
+// which must not be departmentM itself or one of its descendants.
+func (b *departmentBiz) checkMove(ctx context.Context, departmentM *model.DepartmentM, parentID string) error {
+	if parentID == "" {
+		return nil
+	}
+	if _, err := b.get(ctx, parentID); err != nil {
+		return err
+	}
+
+	descendants, err := b.store.Department().Descendants(ctx, departmentM.DepartmentID)
+	if err != nil {
+		return err
+	}
+	if slices.Contains(descendants, parentID) {
+		return errno.ErrInvalidArgument.WithMessage("department %s can not be moved under itself", departmentM.DepartmentID)
+	}
+	return nil
+}
+
+// checkLeader returns a UserNotFound error if the leader does not exist. An empty leaderID means no leader.
+func (b *departmentBiz) checkLeader(ctx context.Context, leaderID string) error {
+	if leaderID == "" {
+		return nil
+	}
+	if _, err := b.store.User().Get(ctx, where.F("userID", leaderID)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return v1.ErrorUserNotFound("leader %s not found", leaderID)
+		}
+		return err
+	}
+	return nil
+}
+
+// buildTree returns the top level departments of the departments which are included, with their
+// included descendants as children. The departments of the same parent are ordered by sort.
+func buildTree(departmentList []*model.DepartmentM, include func(*model.DepartmentM) bool) []*v1.Department {
+	departmentList = slices.Clone(departmentList)
+	slices.SortFunc(departmentList, func(a, b *model.DepartmentM) int {
+		return cmp.Or(cmp.Compare(a.Sort, b.Sort), cmp.Compare(a.ID, b.ID))
+	})
+
+	nodes := make(map[string]*v1.Department, len(departmentList))
+	for _, departmentM := range departmentList {
+		if include(departmentM) {
+			nodes[departmentM.DepartmentID] = conversion.DepartmentMToDepartmentV1(departmentM)
+		}
+	}
+
+	roots := make([]*v1.Department, 0)
+	for _, departmentM := range departmentList {
+		node, ok := nodes[departmentM.DepartmentID]
+		if !ok {
+			continue
+		}
+		if parent, ok := nodes[departmentM.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
@@ -0,0 +1,429 @@
+package menu
+
+//go:generate mockgen -destination mock_menu.go -package menu github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/menu MenuBiz
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// MenuBiz defines the interface that contains methods for handling menu requests.
+type MenuBiz interface {
+	// Create creates a new menu based on the provided request parameters.
+	Create(ctx context.Context, rq *v1.CreateMenuRequest) (*v1.CreateMenuResponse, error)
+
+	// Update updates an existing menu based on the provided request parameters.
+	Update(ctx context.Context, rq *v1.UpdateMenuRequest) (*v1.UpdateMenuResponse, error)
+
+	// Delete removes a menu without children and unbinds it from the roles.
+	Delete(ctx context.Context, rq *v1.DeleteMenuRequest) (*v1.DeleteMenuResponse, error)
+
+	// Get retrieves the details of a specific menu based on the provided request parameters.
+	Get(ctx context.Context, rq *v1.GetMenuRequest) (*v1.GetMenuResponse, error)
+
+	// List retrieves the whole menu tree.
+	List(ctx context.Context, rq *v1.ListMenuRequest) (*v1.ListMenuResponse, error)
+
+	// MenuExpansion defines additional methods for extended menu operations, if needed.
+	MenuExpansion
+}
+
+// MenuExpansion defines additional methods for menu operations.
+type MenuExpansion interface {
+	// ListMine retrieves the menu tree and the permission marks of the roles of the current user.
+	ListMine(ctx context.Context, rq *v1.ListMyMenuRequest) (*v1.ListMyMenuResponse, error)
+	// ListRoleMenu retrieves the menus bound to a role.
+	ListRoleMenu(ctx context.Context, rq *v1.ListRoleMenuRequest) (*v1.ListRoleMenuResponse, error)
+	// UpdateRoleMenu replaces the menus bound to a role.
+	UpdateRoleMenu(ctx context.Context, rq *v1.UpdateRoleMenuRequest) (*v1.UpdateRoleMenuResponse, error)
+}
+
+// menuBiz is the implementation of the MenuBiz.
+type menuBiz struct {
+	store store.IStore
+	// auth provides the roles of the users.
+	auth auth.AuthProvider
+}
+
+// Ensure that *menuBiz implements the MenuBiz.
+var _ MenuBiz = (*menuBiz)(nil)
+
+// New creates and returns a new instance of *menuBiz.
+func New(store store.IStore, auth auth.AuthProvider) *menuBiz {
+	return &menuBiz{store: store, auth: auth}
+}
+
+// Create implements the Create method of the MenuBiz.
+func (b *menuBiz) Create(ctx context.Context, rq *v1.CreateMenuRequest) (*v1.CreateMenuResponse, error) {
+	if err := b.checkParent(ctx, rq.GetType(), rq.GetParentID()); err != nil {
+		return nil, err
+	}
+
+	menuM := &model.MenuM{
+		ParentID:  rq.GetParentID(),
+		Type:      rq.GetType(),
+		Name:      rq.GetName(),
+		Path:      rq.GetPath(),
+		Component: rq.GetComponent(),
+		Icon:      rq.GetIcon(),
+		Sort:      rq.GetSort(),
+		Title:     rq.GetTitle(),
+		Hidden:    rq.GetHidden(),
+		KeepAlive: rq.GetKeepAlive(),
+		AuthMark:  rq.GetAuthMark(),
+	}
+	if err := b.store.Menu().Create(ctx, menuM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to create menu", "title", menuM.Title)
+		return nil, err
+	}
+
+	return &v1.CreateMenuResponse{MenuID: menuM.MenuID}, nil
+}
+
+// Update implements the Update method of the MenuBiz.
+func (b *menuBiz) Update(ctx context.Context, rq *v1.UpdateMenuRequest) (*v1.UpdateMenuResponse, error) {
+	menuM, err := b.get(ctx, rq.GetMenuID())
+	if err != nil {
+		return nil, err
+	}
+
+	if rq.ParentID != nil && *rq.ParentID != menuM.ParentID {
+		if err := b.checkMove(ctx, menuM, *rq.ParentID); err != nil {
+			return nil, err
+		}
+		menuM.ParentID = *rq.ParentID
+	}
+
+	// Update the fields if provided in the request.
+	if rq.Name != nil {
+		menuM.Name = *rq.Name
+	}
+	if rq.Path != nil {
+		menuM.Path = *rq.Path
+	}
+	if rq.Component != nil {
+		menuM.Component = *rq.Component
+	}
+	if rq.Icon != nil {
+		menuM.Icon = *rq.Icon
+	}
+	if rq.Sort != nil {
+		menuM.Sort = *rq.Sort
+	}
+	if rq.Title != nil {
+		menuM.Title = *rq.Title
+	}
+	if rq.Hidden != nil {
+		menuM.Hidden = *rq.Hidden
+	}
+	if rq.KeepAlive != nil {
+		menuM.KeepAlive = *rq.KeepAlive
+	}
+	if rq.AuthMark != nil {
+		menuM.AuthMark = *rq.AuthMark
+	}
+
+	if err := b.store.Menu().Update(ctx, menuM); err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateMenuResponse{}, nil
+}
+
+// Delete implements the Delete method of the MenuBiz.
+func (b *menuBiz) Delete(ctx context.Context, rq *v1.DeleteMenuRequest) (*v1.DeleteMenuResponse, error) {
+	menuM, err := b.get(ctx, rq.GetMenuID())
+	if err != nil {
+		return nil, err
+	}
+
+	// Deleting the children silently could remove a whole part of the tree by mistake.
+	count, _, err := b.store.Menu().List(ctx, where.F("parentId", menuM.MenuID).L(1))
+	if err != nil {
+		return nil, err
+	}
+	if count > 0 {
+		return nil, v1.ErrorMenuHasChildren("menu %s has %d children", menuM.MenuID, count)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Menu().Delete(ctx, where.F("menuId", menuM.MenuID)); err != nil {
+			return err
+		}
+		return b.store.RoleMenu().Delete(ctx, where.F("menuId", menuM.MenuID))
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete menu", "menuID", menuM.MenuID)
+		return nil, err
+	}
+
+	return &v1.DeleteMenuResponse{}, nil
+}
+
+// Get implements the Get method of the MenuBiz.
+func (b *menuBiz) Get(ctx context.Context, rq *v1.GetMenuRequest) (*v1.GetMenuResponse, error) {
+	menuM, err := b.get(ctx, rq.GetMenuID())
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetMenuResponse{Menu: conversion.MenuMToMenuV1(menuM)}, nil
+}
+
+// List implements the List method of the MenuBiz.
+func (b *menuBiz) List(ctx context.Context, rq *v1.ListMenuRequest) (*v1.ListMenuResponse, error) {
+	_, menuList, err := b.store.Menu().List(ctx, where.NewWhere())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list menus from storage")
+		return nil, err
+	}
+
+	return &v1.ListMenuResponse{Menus: buildTree(menuList, func(*model.MenuM) bool { return true })}, nil
+}
+
+// ListMine implements the ListMine method of the MenuBiz.
+func (b *menuBiz) ListMine(ctx context.Context, rq *v1.ListMyMenuRequest) (*v1.ListMyMenuResponse, error) {
+	userID := contextx.UserID(ctx)
+	roles, err := b.enabledRoles(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, menuList, err := b.store.Menu().List(ctx, where.NewWhere())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list menus from storage")
+		return nil, err
+	}
+
+	// The administrators manage the menus, so they see all of them without any bindings.
+	bound := make(map[string]bool, len(menuList))
+	if contextx.IsAdmin(ctx) {
+		for _, menuM := range menuList {
+			bound[menuM.MenuID] = true
+		}
+	} else if len(roles) > 0 {
+		_, bindings, err := b.store.RoleMenu().List(ctx, where.F("role", roles))
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to list role menus from storage", "userID", userID)
+			return nil, err
+		}
+		for _, binding := range bindings {
+			bound[binding.MenuID] = true
+		}
+	}
+
+	marks := make([]string, 0)
+	byID := make(map[string]*model.MenuM, len(menuList))
+	for _, menuM := range menuList {
+		byID[menuM.MenuID] = menuM
+		if bound[menuM.MenuID] && menuM.AuthMark != "" && !slices.Contains(marks, menuM.AuthMark) {
+			marks = append(marks, menuM.AuthMark)
+		}
+	}
+	slices.Sort(marks)
+
+	// The directories containing the bound menus are shown too, otherwise the menus could not be reached.
+	// A bound button grants its permission mark only, the menu of the button has to be bound itself.
+	visible := make(map[string]bool, len(bound))
+	for menuID := range bound {
+		if menuM := byID[menuID]; menuM == nil || menuM.Type == known.MenuTypeButton {
+			continue
+		}
+		for menuM := byID[menuID]; menuM != nil && !visible[menuM.MenuID]; menuM = byID[menuM.ParentID] {
+			visible[menuM.MenuID] = true
+		}
+	}
+	menus := buildTree(menuList, func(menuM *model.MenuM) bool {
+		return visible[menuM.MenuID] && menuM.Type != known.MenuTypeButton
+	})
+
+	return &v1.ListMyMenuResponse{Menus: menus, AuthMarks: marks}, nil
+}
+
+// ListRoleMenu implements the ListRoleMenu method of the MenuBiz.
+func (b *menuBiz) ListRoleMenu(ctx context.Context, rq *v1.ListRoleMenuRequest) (*v1.ListRoleMenuResponse, error) {
+	if err := b.checkRole(ctx, rq.GetName()); err != nil {
+		return nil, err
+	}
+
+	_, bindings, err := b.store.RoleMenu().List(ctx, where.F("role", rq.GetName()))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list role menus from storage", "role", rq.GetName())
+		return nil, err
+	}
+
+	menuIDs := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		menuIDs = append(menuIDs, binding.MenuID)
+	}
+	slices.Sort(menuIDs)
+
+	return &v1.ListRoleMenuResponse{MenuIDs: menuIDs}, nil
+}
+
+// UpdateRoleMenu implements the UpdateRoleMenu method of the MenuBiz.
+func (b *menuBiz) UpdateRoleMenu(ctx context.Context, rq *v1.UpdateRoleMenuRequest) (*v1.UpdateRoleMenuResponse, error) {
+	if err := b.checkRole(ctx, rq.GetName()); err != nil {
+		return nil, err
+	}
+
+	menuIDs := slices.Clone(rq.GetMenuIDs())
+	slices.Sort(menuIDs)
+	menuIDs = slices.Compact(menuIDs)
+	if len(menuIDs) > 0 {
+		_, menuList, err := b.store.Menu().List(ctx, where.F("menuId", menuIDs))
+		if err != nil {
+			return nil, err
+		}
+		for _, menuID := range menuIDs {
+			if !slices.ContainsFunc(menuList, func(menuM *model.MenuM) bool { return menuM.MenuID == menuID }) {
+				return nil, v1.ErrorMenuNotFound("menu %s not found", menuID)
+			}
+		}
+	}
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.RoleMenu().Delete(ctx, where.F("role", rq.GetName())); err != nil {
+			return err
+		}
+		for _, menuID := range menuIDs {
+			if err := b.store.RoleMenu().Create(ctx, &model.RoleMenuM{Role: rq.GetName(), MenuID: menuID}); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to update role menus", "role", rq.GetName())
+		return nil, err
+	}
+
+	return &v1.UpdateRoleMenuResponse{}, nil
+}
+
+// get returns the menu of menuID, or a MenuNotFound error.
+func (b *menuBiz) get(ctx context.Context, menuID string) (*model.MenuM, error) {
+	menuM, err := b.store.Menu().Get(ctx, where.F("menuId", menuID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorMenuNotFound("menu %s not found", menuID)
+		}
+		return nil, err
+	}
+	return menuM, nil
+}
+
+// checkParent checks that an entry of menuType can be placed under the menu of parentID.
+// Directories and menus are placed at the top level or under directories, buttons under menus.
+func (b *menuBiz) checkParent(ctx context.Context, menuType int32, parentID string) error {
+	parentType := int32(0)
+	if parentID != "" {
+		parent, err := b.get(ctx, parentID)
+		if err != nil {
+			return err
+		}
+		parentType = parent.Type
+	}
+
+	switch {
+	case menuType == known.MenuTypeButton && parentType != known.MenuTypeMenu:
+		return errno.ErrInvalidArgument.WithMessage("a button must be placed under a menu")
+	case menuType != known.MenuTypeButton && parentType != 0 && parentType != known.MenuTypeDirectory:
+		return errno.ErrInvalidArgument.WithMessage("a directory or menu must be placed under a directory")
+	}
+	return nil
+}
+
+// checkMove checks that menuM can be moved under the menu of parentID,
+// which must not be menuM itself or one of its descendants.
+func (b *menuBiz) checkMove(ctx context.Context, menuM *model.MenuM, parentID string) error {
+	for ancestorID := parentID; ancestorID != ""; {
+		if ancestorID == menuM.MenuID {
+			return errno.ErrInvalidArgument.WithMessage("menu %s can not be moved under itself", menuM.MenuID)
+		}
+		ancestor, err := b.get(ctx, ancestorID)
+		if err != nil {
+			return err
+		}
+		ancestorID = ancestor.ParentID
+	}
+
+	return b.checkParent(ctx, menuM.Type, parentID)
+}
+
+// checkRole returns a RoleNotFound error if the role of name does not exist.
+func (b *menuBiz) checkRole(ctx context.Context, name string) error {
+	if _, err := b.store.Role().Get(ctx, where.F("name", name)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return v1.ErrorRoleNotFound("role %q not found", name)
+		}
+		return err
+	}
+	return nil
+}
+
+// enabledRoles returns the roles of the user, except the disabled ones.
+// Roles granted outside of the role API, e.g. mapped from LDAP groups, are enabled.
+func (b *menuBiz) enabledRoles(ctx context.Context, userID string) ([]string, error) {
+	roles, err := b.auth.GetRolesForUser(userID)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get roles for user", "userID", userID)
+		return nil, err
+	}
+	if len(roles) == 0 {
+		return roles, nil
+	}
+
+	_, roleList, err := b.store.Role().List(ctx, where.F("name", roles).F("status", known.RoleStatusDisabled))
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(roles, func(name string) bool {
+		return slices.ContainsFunc(roleList, func(roleM *model.RoleM) bool { return roleM.Name == name })
+	}), nil
+}
+
+// buildTree returns the top level entries of the menus which are included, with their
+// included descendants as children. The entries of the same parent are ordered by sort.
+func buildTree(menuList []*model.MenuM, include func(*model.MenuM) bool) []*v1.Menu {
+	menuList = slices.Clone(menuList)
+	slices.SortFunc(menuList, func(a, b *model.MenuM) int {
+		return cmp.Or(cmp.Compare(a.Sort, b.Sort), cmp.Compare(a.ID, b.ID))
+	})
+
+	nodes := make(map[string]*v1.Menu, len(menuList))
+	for _, menuM := range menuList {
+		if include(menuM) {
+			nodes[menuM.MenuID] = conversion.MenuMToMenuV1(menuM)
+		}
+	}
+
+	roots := make([]*v1.Menu, 0)
+	for _, menuM := range menuList {
+		node, ok := nodes[menuM.MenuID]
+		if !ok {
+			continue
+		}
+		if parent, ok := nodes[menuM.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+			continue
+		}
+		roots = append(roots, node)
+	}
+	return roots
+}
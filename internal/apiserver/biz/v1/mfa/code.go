@@ -0,0 +1,77 @@
+package mfa
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/totp"
+)
+
+const (
+	// recoveryCodeCount is the number of recovery codes generated when MFA is enabled.
+	recoveryCodeCount = 10
+	// recoveryCodeSize is the number of random bytes of a recovery code.
+	recoveryCodeSize = 5
+)
+
+// recoveryEncoding encodes recovery codes without padding, 5 bytes giving 8 characters.
+var recoveryEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// Enabled reports whether the MFA record has been confirmed.
+func Enabled(mfaM *model.UserMFAM) bool {
+	return mfaM != nil && mfaM.Enabled == 1
+}
+
+// VerifyCode checks a TOTP code or an unused recovery code against the MFA
+// record. On success the record is updated, so that neither the TOTP code nor
+// the recovery code can be used again, and the caller must save it.
+func VerifyCode(mfaM *model.UserMFAM, code string) bool {
+	if step, ok := totp.Validate(mfaM.Secret, code, time.Now(), mfaM.LastUsedStep); ok {
+		mfaM.LastUsedStep = step
+		return true
+	}
+
+	want := hashRecoveryCode(code)
+	hashes := strings.Split(mfaM.RecoveryCodes, ",")
+	for i, hash := range hashes {
+		if hash != "" && subtle.ConstantTimeCompare([]byte(hash), []byte(want)) == 1 {
+			mfaM.RecoveryCodes = strings.Join(append(hashes[:i:i], hashes[i+1:]...), ",")
+			return true
+		}
+	}
+
+	return false
+}
+
+// generateRecoveryCodes returns new recovery codes formatted as xxxx-xxxx, and
+// the comma separated digests which are stored instead of the codes.
+func generateRecoveryCodes() ([]string, string, error) {
+	codes := make([]string, recoveryCodeCount)
+	hashes := make([]string, recoveryCodeCount)
+	for i := range codes {
+		b := make([]byte, recoveryCodeSize)
+		if _, err := rand.Read(b); err != nil {
+			return nil, "", err
+		}
+
+		code := strings.ToLower(recoveryEncoding.EncodeToString(b))
+		codes[i] = code[:4] + "-" + code[4:]
+		hashes[i] = hashRecoveryCode(codes[i])
+	}
+
+	return codes, strings.Join(hashes, ","), nil
+}
+
+// hashRecoveryCode returns the digest of a recovery code. Case and dashes are
+// ignored, so that users can type the code as they like.
+func hashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(code), "-", ""))
+	sum := sha256.Sum256([]byte(code))
+	return hex.EncodeToString(sum[:])
+}
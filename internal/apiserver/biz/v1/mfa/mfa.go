@@ -0,0 +1,160 @@
+package mfa
+
+//go:generate mockgen -destination mock_mfa.go -package mfa github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/mfa MFABiz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/moweilong/milady/pkg/i18n"
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/totp"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// Issuer is the issuer shown by authenticator apps next to the account.
+const Issuer = "Art Design Pro"
+
+// MFABiz defines the interface that contains methods for handling multi-factor authentication requests.
+type MFABiz interface {
+	// Enroll generates a new TOTP secret for the logged-in user, which must be confirmed before it is enabled.
+	Enroll(ctx context.Context, rq *v1.EnrollMFARequest) (*v1.EnrollMFAResponse, error)
+
+	// Confirm enables MFA with the first code of the enrolled secret and returns the recovery codes.
+	Confirm(ctx context.Context, rq *v1.ConfirmMFARequest) (*v1.ConfirmMFAResponse, error)
+
+	// Disable disables MFA of the logged-in user after checking a code.
+	Disable(ctx context.Context, rq *v1.DisableMFARequest) (*v1.DisableMFAResponse, error)
+
+	// MFAExpansion defines additional methods for extended MFA operations, if needed.
+	MFAExpansion
+}
+
+// MFAExpansion defines additional methods for MFA operations.
+type MFAExpansion interface{}
+
+// mfaBiz is the implementation of the MFABiz.
+type mfaBiz struct {
+	store store.IStore
+}
+
+// Ensure that *mfaBiz implements the MFABiz.
+var _ MFABiz = (*mfaBiz)(nil)
+
+// New creates and returns a new instance of *mfaBiz.
+func New(store store.IStore) *mfaBiz {
+	return &mfaBiz{store: store}
+}
+
+// Enroll implements the Enroll method of the MFABiz. Enrolling again before
+// the confirmation replaces the pending secret.
+func (b *mfaBiz) Enroll(ctx context.Context, rq *v1.EnrollMFARequest) (*v1.EnrollMFAResponse, error) {
+	userID := contextx.UserID(ctx)
+	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("%s", err.Error())
+		}
+		return nil, err
+	}
+
+	mfaM, err := b.get(ctx, userID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if Enabled(mfaM) {
+		return nil, v1.ErrorMFAAlreadyEnabled("%s", i18n.FromContext(ctx).T(locales.MFAAlreadyEnabled))
+	}
+
+	secret, err := totp.GenerateSecret()
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to generate totp secret")
+		return nil, err
+	}
+
+	if mfaM == nil {
+		err = b.store.UserMFA().Create(ctx, &model.UserMFAM{UserID: userID, Secret: secret})
+	} else {
+		mfaM.Secret, mfaM.LastUsedStep = secret, 0
+		err = b.store.UserMFA().Update(ctx, mfaM)
+	}
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to save totp secret")
+		return nil, err
+	}
+
+	return &v1.EnrollMFAResponse{Secret: secret, Uri: totp.URI(Issuer, userM.Username, secret)}, nil
+}
+
+// Confirm implements the Confirm method of the MFABiz.
+func (b *mfaBiz) Confirm(ctx context.Context, rq *v1.ConfirmMFARequest) (*v1.ConfirmMFAResponse, error) {
+	mfaM, err := b.get(ctx, contextx.UserID(ctx))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorMFANotEnabled("%s", i18n.FromContext(ctx).T(locales.MFANotEnabled))
+		}
+		return nil, err
+	}
+	if Enabled(mfaM) {
+		return nil, v1.ErrorMFAAlreadyEnabled("%s", i18n.FromContext(ctx).T(locales.MFAAlreadyEnabled))
+	}
+
+	// Recovery codes do not exist yet, only the authenticator app can confirm.
+	step, ok := totp.Validate(mfaM.Secret, rq.GetCode(), time.Now(), mfaM.LastUsedStep)
+	if !ok {
+		return nil, v1.ErrorInvalidMFACode("%s", i18n.FromContext(ctx).T(locales.InvalidMFACode))
+	}
+
+	codes, hashes, err := generateRecoveryCodes()
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to generate recovery codes")
+		return nil, err
+	}
+
+	mfaM.Enabled, mfaM.LastUsedStep, mfaM.RecoveryCodes = 1, step, hashes
+	if err := b.store.UserMFA().Update(ctx, mfaM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to enable mfa")
+		return nil, err
+	}
+
+	log.W(ctx).Infow("MFA enabled", "userID", mfaM.UserID)
+
+	return &v1.ConfirmMFAResponse{RecoveryCodes: codes}, nil
+}
+
+// Disable implements the Disable method of the MFABiz.
+func (b *mfaBiz) Disable(ctx context.Context, rq *v1.DisableMFARequest) (*v1.DisableMFAResponse, error) {
+	mfaM, err := b.get(ctx, contextx.UserID(ctx))
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	if !Enabled(mfaM) {
+		return nil, v1.ErrorMFANotEnabled("%s", i18n.FromContext(ctx).T(locales.MFANotEnabled))
+	}
+
+	if !VerifyCode(mfaM, rq.GetCode()) {
+		return nil, v1.ErrorInvalidMFACode("%s", i18n.FromContext(ctx).T(locales.InvalidMFACode))
+	}
+
+	if err := b.store.UserMFA().Delete(ctx, where.F("userID", mfaM.UserID)); err != nil {
+		log.W(ctx).Errorw(err, "Failed to disable mfa")
+		return nil, err
+	}
+
+	log.W(ctx).Infow("MFA disabled", "userID", mfaM.UserID)
+
+	return &v1.DisableMFAResponse{}, nil
+}
+
+// get returns the MFA record of userID.
+func (b *mfaBiz) get(ctx context.Context, userID string) (*model.UserMFAM, error) {
+	return b.store.UserMFA().Get(ctx, where.F("userID", userID))
+}
@@ -0,0 +1,199 @@
+package policy
+
+//go:generate mockgen -destination mock_policy.go -package policy github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/policy PolicyBiz
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// PolicyBiz defines the interface that contains methods for handling casbin policy requests.
+type PolicyBiz interface {
+	// Create adds a new policy based on the provided request parameters.
+	Create(ctx context.Context, rq *v1.CreatePolicyRequest) (*v1.CreatePolicyResponse, error)
+
+	// Update replaces an existing policy with another one.
+	Update(ctx context.Context, rq *v1.UpdatePolicyRequest) (*v1.UpdatePolicyResponse, error)
+
+	// Delete removes a policy based on the provided request parameters.
+	Delete(ctx context.Context, rq *v1.DeletePolicyRequest) (*v1.DeletePolicyResponse, error)
+
+	// List retrieves a list of policies and their total count based on the provided request parameters.
+	List(ctx context.Context, rq *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error)
+
+	// PolicyExpansion defines additional methods for extended policy operations, if needed.
+	PolicyExpansion
+}
+
+// PolicyExpansion defines additional methods for policy operations.
+type PolicyExpansion interface {
+	// ReplaceSubject replaces all policies of a subject.
+	ReplaceSubject(ctx context.Context, rq *v1.ReplaceSubjectPolicyRequest) (*v1.ReplaceSubjectPolicyResponse, error)
+}
+
+// policyBiz is the implementation of the PolicyBiz.
+type policyBiz struct {
+	// auth keeps the policies, the changes are broadcast to the other replicas by its watcher.
+	auth auth.AuthProvider
+}
+
+// Ensure that *policyBiz implements the PolicyBiz.
+var _ PolicyBiz = (*policyBiz)(nil)
+
+// New creates and returns a new instance of *policyBiz.
+func New(auth auth.AuthProvider) *policyBiz {
+	return &policyBiz{auth: auth}
+}
+
+// Create implements the Create method of the PolicyBiz.
+func (b *policyBiz) Create(ctx context.Context, rq *v1.CreatePolicyRequest) (*v1.CreatePolicyResponse, error) {
+	rule := conversion.PolicyV1ToRule(&v1.Policy{Subject: rq.GetSubject(), Object: rq.GetObject(), Action: rq.GetAction(), Effect: rq.GetEffect()})
+	added, err := b.auth.AddPolicies([][]string{rule})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to add policy", "policy", rule)
+		return nil, errno.ErrAddPolicy
+	}
+	if !added {
+		return nil, errno.ErrPolicyAlreadyExists
+	}
+
+	return &v1.CreatePolicyResponse{}, nil
+}
+
+// Update implements the Update method of the PolicyBiz.
+func (b *policyBiz) Update(ctx context.Context, rq *v1.UpdatePolicyRequest) (*v1.UpdatePolicyResponse, error) {
+	oldRule, newRule := conversion.PolicyV1ToRule(rq.GetOldPolicy()), conversion.PolicyV1ToRule(rq.GetNewPolicy())
+	if slices.Equal(oldRule, newRule) {
+		return &v1.UpdatePolicyResponse{}, nil
+	}
+
+	// casbin replaces the policy even if the new one exists, which would leave duplicated policies.
+	exists, err := b.exists(newRule)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errno.ErrPolicyAlreadyExists
+	}
+
+	updated, err := b.auth.UpdatePolicy(oldRule, newRule)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to update policy", "old", oldRule, "new", newRule)
+		return nil, errno.ErrUpdatePolicy
+	}
+	if !updated {
+		return nil, errno.ErrPolicyNotFound
+	}
+
+	return &v1.UpdatePolicyResponse{}, nil
+}
+
+// Delete implements the Delete method of the PolicyBiz.
+func (b *policyBiz) Delete(ctx context.Context, rq *v1.DeletePolicyRequest) (*v1.DeletePolicyResponse, error) {
+	rule := conversion.PolicyV1ToRule(&v1.Policy{Subject: rq.GetSubject(), Object: rq.GetObject(), Action: rq.GetAction(), Effect: rq.GetEffect()})
+	removed, err := b.auth.RemovePolicies([][]string{rule})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to remove policy", "policy", rule)
+		return nil, errno.ErrRemovePolicy
+	}
+	if !removed {
+		return nil, errno.ErrPolicyNotFound
+	}
+
+	return &v1.DeletePolicyResponse{}, nil
+}
+
+// List implements the List method of the PolicyBiz.
+func (b *policyBiz) List(ctx context.Context, rq *v1.ListPolicyRequest) (*v1.ListPolicyResponse, error) {
+	var fieldValues []string
+	if rq.GetSubject() != "" {
+		fieldValues = append(fieldValues, rq.GetSubject())
+	}
+	rules, err := b.auth.GetFilteredPolicy(0, fieldValues...)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get policies")
+		return nil, err
+	}
+
+	// The policies are kept in memory in the order they were added, sort them for a stable pagination.
+	slices.SortFunc(rules, func(a, b []string) int {
+		return strings.Compare(strings.Join(a, "\x00"), strings.Join(b, "\x00"))
+	})
+
+	total := int64(len(rules))
+	start := min(rq.GetOffset(), total)
+	end := min(start+rq.GetLimit(), total)
+
+	policies := make([]*v1.Policy, 0, end-start)
+	for _, rule := range rules[start:end] {
+		policies = append(policies, conversion.RuleToPolicyV1(rule))
+	}
+
+	return &v1.ListPolicyResponse{Total: total, Policies: policies}, nil
+}
+
+// ReplaceSubject implements the ReplaceSubject method of the PolicyBiz.
+func (b *policyBiz) ReplaceSubject(ctx context.Context, rq *v1.ReplaceSubjectPolicyRequest) (*v1.ReplaceSubjectPolicyResponse, error) {
+	newRules := make([][]string, 0, len(rq.GetPolicies()))
+	for _, policy := range rq.GetPolicies() {
+		rule := conversion.PolicyV1ToRule(policy)
+		rule[0] = rq.GetSubject()
+		if !slices.ContainsFunc(newRules, func(r []string) bool { return slices.Equal(r, rule) }) {
+			newRules = append(newRules, rule)
+		}
+	}
+
+	oldRules, err := b.auth.GetFilteredPolicy(0, rq.GetSubject())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get policies", "subject", rq.GetSubject())
+		return nil, err
+	}
+
+	// Only the difference is applied, so that the unchanged policies stay in effect throughout.
+	removed := difference(oldRules, newRules)
+	added := difference(newRules, oldRules)
+	if len(removed) > 0 {
+		if _, err := b.auth.RemovePolicies(removed); err != nil {
+			log.W(ctx).Errorw(err, "Failed to remove policies", "subject", rq.GetSubject())
+			return nil, errno.ErrRemovePolicy
+		}
+	}
+	if len(added) > 0 {
+		if _, err := b.auth.AddPolicies(added); err != nil {
+			log.W(ctx).Errorw(err, "Failed to add policies", "subject", rq.GetSubject())
+			return nil, errno.ErrAddPolicy
+		}
+	}
+
+	log.W(ctx).Infow("Policies replaced", "subject", rq.GetSubject(), "added", len(added), "removed", len(removed))
+
+	return &v1.ReplaceSubjectPolicyResponse{}, nil
+}
+
+// exists reports whether the policy rule exists.
+func (b *policyBiz) exists(rule []string) (bool, error) {
+	rules, err := b.auth.GetFilteredPolicy(0, rule...)
+	if err != nil {
+		return false, err
+	}
+	return len(rules) > 0, nil
+}
+
+// difference returns the rules of a which are not in b.
+func difference(a, b [][]string) [][]string {
+	var rules [][]string
+	for _, rule := range a {
+		if !slices.ContainsFunc(b, func(r []string) bool { return slices.Equal(r, rule) }) {
+			rules = append(rules, rule)
+		}
+	}
+	return rules
+}
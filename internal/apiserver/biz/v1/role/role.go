@@ -0,0 +1,399 @@
+package role
+
+//go:generate mockgen -destination mock_role.go -package role github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/role RoleBiz
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// RoleBiz defines the interface that contains methods for handling role requests.
+type RoleBiz interface {
+	// Create creates a new role based on the provided request parameters.
+	Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.CreateRoleResponse, error)
+
+	// Update updates an existing role based on the provided request parameters.
+	Update(ctx context.Context, rq *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error)
+
+	// Delete removes a role, revokes it from all of its members and unbinds its menus and departments.
+	Delete(ctx context.Context, rq *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error)
+
+	// Get retrieves the details of a specific role based on the provided request parameters.
+	Get(ctx context.Context, rq *v1.GetRoleRequest) (*v1.GetRoleResponse, error)
+
+	// List retrieves a list of roles and their total count based on the provided request parameters.
+	List(ctx context.Context, rq *v1.ListRoleRequest) (*v1.ListRoleResponse, error)
+
+	// RoleExpansion defines additional methods for extended role operations, if needed.
+	RoleExpansion
+}
+
+// RoleExpansion defines additional methods for role operations.
+type RoleExpansion interface {
+	// ListMember retrieves the users the role is assigned to.
+	ListMember(ctx context.Context, rq *v1.ListRoleMemberRequest) (*v1.ListRoleMemberResponse, error)
+	// ListUserRole retrieves the roles assigned to a user.
+	ListUserRole(ctx context.Context, rq *v1.ListUserRoleRequest) (*v1.ListUserRoleResponse, error)
+	// AssignUserRole assigns roles to a user.
+	AssignUserRole(ctx context.Context, rq *v1.AssignUserRoleRequest) (*v1.AssignUserRoleResponse, error)
+	// RemoveUserRole removes a role from a user.
+	RemoveUserRole(ctx context.Context, rq *v1.RemoveUserRoleRequest) (*v1.RemoveUserRoleResponse, error)
+}
+
+// roleBiz is the implementation of the RoleBiz.
+type roleBiz struct {
+	store store.IStore
+	// auth keeps the assignments of the roles as casbin grouping policies.
+	auth auth.AuthProvider
+}
+
+// Ensure that *roleBiz implements the RoleBiz.
+var _ RoleBiz = (*roleBiz)(nil)
+
+// New creates and returns a new instance of *roleBiz.
+func New(store store.IStore, auth auth.AuthProvider) *roleBiz {
+	return &roleBiz{store: store, auth: auth}
+}
+
+// Create implements the Create method of the RoleBiz.
+func (b *roleBiz) Create(ctx context.Context, rq *v1.CreateRoleRequest) (*v1.CreateRoleResponse, error) {
+	if _, err := b.get(ctx, rq.GetName()); !v1.IsRoleNotFound(err) {
+		if err != nil {
+			return nil, err
+		}
+		return nil, v1.ErrorRoleAlreadyExists("role %q already exists", rq.GetName())
+	}
+
+	departmentIDs, err := b.checkDepartments(ctx, rq.GetDepartmentIDs())
+	if err != nil {
+		return nil, err
+	}
+
+	roleM := &model.RoleM{
+		Name:        rq.GetName(),
+		DisplayName: rq.GetDisplayName(),
+		Description: rq.GetDescription(),
+		Status:      known.RoleStatusEnabled,
+		DataScope:   known.DataScopeSelf,
+	}
+	if rq.DataScope != nil {
+		roleM.DataScope = *rq.DataScope
+	}
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Role().Create(ctx, roleM); err != nil {
+			return err
+		}
+		return b.bindDepartments(ctx, roleM.Name, departmentIDs)
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to create role", "role", roleM.Name)
+		return nil, err
+	}
+
+	return &v1.CreateRoleResponse{Name: roleM.Name}, nil
+}
+
+// Update implements the Update method of the RoleBiz.
+func (b *roleBiz) Update(ctx context.Context, rq *v1.UpdateRoleRequest) (*v1.UpdateRoleResponse, error) {
+	roleM, err := b.get(ctx, rq.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the fields if provided in the request.
+	if rq.DisplayName != nil {
+		roleM.DisplayName = *rq.DisplayName
+	}
+	if rq.Description != nil {
+		roleM.Description = *rq.Description
+	}
+	if rq.Status != nil {
+		// The users and the administrators lose their permissions with a disabled builtin role.
+		if *rq.Status == known.RoleStatusDisabled && slices.Contains(known.BuiltinRoles, roleM.Name) {
+			return nil, v1.ErrorRoleBuiltin("builtin role %q can not be disabled", roleM.Name)
+		}
+		roleM.Status = *rq.Status
+	}
+
+	// The custom departments are replaced together with the data scope.
+	if rq.DataScope == nil {
+		if err := b.store.Role().Update(ctx, roleM); err != nil {
+			return nil, err
+		}
+		return &v1.UpdateRoleResponse{}, nil
+	}
+	// The administrators access all of the data whatever the data scope of their role is.
+	if roleM.Name == known.RoleAdmin && *rq.DataScope != known.DataScopeAll {
+		return nil, v1.ErrorRoleBuiltin("the data scope of builtin role %q can not be changed", roleM.Name)
+	}
+	departmentIDs, err := b.checkDepartments(ctx, rq.GetDepartmentIDs())
+	if err != nil {
+		return nil, err
+	}
+	roleM.DataScope = *rq.DataScope
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Role().Update(ctx, roleM); err != nil {
+			return err
+		}
+		if err := b.store.RoleDepartment().Delete(ctx, where.F("role", roleM.Name)); err != nil {
+			return err
+		}
+		return b.bindDepartments(ctx, roleM.Name, departmentIDs)
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to update role", "role", roleM.Name)
+		return nil, err
+	}
+
+	return &v1.UpdateRoleResponse{}, nil
+}
+
+// Delete implements the Delete method of the RoleBiz.
+func (b *roleBiz) Delete(ctx context.Context, rq *v1.DeleteRoleRequest) (*v1.DeleteRoleResponse, error) {
+	roleM, err := b.get(ctx, rq.GetName())
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(known.BuiltinRoles, roleM.Name) {
+		return nil, v1.ErrorRoleBuiltin("builtin role %q can not be deleted", roleM.Name)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.Role().Delete(ctx, where.F("name", roleM.Name)); err != nil {
+			return err
+		}
+		if err := b.store.RoleDepartment().Delete(ctx, where.F("role", roleM.Name)); err != nil {
+			return err
+		}
+		return b.store.RoleMenu().Delete(ctx, where.F("role", roleM.Name))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Otherwise the members keep the permissions of the deleted role.
+	if _, err := b.auth.DeleteRole(roleM.Name); err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete casbin role", "role", roleM.Name)
+		return nil, errno.ErrRemoveRole
+	}
+
+	return &v1.DeleteRoleResponse{}, nil
+}
+
+// Get implements the Get method of the RoleBiz.
+func (b *roleBiz) Get(ctx context.Context, rq *v1.GetRoleRequest) (*v1.GetRoleResponse, error) {
+	roleM, err := b.get(ctx, rq.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	roles, err := b.convert(ctx, []*model.RoleM{roleM})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetRoleResponse{Role: roles[0]}, nil
+}
+
+// List implements the List method of the RoleBiz.
+func (b *roleBiz) List(ctx context.Context, rq *v1.ListRoleRequest) (*v1.ListRoleResponse, error) {
+	whr := where.O(int(rq.GetOffset())).L(int(rq.GetLimit()))
+	count, roleList, err := b.store.Role().List(ctx, whr)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list roles from storage")
+		return nil, err
+	}
+
+	roles, err := b.convert(ctx, roleList)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ListRoleResponse{Total: count, Roles: roles}, nil
+}
+
+// ListMember implements the ListMember method of the RoleBiz.
+func (b *roleBiz) ListMember(ctx context.Context, rq *v1.ListRoleMemberRequest) (*v1.ListRoleMemberResponse, error) {
+	if _, err := b.get(ctx, rq.GetName()); err != nil {
+		return nil, err
+	}
+
+	userIDs, err := b.auth.GetUsersForRole(rq.GetName())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get users for role", "role", rq.GetName())
+		return nil, err
+	}
+	if len(userIDs) == 0 {
+		return &v1.ListRoleMemberResponse{Users: []*v1.User{}}, nil
+	}
+
+	// The members may contain other roles inheriting the role, which are dropped by the query.
+	whr := where.O(int(rq.GetOffset())).L(int(rq.GetLimit())).F("userID", userIDs)
+	count, userList, err := b.store.User().List(ctx, whr)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list role members from storage")
+		return nil, err
+	}
+
+	users := make([]*v1.User, 0, len(userList))
+	for _, userM := range userList {
+		users = append(users, conversion.UserMToUserV1(userM))
+	}
+
+	return &v1.ListRoleMemberResponse{Total: count, Users: users}, nil
+}
+
+// ListUserRole implements the ListUserRole method of the RoleBiz.
+func (b *roleBiz) ListUserRole(ctx context.Context, rq *v1.ListUserRoleRequest) (*v1.ListUserRoleResponse, error) {
+	names, err := b.auth.GetRolesForUser(rq.GetUserID())
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get roles for user", "userID", rq.GetUserID())
+		return nil, err
+	}
+	if len(names) == 0 {
+		return &v1.ListUserRoleResponse{Roles: []*v1.Role{}}, nil
+	}
+
+	_, roleList, err := b.store.Role().List(ctx, where.F("name", names))
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]*v1.Role, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(roleList, func(roleM *model.RoleM) bool { return roleM.Name == name })
+		if idx < 0 {
+			// Roles granted outside of the role API, e.g. mapped from LDAP groups, are listed by name.
+			roles = append(roles, &v1.Role{Name: name, Status: known.RoleStatusEnabled})
+			continue
+		}
+		roles = append(roles, conversion.RoleMToRoleV1(roleList[idx]))
+	}
+
+	return &v1.ListUserRoleResponse{Roles: roles}, nil
+}
+
+// AssignUserRole implements the AssignUserRole method of the RoleBiz.
+func (b *roleBiz) AssignUserRole(ctx context.Context, rq *v1.AssignUserRoleRequest) (*v1.AssignUserRoleResponse, error) {
+	if _, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID())); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("user %s not found", rq.GetUserID())
+		}
+		return nil, err
+	}
+
+	// Check all of the roles before granting any of them.
+	for _, name := range rq.GetRoles() {
+		roleM, err := b.get(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		if roleM.Status == known.RoleStatusDisabled {
+			return nil, v1.ErrorRoleDisabled("role %q is disabled", name)
+		}
+	}
+
+	for _, name := range rq.GetRoles() {
+		if _, err := b.auth.AddRoleForUser(rq.GetUserID(), name); err != nil {
+			log.W(ctx).Errorw(err, "Failed to add role for user", "userID", rq.GetUserID(), "role", name)
+			return nil, errno.ErrAddRole
+		}
+	}
+
+	return &v1.AssignUserRoleResponse{}, nil
+}
+
+// RemoveUserRole implements the RemoveUserRole method of the RoleBiz.
+func (b *roleBiz) RemoveUserRole(ctx context.Context, rq *v1.RemoveUserRoleRequest) (*v1.RemoveUserRoleResponse, error) {
+	// Removing a role the user does not have is not an error.
+	if _, err := b.auth.DeleteRoleForUser(rq.GetUserID(), rq.GetName()); err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete role for user", "userID", rq.GetUserID(), "role", rq.GetName())
+		return nil, errno.ErrRemoveRole
+	}
+
+	return &v1.RemoveUserRoleResponse{}, nil
+}
+
+// get returns the role of name, or a RoleNotFound error.
+func (b *roleBiz) get(ctx context.Context, name string) (*model.RoleM, error) {
+	roleM, err := b.store.Role().Get(ctx, where.F("name", name))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorRoleNotFound("role %q not found", name)
+		}
+		return nil, err
+	}
+	return roleM, nil
+}
+
+// convert converts the roles to the v1 API format, together with the departments of their custom data scopes.
+func (b *roleBiz) convert(ctx context.Context, roleList []*model.RoleM) ([]*v1.Role, error) {
+	names := make([]string, 0, len(roleList))
+	for _, roleM := range roleList {
+		names = append(names, roleM.Name)
+	}
+	departments := make(map[string][]string, len(roleList))
+	if len(names) > 0 {
+		_, bindings, err := b.store.RoleDepartment().List(ctx, where.F("role", names))
+		if err != nil {
+			return nil, err
+		}
+		for _, binding := range bindings {
+			departments[binding.Role] = append(departments[binding.Role], binding.DepartmentID)
+		}
+	}
+
+	roles := make([]*v1.Role, 0, len(roleList))
+	for _, roleM := range roleList {
+		role := conversion.RoleMToRoleV1(roleM)
+		role.DepartmentIDs = slices.Sorted(slices.Values(departments[roleM.Name]))
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// checkDepartments returns the departments of a custom data scope without duplicates,
+// or a DepartmentNotFound error if one of them does not exist.
+func (b *roleBiz) checkDepartments(ctx context.Context, departmentIDs []string) ([]string, error) {
+	departmentIDs = slices.Clone(departmentIDs)
+	slices.Sort(departmentIDs)
+	departmentIDs = slices.Compact(departmentIDs)
+	if len(departmentIDs) == 0 {
+		return departmentIDs, nil
+	}
+
+	_, departmentList, err := b.store.Department().List(ctx, where.F("departmentId", departmentIDs))
+	if err != nil {
+		return nil, err
+	}
+	for _, departmentID := range departmentIDs {
+		if !slices.ContainsFunc(departmentList, func(departmentM *model.DepartmentM) bool { return departmentM.DepartmentID == departmentID }) {
+			return nil, v1.ErrorDepartmentNotFound("department %s not found", departmentID)
+		}
+	}
+	return departmentIDs, nil
+}
+
+// bindDepartments binds the departments of a custom data scope to the role.
+func (b *roleBiz) bindDepartments(ctx context.Context, name string, departmentIDs []string) error {
+	for _, departmentID := range departmentIDs {
+		if err := b.store.RoleDepartment().Create(ctx, &model.RoleDepartmentM{Role: name, DepartmentID: departmentID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
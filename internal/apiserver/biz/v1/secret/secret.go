@@ -15,6 +15,7 @@ import (
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
 	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
@@ -47,14 +48,16 @@ type SecretExpansion interface{}
 // secretBiz is the implementation of the SecretBiz.
 type secretBiz struct {
 	store store.IStore
+	// auth evicts the updated and deleted secrets from the secret cache of every replica.
+	auth auth.AuthProvider
 }
 
 // Ensure that *secretBiz implements the SecretBiz.
 var _ SecretBiz = (*secretBiz)(nil)
 
 // New creates and returns a new instance of *secretBiz.
-func New(store store.IStore) *secretBiz {
-	return &secretBiz{store: store}
+func New(store store.IStore, auth auth.AuthProvider) *secretBiz {
+	return &secretBiz{store: store, auth: auth}
 }
 
 // Create implements the Create method of the SecretBiz.
@@ -94,16 +97,35 @@ func (b *secretBiz) Update(ctx context.Context, rq *v1.UpdateSecretRequest) (*v1
 		return nil, err
 	}
 
+	// Otherwise a disabled or expired secret keeps authenticating on the replicas which cached it.
+	if err := b.auth.InvalidateSecrets(ctx, secretM.SecretID); err != nil {
+		log.W(ctx).Errorw(err, "Failed to invalidate secret", "secretID", secretM.SecretID)
+		return nil, err
+	}
+
 	return &v1.UpdateSecretResponse{}, nil
 }
 
 // Delete implements the Delete method of the SecretBiz.
 func (b *secretBiz) Delete(ctx context.Context, rq *v1.DeleteSecretRequest) (*v1.DeleteSecretResponse, error) {
 	whr := where.T(ctx).F("name", rq.GetName())
+	_, secretList, err := b.store.Secret().List(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
 	if err := b.store.Secret().Delete(ctx, whr); err != nil {
 		return nil, err
 	}
 
+	secretIDs := make([]string, 0, len(secretList))
+	for _, secretM := range secretList {
+		secretIDs = append(secretIDs, secretM.SecretID)
+	}
+	if err := b.auth.InvalidateSecrets(ctx, secretIDs...); err != nil {
+		log.W(ctx).Errorw(err, "Failed to invalidate secrets", "secretIDs", secretIDs)
+		return nil, err
+	}
+
 	return &v1.DeleteSecretResponse{}, nil
 }
 
@@ -113,7 +135,7 @@ func (b *secretBiz) Get(ctx context.Context, rq *v1.GetSecretRequest) (*v1.GetSe
 	secretM, err := b.store.Secret().Get(ctx, whr)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, v1.ErrorSecretNotFound(err.Error()) // Return an error if secret is not found.
+			return nil, v1.ErrorSecretNotFound("%s", err.Error()) // Return an error if secret is not found.
 		}
 		return nil, err // Return any other error encountered.
 	}
@@ -0,0 +1,78 @@
+package session
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// browsers are matched in order, since most user agents mention several of them,
+// e.g. Edge claims to be Chrome and Chrome claims to be Safari.
+var browsers = []struct{ token, name string }{
+	{"Edg/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Firefox/", "Firefox"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+	{"curl/", "curl"},
+	{"okhttp/", "OkHttp"},
+	{"Go-http-client/", "Go"},
+}
+
+// systems are matched in order, e.g. Android user agents also mention Linux.
+var systems = []struct{ token, name string }{
+	{"Android", "Android"},
+	{"iPhone", "iOS"},
+	{"iPad", "iPadOS"},
+	{"Windows", "Windows"},
+	{"Mac OS X", "macOS"},
+	{"CrOS", "ChromeOS"},
+	{"Linux", "Linux"},
+}
+
+// Device returns a readable name of the device a user agent belongs to, such as "Chrome on macOS".
+func Device(userAgent string) string {
+	if userAgent == "" {
+		return "Unknown"
+	}
+
+	var browser, system string
+	for _, b := range browsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range systems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	case system != "":
+		return system
+	}
+
+	// Fall back to the product token of unknown clients, e.g. "MyApp/1.0 (...)".
+	product, _, _ := strings.Cut(userAgent, " ")
+	return truncate(product, 128)
+}
+
+// truncate shortens s to at most n bytes without splitting a character.
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+
+	s = s[:n]
+	for !utf8.ValidString(s) {
+		s = s[:len(s)-1]
+	}
+
+	return s
+}
@@ -0,0 +1,198 @@
+package session
+
+//go:generate mockgen -destination mock_session.go -package session github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session SessionBiz
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/moweilong/milady/pkg/log"
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// SessionBiz defines the interface that contains methods for managing the login sessions.
+// The requests carry a userID only on the administrator routes, the logged-in
+// user is used otherwise.
+type SessionBiz interface {
+	// List returns the active sessions of a user.
+	List(ctx context.Context, rq *v1.ListSessionRequest) (*v1.ListSessionResponse, error)
+
+	// Delete revokes a session of a user.
+	Delete(ctx context.Context, rq *v1.DeleteSessionRequest) (*v1.DeleteSessionResponse, error)
+
+	// DeleteAll revokes all sessions of a user.
+	DeleteAll(ctx context.Context, rq *v1.DeleteAllSessionRequest) (*v1.DeleteAllSessionResponse, error)
+
+	// SessionExpansion defines additional methods for extended session operations, if needed.
+	SessionExpansion
+}
+
+// SessionExpansion defines additional methods for session operations.
+type SessionExpansion interface{}
+
+// sessionBiz is the implementation of the SessionBiz.
+type sessionBiz struct {
+	store    store.IStore
+	sessions auth.SessionStore
+}
+
+// Ensure that *sessionBiz implements the SessionBiz.
+var _ SessionBiz = (*sessionBiz)(nil)
+
+// New creates and returns a new instance of *sessionBiz.
+func New(store store.IStore, sessions auth.SessionStore) *sessionBiz {
+	return &sessionBiz{store: store, sessions: sessions}
+}
+
+// List implements the List method of the SessionBiz.
+func (b *sessionBiz) List(ctx context.Context, rq *v1.ListSessionRequest) (*v1.ListSessionResponse, error) {
+	userID := targetUserID(ctx, rq.GetUserID())
+
+	// Sessions whose refresh token has expired can no longer be used, they are cleaned up here.
+	if err := b.store.UserSession().Delete(ctx, where.F("userID", userID).Q("expiresAt < ?", time.Now())); err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete expired sessions", "userID", userID)
+	}
+
+	_, sessionList, err := b.store.UserSession().List(ctx, where.F("userID", userID))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list sessions from storage")
+		return nil, err
+	}
+
+	sessionIDs := make([]string, 0, len(sessionList))
+	for _, sessionM := range sessionList {
+		sessionIDs = append(sessionIDs, sessionM.SessionID)
+	}
+
+	// The database only records when a session was refreshed, every request is tracked in Redis.
+	seen, err := b.sessions.LastSeen(ctx, sessionIDs...)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to get last seen time of sessions")
+		return nil, err
+	}
+
+	current := contextx.SessionID(ctx)
+	sessions := make([]*v1.Session, 0, len(sessionList))
+	for _, sessionM := range sessionList {
+		if t, ok := seen[sessionM.SessionID]; ok && t.After(sessionM.LastSeenAt) {
+			sessionM.LastSeenAt = t
+		}
+
+		session := conversion.UserSessionMToSessionV1(sessionM)
+		session.Current = sessionM.SessionID == current
+		sessions = append(sessions, session)
+	}
+
+	return &v1.ListSessionResponse{Total: int64(len(sessions)), Sessions: sessions}, nil
+}
+
+// Delete implements the Delete method of the SessionBiz.
+func (b *sessionBiz) Delete(ctx context.Context, rq *v1.DeleteSessionRequest) (*v1.DeleteSessionResponse, error) {
+	userID := targetUserID(ctx, rq.GetUserID())
+
+	// Filtering by userID makes the sessions of other users look nonexistent.
+	sessionM, err := b.store.UserSession().Get(ctx, where.F("userID", userID, "sessionID", rq.GetSessionID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorSessionNotFound("session %s not found", rq.GetSessionID())
+		}
+		return nil, err
+	}
+
+	if err := Revoke(ctx, b.store, b.sessions, sessionM); err != nil {
+		log.W(ctx).Errorw(err, "Failed to revoke session", "sessionID", sessionM.SessionID)
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Session revoked", "userID", userID, "sessionID", sessionM.SessionID, "operator", contextx.UserID(ctx))
+
+	return &v1.DeleteSessionResponse{}, nil
+}
+
+// DeleteAll implements the DeleteAll method of the SessionBiz.
+func (b *sessionBiz) DeleteAll(ctx context.Context, rq *v1.DeleteAllSessionRequest) (*v1.DeleteAllSessionResponse, error) {
+	userID := targetUserID(ctx, rq.GetUserID())
+
+	var except string
+	if rq.GetExceptCurrent() {
+		except = contextx.SessionID(ctx)
+	}
+
+	count, err := RevokeAll(ctx, b.store, b.sessions, userID, except)
+	if err != nil {
+		return nil, err
+	}
+
+	log.W(ctx).Infow("Sessions revoked", "userID", userID, "count", count, "operator", contextx.UserID(ctx))
+
+	return &v1.DeleteAllSessionResponse{Count: count}, nil
+}
+
+// NewUserSessionM creates the record of a session started by the current request.
+func NewUserSessionM(ctx context.Context, userID, sessionID string, expiresAt time.Time) *model.UserSessionM {
+	userAgent := contextx.UserAgent(ctx)
+
+	return &model.UserSessionM{
+		SessionID:  sessionID,
+		UserID:     userID,
+		Device:     Device(userAgent),
+		IP:         contextx.ClientIP(ctx),
+		UserAgent:  truncate(userAgent, 512),
+		LastSeenAt: time.Now(),
+		ExpiresAt:  expiresAt,
+	}
+}
+
+// Revoke rejects the access and refresh tokens of the session on all replicas
+// and deletes its record. The revocation is written first, so that a failure
+// never leaves a session usable without a record.
+func Revoke(ctx context.Context, store store.IStore, sessions auth.SessionStore, sessionM *model.UserSessionM) error {
+	if err := sessions.Revoke(ctx, sessionM.SessionID, time.Until(sessionM.ExpiresAt)); err != nil {
+		return err
+	}
+
+	return store.UserSession().Delete(ctx, where.F("sessionID", sessionM.SessionID))
+}
+
+// RevokeAll revokes all sessions of userID except the session identified by
+// except, and returns the number of revoked sessions.
+func RevokeAll(ctx context.Context, store store.IStore, sessions auth.SessionStore, userID, except string) (int64, error) {
+	_, sessionList, err := store.UserSession().List(ctx, where.F("userID", userID))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list sessions from storage")
+		return 0, err
+	}
+
+	var count int64
+	for _, sessionM := range sessionList {
+		if except != "" && sessionM.SessionID == except {
+			continue
+		}
+
+		if err := Revoke(ctx, store, sessions, sessionM); err != nil {
+			log.W(ctx).Errorw(err, "Failed to revoke session", "sessionID", sessionM.SessionID)
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// targetUserID returns the user whose sessions are managed by the request.
+func targetUserID(ctx context.Context, userID string) string {
+	if userID != "" {
+		return userID
+	}
+
+	return contextx.UserID(ctx)
+}
@@ -7,20 +7,29 @@ import (
 	"errors"
 	"regexp"
 	"sync"
+	"time"
 
-	"github.com/moweilong/milady/pkg/authn"
 	"github.com/moweilong/milady/pkg/core"
+	"github.com/moweilong/milady/pkg/i18n"
 	"github.com/moweilong/milady/pkg/log"
 	"github.com/moweilong/milady/pkg/store/where"
 	"golang.org/x/sync/errgroup"
 	"gorm.io/gorm"
 
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/conversion"
-	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/validation"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
 	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
 )
 
@@ -55,20 +64,46 @@ type UserExpansion interface {
 // userBiz is the implementation of the UserBiz.
 type userBiz struct {
 	store store.IStore
+	// auth grants the role of the new users and revokes the roles of the deleted users.
+	auth    auth.AuthProvider
+	captcha captcha.Captcha
+	// policy rejects the reuse of recent passwords.
+	policy *pwdpolicy.Policy
+	// registration decides whether new users have to verify their email.
+	registration registration.Registration
+	// mailer sends the email verification links.
+	mailer mail.Mailer
+	// sessions revokes the sessions of the deleted users on all replicas.
+	sessions auth.SessionStore
 }
 
 // Ensure that *userBiz implements the UserBiz.
 var _ UserBiz = (*userBiz)(nil)
 
 // New creates and returns a new instance of *userBiz.
-func New(store store.IStore) *userBiz {
-	return &userBiz{store: store}
+func New(store store.IStore, auth auth.AuthProvider, captcha captcha.Captcha, policy *pwdpolicy.Policy, registration registration.Registration, mailer mail.Mailer, sessions auth.SessionStore) *userBiz {
+	return &userBiz{store: store, auth: auth, captcha: captcha, policy: policy, registration: registration, mailer: mailer, sessions: sessions}
 }
 
 // Create implements the Create method of the UserBiz.
 func (b *userBiz) Create(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	if b.captcha.RegisterRequired() {
+		ok, err := b.captcha.Verify(ctx, rq.CaptchaID, rq.Captcha)
+		if err != nil {
+			log.W(ctx).Errorw(err, "Failed to verify captcha")
+			return nil, err
+		}
+		if !ok {
+			return nil, v1.ErrorInvalidCaptcha("%s", i18n.FromContext(ctx).T(locales.InvalidCaptcha))
+		}
+	}
+
 	var userM model.UserM
 	_ = core.Copy(&userM, rq) // Copy request data to the User model.
+	// Users can only log in once they verified their email, if it is required.
+	if b.registration.Enabled() {
+		userM.Status = known.UserStatusRegistered
+	}
 
 	// Start a transaction for creating the user and secret.
 	err := b.store.TX(ctx, func(ctx context.Context) error {
@@ -99,7 +134,24 @@ func (b *userBiz) Create(ctx context.Context, rq *v1.CreateUserRequest) (*v1.Cre
 		return nil, err // Return any error from the transaction.
 	}
 
-	return &v1.CreateUserResponse{UserID: userM.UserID}, nil
+	// Every user has the permissions of role::user. The role is stored by casbin
+	// out of the transaction, so the user is deleted again when it can not be
+	// granted, otherwise a retry would fail because the username exists.
+	if _, err := b.auth.AddRoleForUser(userM.UserID, known.RoleUser); err != nil {
+		log.W(ctx).Errorw(err, "Failed to add role for user", "userID", userM.UserID, "role", known.RoleUser)
+		if err := b.deleteRecords(ctx, userM.UserID); err != nil {
+			log.W(ctx).Errorw(err, "Failed to delete user without role", "userID", userM.UserID)
+		}
+		return nil, errno.ErrAddRole
+	}
+
+	registered := userM.Status == known.UserStatusRegistered
+	if registered {
+		// The user is created anyway, another mail can be requested with ResendVerification.
+		_ = SendVerification(ctx, b.registration, b.mailer, &userM)
+	}
+
+	return &v1.CreateUserResponse{UserID: userM.UserID, VerificationRequired: registered}, nil
 }
 
 // Update implements the Update method of the UserBiz.
@@ -129,31 +181,89 @@ func (b *userBiz) Update(ctx context.Context, rq *v1.UpdateUserRequest) (*v1.Upd
 
 // Delete implements the Delete method of the UserBiz.
 func (b *userBiz) Delete(ctx context.Context, rq *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
-	userID := contextx.UserID(ctx)
-	// Limit access to authorized users only.
-	if validation.IsAdminUser(contextx.UserID(ctx)) {
-		userID = rq.UserID
+	// The users out of the data scope of the logged-in user are not found.
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, v1.ErrorUserNotFound("%s", err.Error())
+		}
+		return nil, err
 	}
+	userID := userM.UserID
 
-	if err := b.store.User().Delete(ctx, where.F("userID", userID)); err != nil {
+	if err := b.deleteRecords(ctx, userID); err != nil {
 		return nil, err
 	}
 
+	// Revoke the roles and remove the policies of the user once the records are gone,
+	// so that a reused userID gains no permissions. Casbin does not join the transaction.
+	if _, err := b.auth.DeleteUser(userID); err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete casbin user", "userID", userID)
+		return nil, errno.ErrRemoveRole
+	}
+
 	return &v1.DeleteUserResponse{}, nil
 }
 
-// Get implements the Get method of the UserBiz.
-func (b *userBiz) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error) {
-	userID := contextx.UserID(ctx)
-	// Limit access to authorized users only.
-	if validation.IsAdminUser(contextx.UserID(ctx)) {
-		userID = rq.UserID
+// deleteRecords deletes the user and all the records of the user in one
+// transaction, and evicts the secrets of the user from the secret cache of
+// every replica.
+func (b *userBiz) deleteRecords(ctx context.Context, userID string) error {
+	var secretIDs []string
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.store.User().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+
+		if err := b.store.UserMFA().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+
+		// Unlink the external identities, so that the next single sign-on provisions a new user.
+		if err := b.store.UserIdentity().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+
+		// Verifying a token does not look up the user, so the sessions are revoked
+		// for the tokens of the deleted user to be rejected by the gateways as well.
+		if _, err := session.RevokeAll(ctx, b.store, b.sessions, userID, ""); err != nil {
+			return err
+		}
+
+		if err := b.store.UserPasswordHistory().Delete(ctx, where.F("userID", userID)); err != nil {
+			return err
+		}
+
+		_, secretList, err := b.store.Secret().List(ctx, where.F("userID", userID))
+		if err != nil {
+			return err
+		}
+		for _, secretM := range secretList {
+			secretIDs = append(secretIDs, secretM.SecretID)
+		}
+		return b.store.Secret().Delete(ctx, where.F("userID", userID))
+	})
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to delete user", "userID", userID)
+		return err
+	}
+
+	// Otherwise the secrets of the deleted user keep authenticating on the replicas which cached them.
+	if err := b.auth.InvalidateSecrets(ctx, secretIDs...); err != nil {
+		log.W(ctx).Errorw(err, "Failed to invalidate secrets", "secretIDs", secretIDs)
+		return err
 	}
 
-	userM, err := b.store.User().Get(ctx, where.F("userID", userID))
+	return nil
+}
+
+// Get implements the Get method of the UserBiz.
+func (b *userBiz) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error) {
+	// The users out of the data scope of the logged-in user are not found.
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.GetUserID()))
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, v1.ErrorUserNotFound(err.Error()) // Return an error if the user is not found.
+			return nil, v1.ErrorUserNotFound("%s", err.Error()) // Return an error if the user is not found.
 		}
 		return nil, err // Return any other error encountered.
 	}
@@ -164,6 +274,14 @@ func (b *userBiz) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserRe
 // List implements the List method of the UserBiz.
 func (b *userBiz) List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error) {
 	whr := where.P(int(rq.GetOffset()), int(rq.GetLimit()))
+	if rq.GetDepartmentID() != "" {
+		// The users of the descendants belong to the department as well.
+		departmentIDs, err := b.store.Department().Descendants(ctx, rq.GetDepartmentID())
+		if err != nil {
+			return nil, err
+		}
+		whr = whr.F("departmentId", departmentIDs)
+	}
 	count, userList, err := b.store.User().List(ctx, whr)
 	if err != nil {
 		return nil, err
@@ -215,20 +333,77 @@ func (b *userBiz) List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUse
 
 // UpdatePassword updates the password for a user based on the provided request.
 func (b *userBiz) UpdatePassword(ctx context.Context, rq *v1.UpdatePasswordRequest) (*v1.UpdatePasswordResponse, error) {
-	// Retrieve the user by username.
-	userM, err := b.store.User().Get(ctx, where.T(ctx))
+	// Retrieve the logged-in user, the validation makes sure it is the user of the request.
+	userM, err := b.store.User().Get(ctx, where.F("userID", contextx.UserID(ctx)))
 	if err != nil {
 		return nil, err // Return any error encountered.
 	}
 
 	// Compare the old password with the stored password.
-	if err := authn.Compare(userM.Password, rq.OldPassword); err != nil {
+	if err := pwdhash.Verify(userM.Password, rq.OldPassword); err != nil {
 		return nil, v1.ErrorUserLoginFailed("password incorrect") // Return an error if the old password is incorrect.
 	}
-	// Encrypt the new password.
-	userM.Password, _ = authn.Encrypt(rq.NewPassword)
 
-	return &v1.UpdatePasswordResponse{}, b.store.User().Update(ctx, userM) // Update the user's password in the data store.
+	if err := ChangePassword(ctx, b.store, b.policy, userM, rq.NewPassword); err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdatePasswordResponse{}, nil
+}
+
+// ChangePassword replaces the password of userM with password, which must
+// already comply with the policy, unless it is one of the recent passwords of
+// the user. The replaced password is added to the password history, which is
+// trimmed to the size remembered by the policy.
+func ChangePassword(ctx context.Context, store store.IStore, policy *pwdpolicy.Policy, userM *model.UserM, password string) error {
+	// The history is listed newest first.
+	_, historyList, err := store.UserPasswordHistory().List(ctx, where.F("userID", userM.UserID))
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to list password history from storage")
+		return err
+	}
+
+	hashes := make([]string, 0, len(historyList)+1)
+	hashes = append(hashes, userM.Password)
+	for _, historyM := range historyList {
+		hashes = append(hashes, historyM.Password)
+	}
+	if err := pwdpolicy.Error(ctx, policy.CheckHistory(password, hashes)); err != nil {
+		return err
+	}
+
+	hash, err := pwdhash.Hash(password)
+	if err != nil {
+		return err
+	}
+
+	historyM := &model.UserPasswordHistoryM{UserID: userM.UserID, Password: userM.Password}
+	userM.Password = hash
+	userM.PasswordChangedAt = time.Now()
+
+	return store.TX(ctx, func(ctx context.Context) error {
+		if err := store.User().Update(ctx, userM); err != nil {
+			return err
+		}
+
+		// The current password counts towards the history size, so the history
+		// keeps one password less, starting with the one just replaced.
+		keep := policy.HistorySize() - 1
+		if keep > 0 {
+			if err := store.UserPasswordHistory().Create(ctx, historyM); err != nil {
+				return err
+			}
+			keep--
+		}
+		if len(historyList) <= keep {
+			return nil
+		}
+		ids := make([]int64, 0, len(historyList)-keep)
+		for _, historyM := range historyList[keep:] {
+			ids = append(ids, historyM.ID)
+		}
+		return store.UserPasswordHistory().Delete(ctx, where.F("id", ids))
+	})
 }
 
 // ListWithBadPerformance is a poor performance implementation of List.
@@ -0,0 +1,40 @@
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/moweilong/milady/pkg/i18n"
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
+)
+
+// SendVerification mails a new email verification link to a registered user,
+// the links mailed before stop working.
+func SendVerification(ctx context.Context, reg registration.Registration, mailer mail.Mailer, userM *model.UserM) error {
+	opts := reg.Options()
+	token, err := reg.Issue(ctx, userM.UserID)
+	if err != nil {
+		log.W(ctx).Errorw(err, "Failed to issue email verification token")
+		return err
+	}
+
+	translator := i18n.FromContext(ctx)
+	msg := &mail.Message{
+		To:      userM.Email,
+		Subject: translator.T(locales.EmailVerifySubject),
+		Body:    fmt.Sprintf(translator.T(locales.EmailVerifyBody), userM.Nickname, mail.TokenLink(opts.URL, token), int(opts.Expiration.Hours())),
+	}
+	if err := mailer.Send(ctx, msg); err != nil {
+		log.W(ctx).Errorw(err, "Failed to send email verification mail", "userID", userM.UserID)
+		return err
+	}
+
+	log.W(ctx).Infow("Email verification mail sent", "userID", userM.UserID)
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package apiserver
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestDataScope(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	createDepartment := func(parentID, name string) string {
+		t.Helper()
+
+		var resp v1.CreateDepartmentResponse
+		if code, reason := do(t, engine, "/v1/departments", admin.AccessToken, &v1.CreateDepartmentRequest{ParentID: parentID, Name: name}, &resp); code != http.StatusOK {
+			t.Fatalf("create department %s: got status %d (%s)", name, code, reason)
+		}
+		return resp.DepartmentID
+	}
+	headquartersID := createDepartment("", "Scope Headquarters")
+	researchID := createDepartment(headquartersID, "Scope Research")
+	backendID := createDepartment(researchID, "Scope Backend")
+	salesID := createDepartment(headquartersID, "Scope Sales")
+
+	// The manager and a peer work in research, the others in the child department, in sales and nowhere.
+	users := make(map[string]*testUser)
+	userIDs := make(map[string]string)
+	for _, user := range []struct{ username, departmentID string }{
+		{"scopemanager", researchID},
+		{"scopepeer", researchID},
+		{"scopedev", backendID},
+		{"scopeseller", salesID},
+		{"scopeloner", ""},
+	} {
+		users[user.username] = createUser(t, engine, user.username)
+		userIDs[user.username] = users[user.username].UserID
+		if user.departmentID == "" {
+			continue
+		}
+		department := &v1.UpdateUserDepartmentRequest{DepartmentID: user.departmentID}
+		if code, _ := doRequest(t, engine, http.MethodPut, "/v1/users/"+userIDs[user.username]+"/department", admin.AccessToken, department, nil); code != http.StatusOK {
+			t.Fatalf("move user %s: got status %d", user.username, code)
+		}
+	}
+	manager := users["scopemanager"].login(t, engine)
+	managerID := userIDs["scopemanager"]
+
+	createRole := func(rq *v1.CreateRoleRequest) {
+		t.Helper()
+
+		if code, reason := do(t, engine, "/v1/roles", admin.AccessToken, rq, nil); code != http.StatusOK {
+			t.Fatalf("create role %s: got status %d (%s)", rq.Name, code, reason)
+		}
+	}
+	scope := func(dataScope int32) *int32 { return &dataScope }
+	remove := func(role string) {
+		t.Helper()
+
+		if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+managerID+"/roles/"+role, admin.AccessToken, nil, nil); code != http.StatusOK {
+			t.Fatalf("remove role %s: got status %d", role, code)
+		}
+	}
+	// visible returns the users of the test the manager can see.
+	visible := func() []string {
+		t.Helper()
+
+		var resp v1.ListUserResponse
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/users?limit=100", manager.AccessToken, nil, &resp); code != http.StatusOK {
+			t.Fatalf("list users: got status %d (%s)", code, reason)
+		}
+		var usernames []string
+		for _, user := range resp.Users {
+			if _, ok := userIDs[user.Username]; ok {
+				usernames = append(usernames, user.Username)
+			}
+		}
+		slices.Sort(usernames)
+		return usernames
+	}
+	getUser := func(username string) int {
+		t.Helper()
+
+		code, _ := doRequest(t, engine, http.MethodGet, "/v1/users/"+userIDs[username], manager.AccessToken, nil, nil)
+		return code
+	}
+
+	// Without other roles the users only see themselves.
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopemanager"}) {
+		t.Fatalf("users of the self scope: got %v", usernames)
+	}
+	if code := getUser("scopemanager"); code != http.StatusOK {
+		t.Fatalf("get self: got status %d", code)
+	}
+	if code := getUser("scopepeer"); code != http.StatusNotFound {
+		t.Fatalf("get user out of the scope: got status %d, want %d", code, http.StatusNotFound)
+	}
+
+	// Invalid data scopes are rejected.
+	invalid := []*v1.CreateRoleRequest{
+		{Name: "role::scopeinvalid", DataScope: scope(6)},
+		{Name: "role::scopeinvalid", DataScope: scope(known.DataScopeCustom)},
+		{Name: "role::scopeinvalid", DataScope: scope(known.DataScopeDepartment), DepartmentIDs: []string{salesID}},
+		{Name: "role::scopeinvalid", DepartmentIDs: []string{salesID}},
+	}
+	for _, rq := range invalid {
+		if code, _ := do(t, engine, "/v1/roles", admin.AccessToken, rq, nil); code != http.StatusBadRequest {
+			t.Errorf("create role %+v: got status %d, want %d", rq, code, http.StatusBadRequest)
+		}
+	}
+	missing := &v1.CreateRoleRequest{Name: "role::scopeinvalid", DataScope: scope(known.DataScopeCustom), DepartmentIDs: []string{"dept-missing"}}
+	if code, reason := do(t, engine, "/v1/roles", admin.AccessToken, missing, nil); code != http.StatusNotFound || reason != v1.ErrorReason_DepartmentNotFound.String() {
+		t.Fatalf("create role with a missing department: got status %d (%s)", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/roles/"+known.RoleAdmin, admin.AccessToken, &v1.UpdateRoleRequest{DataScope: scope(known.DataScopeSelf)}, nil); code != http.StatusForbidden || reason != v1.ErrorReason_RoleBuiltin.String() {
+		t.Fatalf("change the data scope of the administrators: got status %d (%s)", code, reason)
+	}
+
+	// The users of the own department, then with its descendants.
+	createRole(&v1.CreateRoleRequest{Name: "role::scopedept", DataScope: scope(known.DataScopeDepartment)})
+	assignRoles(t, engine, admin, managerID, "role::scopedept")
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopemanager", "scopepeer"}) {
+		t.Fatalf("users of the department scope: got %v", usernames)
+	}
+	if code := getUser("scopepeer"); code != http.StatusOK {
+		t.Fatalf("get user in the department: got status %d", code)
+	}
+	// The data scope only decides which users are seen, only the administrators delete other users.
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+userIDs["scopepeer"], manager.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("delete a peer: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if code := getUser("scopepeer"); code != http.StatusOK {
+		t.Fatalf("get peer after a refused deletion: got status %d", code)
+	}
+	createRole(&v1.CreateRoleRequest{Name: "role::scopetree", DataScope: scope(known.DataScopeDepartmentAndChildren)})
+	assignRoles(t, engine, admin, managerID, "role::scopetree")
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopedev", "scopemanager", "scopepeer"}) {
+		t.Fatalf("users of the department and children scope: got %v", usernames)
+	}
+
+	// The custom departments are merged with the other scopes, and can be replaced.
+	createRole(&v1.CreateRoleRequest{Name: "role::scopecustom", DataScope: scope(known.DataScopeCustom), DepartmentIDs: []string{salesID, salesID}})
+	assignRoles(t, engine, admin, managerID, "role::scopecustom")
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopedev", "scopemanager", "scopepeer", "scopeseller"}) {
+		t.Fatalf("users of the merged scopes: got %v", usernames)
+	}
+	var role v1.GetRoleResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles/role::scopecustom", admin.AccessToken, nil, &role); code != http.StatusOK {
+		t.Fatalf("get role: got status %d", code)
+	}
+	if role.Role.DataScope != known.DataScopeCustom || !slices.Equal(role.Role.DepartmentIDs, []string{salesID}) {
+		t.Fatalf("get role: got data scope %d departments %v", role.Role.DataScope, role.Role.DepartmentIDs)
+	}
+	remove("role::scopedept")
+	remove("role::scopetree")
+	custom := &v1.UpdateRoleRequest{DataScope: scope(known.DataScopeCustom), DepartmentIDs: []string{backendID}}
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/roles/role::scopecustom", admin.AccessToken, custom, nil); code != http.StatusOK {
+		t.Fatalf("replace custom departments: got status %d (%s)", code, reason)
+	}
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopedev", "scopemanager"}) {
+		t.Fatalf("users of the replaced custom scope: got %v", usernames)
+	}
+
+	// A disabled role grants no data scope, the all scope grants every user.
+	createRole(&v1.CreateRoleRequest{Name: "role::scopeall", DataScope: scope(known.DataScopeAll)})
+	assignRoles(t, engine, admin, managerID, "role::scopeall")
+	if usernames := visible(); len(usernames) != len(userIDs) {
+		t.Fatalf("users of the all scope: got %v", usernames)
+	}
+	disabled := int32(known.RoleStatusDisabled)
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/roles/role::scopeall", admin.AccessToken, &v1.UpdateRoleRequest{Status: &disabled}, nil); code != http.StatusOK {
+		t.Fatalf("disable role: got status %d", code)
+	}
+	if usernames := visible(); !slices.Equal(usernames, []string{"scopedev", "scopemanager"}) {
+		t.Fatalf("users of a disabled all scope: got %v", usernames)
+	}
+
+	// The members of role::admin are administrators, who see and delete every user.
+	assignRoles(t, engine, admin, managerID, known.RoleAdmin)
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/users/"+userIDs["scopeseller"], manager.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete user as a member of %s: got status %d (%s)", known.RoleAdmin, code, reason)
+	}
+	remove(known.RoleAdmin)
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+userIDs["scopeloner"], manager.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("delete user after leaving %s: got status %d, want %d", known.RoleAdmin, code, http.StatusForbidden)
+	}
+
+	// Deleting a department unbinds it from the roles.
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/users/"+userIDs["scopedev"]+"/department", admin.AccessToken, &v1.UpdateUserDepartmentRequest{}, nil); code != http.StatusOK {
+		t.Fatalf("remove user from department: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/departments/"+backendID, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete department: got status %d", code)
+	}
+	var unbound v1.GetRoleResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles/role::scopecustom", admin.AccessToken, nil, &unbound); code != http.StatusOK || len(unbound.Role.DepartmentIDs) != 0 {
+		t.Fatalf("role of a deleted department: got status %d departments %v", code, unbound.Role.DepartmentIDs)
+	}
+}
@@ -0,0 +1,164 @@
+package apiserver
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// departmentNames returns the names of the departments and their children in depth-first order.
+func departmentNames(departments []*v1.Department) []string {
+	var names []string
+	for _, department := range departments {
+		names = append(names, department.Name)
+		names = append(names, departmentNames(department.Children)...)
+	}
+	return names
+}
+
+func TestDepartmentManagement(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	leaderID := createUser(t, engine, "deptleader").UserID
+	member := createUser(t, engine, "deptmember")
+	memberID := member.UserID
+	login := member.login(t, engine)
+
+	createDepartment := func(rq *v1.CreateDepartmentRequest) string {
+		t.Helper()
+
+		var resp v1.CreateDepartmentResponse
+		if code, reason := do(t, engine, "/v1/departments", admin.AccessToken, rq, &resp); code != http.StatusOK {
+			t.Fatalf("create department %s: got status %d (%s)", rq.Name, code, reason)
+		}
+		return resp.DepartmentID
+	}
+	moveUser := func(userID, departmentID string) (int, string) {
+		t.Helper()
+
+		rq := &v1.UpdateUserDepartmentRequest{DepartmentID: departmentID}
+		return doRequest(t, engine, http.MethodPut, "/v1/users/"+userID+"/department", admin.AccessToken, rq, nil)
+	}
+	listUsers := func(departmentID string) []string {
+		t.Helper()
+
+		var resp v1.ListUserResponse
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/users?limit=100&departmentID="+departmentID, admin.AccessToken, nil, &resp); code != http.StatusOK {
+			t.Fatalf("list users of %s: got status %d (%s)", departmentID, code, reason)
+		}
+		var usernames []string
+		for _, user := range resp.Users {
+			usernames = append(usernames, user.Username)
+		}
+		slices.Sort(usernames)
+		return usernames
+	}
+
+	// Only the administrator manages the departments.
+	headquarters := &v1.CreateDepartmentRequest{Name: "Headquarters", LeaderID: leaderID}
+	if code, _ := do(t, engine, "/v1/departments", login.AccessToken, headquarters, nil); code != http.StatusForbidden {
+		t.Fatalf("create department as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+	headquartersID := createDepartment(headquarters)
+	researchID := createDepartment(&v1.CreateDepartmentRequest{ParentID: headquartersID, Name: "Research", Sort: 2})
+	backendID := createDepartment(&v1.CreateDepartmentRequest{ParentID: researchID, Name: "Backend"})
+	salesID := createDepartment(&v1.CreateDepartmentRequest{ParentID: headquartersID, Name: "Sales", Sort: 1})
+
+	if code, _ := do(t, engine, "/v1/departments", admin.AccessToken, &v1.CreateDepartmentRequest{}, nil); code != http.StatusBadRequest {
+		t.Fatalf("create department without name: got status %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, reason := do(t, engine, "/v1/departments", admin.AccessToken, &v1.CreateDepartmentRequest{ParentID: "dept-missing", Name: "Other"}, nil); code != http.StatusNotFound || reason != v1.ErrorReason_DepartmentNotFound.String() {
+		t.Fatalf("create department under a missing parent: got status %d (%s)", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/departments", admin.AccessToken, &v1.CreateDepartmentRequest{Name: "Other", LeaderID: "user-missing"}, nil); code != http.StatusNotFound || reason != v1.ErrorReason_UserNotFound.String() {
+		t.Fatalf("create department with a missing leader: got status %d (%s)", code, reason)
+	}
+
+	// All users can read the tree, which is ordered by sort.
+	var tree v1.ListDepartmentResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/departments", login.AccessToken, nil, &tree); code != http.StatusOK {
+		t.Fatalf("list departments: got status %d", code)
+	}
+	if names := departmentNames(tree.Departments); !slices.Equal(names, []string{"Headquarters", "Sales", "Research", "Backend"}) {
+		t.Fatalf("department tree: got %v", names)
+	}
+	var got v1.GetDepartmentResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/departments/"+headquartersID, login.AccessToken, nil, &got); code != http.StatusOK {
+		t.Fatalf("get department: got status %d", code)
+	}
+	if got.Department.LeaderID != leaderID || got.Department.Status != known.DepartmentStatusEnabled {
+		t.Fatalf("get department: got %+v", got.Department)
+	}
+	var subtree v1.GetDepartmentSubtreeResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/departments/"+researchID+"/subtree", login.AccessToken, nil, &subtree); code != http.StatusOK {
+		t.Fatalf("get subtree: got status %d", code)
+	}
+	if names := departmentNames([]*v1.Department{subtree.Department}); !slices.Equal(names, []string{"Research", "Backend"}) {
+		t.Fatalf("subtree: got %v", names)
+	}
+	var ancestors v1.ListDepartmentAncestorResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/departments/"+backendID+"/ancestors", login.AccessToken, nil, &ancestors); code != http.StatusOK {
+		t.Fatalf("list ancestors: got status %d", code)
+	}
+	if names := departmentNames(ancestors.Departments); !slices.Equal(names, []string{"Headquarters", "Research"}) {
+		t.Fatalf("ancestors: got %v", names)
+	}
+
+	// Users are listed with the users of the descendants.
+	if code, _ := moveUser(memberID, backendID); code != http.StatusOK {
+		t.Fatalf("move user: got status %d", code)
+	}
+	if code, _ := moveUser(leaderID, salesID); code != http.StatusOK {
+		t.Fatalf("move leader: got status %d", code)
+	}
+	if code, reason := moveUser(memberID, "dept-missing"); code != http.StatusNotFound || reason != v1.ErrorReason_DepartmentNotFound.String() {
+		t.Fatalf("move user to a missing department: got status %d (%s)", code, reason)
+	}
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/users/"+memberID+"/department", login.AccessToken, &v1.UpdateUserDepartmentRequest{DepartmentID: salesID}, nil); code != http.StatusForbidden {
+		t.Fatalf("move user as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if usernames := listUsers(headquartersID); !slices.Equal(usernames, []string{"deptleader", "deptmember"}) {
+		t.Fatalf("users of the headquarters: got %v", usernames)
+	}
+	if usernames := listUsers(researchID); !slices.Equal(usernames, []string{"deptmember"}) {
+		t.Fatalf("users of research: got %v", usernames)
+	}
+
+	// Moving a department under its own descendant is rejected, the subtree moves with the department.
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/departments/"+researchID, admin.AccessToken, &v1.UpdateDepartmentRequest{ParentID: &backendID}, nil); code != http.StatusBadRequest {
+		t.Fatalf("move department under its descendant: got status %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/departments/"+researchID, admin.AccessToken, &v1.UpdateDepartmentRequest{ParentID: &salesID}, nil); code != http.StatusOK {
+		t.Fatalf("move department: got status %d (%s)", code, reason)
+	}
+	if usernames := listUsers(salesID); !slices.Equal(usernames, []string{"deptleader", "deptmember"}) {
+		t.Fatalf("users of sales after moving: got %v", usernames)
+	}
+
+	// Departments with children or users can not be deleted.
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/departments/"+researchID, admin.AccessToken, nil, nil); code != http.StatusConflict || reason != v1.ErrorReason_DepartmentHasChildren.String() {
+		t.Fatalf("delete department with children: got status %d (%s)", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/departments/"+backendID, admin.AccessToken, nil, nil); code != http.StatusConflict || reason != v1.ErrorReason_DepartmentHasUsers.String() {
+		t.Fatalf("delete department with users: got status %d (%s)", code, reason)
+	}
+	if code, _ := moveUser(memberID, ""); code != http.StatusOK {
+		t.Fatalf("remove user from department: got status %d", code)
+	}
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/departments/"+backendID, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete department: got status %d (%s)", code, reason)
+	}
+
+	// Users can not be moved to a disabled department.
+	disabled := int32(known.DepartmentStatusDisabled)
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/departments/"+researchID, admin.AccessToken, &v1.UpdateDepartmentRequest{Status: &disabled}, nil); code != http.StatusOK {
+		t.Fatalf("disable department: got status %d", code)
+	}
+	if code, reason := moveUser(memberID, researchID); code != http.StatusBadRequest || reason != v1.ErrorReason_DepartmentDisabled.String() {
+		t.Fatalf("move user to a disabled department: got status %d (%s)", code, reason)
+	}
+}
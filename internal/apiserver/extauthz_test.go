@@ -0,0 +1,214 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// newExtAuthzServer creates a user who must not delete orders, and returns the
+// server wired with the policy together with the login of the user.
+func newExtAuthzServer(t *testing.T, username string) (*ServerConfig, *gin.Engine, *testUser, *v1.LoginReply) {
+	t.Helper()
+
+	engine, _ := newTestEngine(t)
+	user := createUser(t, engine, username)
+
+	// The policies are loaded when the server is created, so add the policy before creating it.
+	if err := store.S.DB(context.Background()).Exec("INSERT INTO casbin_rule (ptype, v0, v1, v2, v3) VALUES ('p', ?, '/v1/orders/*', 'DELETE', 'deny')", user.UserID).Error; err != nil {
+		t.Fatalf("add policy: %v", err)
+	}
+
+	cfg, engine, _ := newTestServer(t)
+	return cfg, engine, user, user.login(t, engine)
+}
+
+// forwardAuth calls the forward-auth endpoint the way a gateway does.
+func forwardAuth(t *testing.T, engine *gin.Engine, token string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rq := httptest.NewRequest(http.MethodGet, "/v1/auth/forward", nil)
+	if token != "" {
+		rq.Header.Set("Authorization", "Bearer "+token)
+	}
+	for key, value := range headers {
+		rq.Header.Set(key, value)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, rq)
+	return w
+}
+
+func TestForwardAuth(t *testing.T) {
+	_, engine, created, login := newExtAuthzServer(t, "forwarded")
+	claims, err := auth.ParseUnverified(login.AccessToken)
+	if err != nil {
+		t.Fatalf("parse access token: %v", err)
+	}
+
+	// Traefik passes the original request in the X-Forwarded-* headers.
+	w := forwardAuth(t, engine, login.AccessToken, map[string]string{"X-Forwarded-Method": "GET", "X-Forwarded-Uri": "/v1/orders/1?expand=items"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("forward auth: got status %d: %s", w.Code, w.Body.String())
+	}
+	if got := w.Header().Get(known.XUserID); got != created.UserID {
+		t.Fatalf("forward auth: got user %q, want %q", got, created.UserID)
+	}
+	if got := w.Header().Get(known.XSessionID); got != claims.SessionID {
+		t.Fatalf("forward auth: got session %q, want %q", got, claims.SessionID)
+	}
+	if got := w.Header().Get(known.XActorID); got != "" {
+		t.Fatalf("forward auth: got actor %q without impersonation", got)
+	}
+
+	// nginx passes it in the X-Original-* headers.
+	w = forwardAuth(t, engine, login.AccessToken, map[string]string{"X-Original-Method": "DELETE", "X-Original-URI": "/v1/orders/1"})
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("forward denied request: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+	// Dot segments can not be used to escape the policies.
+	w = forwardAuth(t, engine, login.AccessToken, map[string]string{"X-Forwarded-Method": "DELETE", "X-Forwarded-Uri": "/v1/public/../orders/1"})
+	if w.Code != http.StatusForbidden {
+		t.Fatalf("forward request with dot segments: got status %d, want %d", w.Code, http.StatusForbidden)
+	}
+
+	for name, token := range map[string]string{"missing": "", "invalid": "not-a-token", "refresh": login.RefreshToken} {
+		w = forwardAuth(t, engine, token, map[string]string{"X-Forwarded-Method": "GET", "X-Forwarded-Uri": "/v1/orders/1"})
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("forward %s token: got status %d, want %d", name, w.Code, http.StatusUnauthorized)
+		}
+	}
+	// The original request is required.
+	if w = forwardAuth(t, engine, login.AccessToken, nil); w.Code != http.StatusBadRequest {
+		t.Fatalf("forward without the original request: got status %d, want %d", w.Code, http.StatusBadRequest)
+	}
+
+	// The administrator impersonating the user is passed on as the actor.
+	admin := loginAdmin(t, engine)
+	var impersonation v1.ImpersonateUserResponse
+	if code, _ := do(t, engine, "/v1/users/"+created.UserID+"/impersonate", admin.AccessToken, nil, &impersonation); code != http.StatusOK {
+		t.Fatalf("impersonate: got status %d", code)
+	}
+	w = forwardAuth(t, engine, impersonation.AccessToken, map[string]string{"X-Forwarded-Method": "GET", "X-Forwarded-Uri": "/v1/orders/1"})
+	if w.Code != http.StatusOK || w.Header().Get(known.XUserID) != created.UserID || w.Header().Get(known.XActorID) != known.AdminUserID {
+		t.Fatalf("forward impersonated request: got status %d user %q actor %q", w.Code, w.Header().Get(known.XUserID), w.Header().Get(known.XActorID))
+	}
+}
+
+func TestForwardAuthDeletedUser(t *testing.T) {
+	_, engine, created, login := newExtAuthzServer(t, "forwardeddeleted")
+	forwarded := map[string]string{"X-Forwarded-Method": "GET", "X-Forwarded-Uri": "/v1/orders/1"}
+	if w := forwardAuth(t, engine, login.AccessToken, forwarded); w.Code != http.StatusOK {
+		t.Fatalf("forward auth: got status %d", w.Code)
+	}
+
+	// Deleting the user revokes the sessions, the gateways reject the tokens issued before.
+	admin := loginAdmin(t, engine)
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+created.UserID, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete user: got status %d", code)
+	}
+	if w := forwardAuth(t, engine, login.AccessToken, forwarded); w.Code != http.StatusUnauthorized {
+		t.Fatalf("forward auth of a deleted user: got status %d, want %d", w.Code, http.StatusUnauthorized)
+	}
+}
+
+func TestExtAuthzCheck(t *testing.T) {
+	cfg, _, created, login := newExtAuthzServer(t, "enveloped")
+
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	cfg.InstallExtAuthz(srv)
+	go func() { _ = srv.Serve(lis) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial ext_authz server: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	client := authv3.NewAuthorizationClient(conn)
+
+	check := func(method, path, token string) *authv3.CheckResponse {
+		t.Helper()
+
+		headers := map[string]string{known.XActorID: "user-spoofed"}
+		if token != "" {
+			headers["authorization"] = "Bearer " + token
+		}
+		resp, err := client.Check(context.Background(), &authv3.CheckRequest{
+			Attributes: &authv3.AttributeContext{
+				Request: &authv3.AttributeContext_Request{
+					Http: &authv3.AttributeContext_HttpRequest{Method: method, Path: path, Headers: headers},
+				},
+			},
+		})
+		if err != nil {
+			t.Fatalf("check %s %s: %v", method, path, err)
+		}
+		return resp
+	}
+
+	resp := check(http.MethodGet, "/v1/orders/1?expand=items", login.AccessToken)
+	if codes.Code(resp.GetStatus().GetCode()) != codes.OK {
+		t.Fatalf("check: got status %v", resp.GetStatus())
+	}
+	headers := map[string]string{}
+	for _, header := range resp.GetOkResponse().GetHeaders() {
+		headers[header.GetHeader().GetKey()] = header.GetHeader().GetValue()
+	}
+	if headers[known.XUserID] != created.UserID {
+		t.Fatalf("check: got user %q, want %q", headers[known.XUserID], created.UserID)
+	}
+	// The identity headers sent by the client are removed.
+	removed := false
+	for _, key := range resp.GetOkResponse().GetHeadersToRemove() {
+		removed = removed || key == known.XActorID
+	}
+	if !removed {
+		t.Fatalf("check: the spoofed %s header must be removed", known.XActorID)
+	}
+
+	denied := []struct {
+		method, path, token string
+		code                codes.Code
+		status              int
+		reason              string
+	}{
+		{http.MethodDelete, "/v1/orders/1", login.AccessToken, codes.PermissionDenied, http.StatusForbidden, "PermissionDenied"},
+		{http.MethodGet, "/v1/orders/1", "", codes.Unauthenticated, http.StatusUnauthorized, "Unauthenticated"},
+	}
+	for _, tc := range denied {
+		resp := check(tc.method, tc.path, tc.token)
+		if got := codes.Code(resp.GetStatus().GetCode()); got != tc.code {
+			t.Fatalf("check %s %s: got code %v, want %v", tc.method, tc.path, got, tc.code)
+		}
+		deniedResponse := resp.GetDeniedResponse()
+		if got := int(deniedResponse.GetStatus().GetCode()); got != tc.status {
+			t.Fatalf("check %s %s: got status %d, want %d", tc.method, tc.path, got, tc.status)
+		}
+		var body core.ErrorResponse
+		if err := json.Unmarshal([]byte(deniedResponse.GetBody()), &body); err != nil || body.Reason != tc.reason {
+			t.Fatalf("check %s %s: got body %q, want reason %q", tc.method, tc.path, deniedResponse.GetBody(), tc.reason)
+		}
+	}
+}
@@ -0,0 +1,471 @@
+package apiserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gin-gonic/gin"
+	"github.com/glebarez/sqlite"
+	"github.com/moweilong/milady/pkg/authz"
+	"github.com/moweilong/milady/pkg/core"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/validation"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/lockout"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	mw "github.com/moweilong/art-design-pro-go/internal/pkg/middleware"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdreset"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+const (
+	chromeOnMac    = "Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/126.0.0.0 Safari/537.36"
+	firefoxOnLinux = "Mozilla/5.0 (X11; Linux x86_64; rv:127.0) Gecko/20100101 Firefox/127.0"
+)
+
+// loginFrom logs in from the device identified by userAgent.
+func loginFrom(t *testing.T, engine *gin.Engine, username, password, userAgent string) *v1.LoginReply {
+	t.Helper()
+
+	body, _ := json.Marshal(&v1.LoginRequest{Username: username, Password: password})
+	rq := httptest.NewRequest(http.MethodPost, "/v1/auth/login", bytes.NewReader(body))
+	rq.Header.Set("Content-Type", "application/json")
+	rq.Header.Set("User-Agent", userAgent)
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, rq)
+	if w.Code != http.StatusOK {
+		t.Fatalf("login of %s: got status %d", username, w.Code)
+	}
+
+	var login v1.LoginReply
+	if err := json.Unmarshal(w.Body.Bytes(), &login); err != nil {
+		t.Fatalf("decode login response: %v", err)
+	}
+	return &login
+}
+
+// schema mirrors the MySQL tables in SQLite. The model column types (for example
+// `bigint unsigned` primary keys) do not map to SQLite rowids, so AutoMigrate is not used.
+var schema = []string{
+	`CREATE TABLE user (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL DEFAULT '',
+	username TEXT NOT NULL UNIQUE,
+	status TEXT NOT NULL DEFAULT 'actived',
+	nickname TEXT NOT NULL,
+	password TEXT NOT NULL,
+	email TEXT NOT NULL,
+	phone TEXT NOT NULL,
+	departmentId TEXT NOT NULL DEFAULT '',
+	passwordChangedAt DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE secret (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL,
+	name TEXT NOT NULL,
+	secretId TEXT NOT NULL UNIQUE,
+	secretKey TEXT NOT NULL,
+	status INTEGER NOT NULL DEFAULT 1,
+	expires INTEGER NOT NULL,
+	description TEXT NOT NULL DEFAULT '',
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE user_mfa (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL UNIQUE,
+	secret TEXT NOT NULL,
+	enabled INTEGER NOT NULL DEFAULT 0,
+	recoveryCodes TEXT NOT NULL DEFAULT '',
+	lastUsedStep INTEGER NOT NULL DEFAULT 0,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE user_identity (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL,
+	provider TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL,
+	UNIQUE (provider, subject)
+)`,
+	`CREATE TABLE user_session (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	sessionId TEXT NOT NULL UNIQUE,
+	userId TEXT NOT NULL,
+	device TEXT NOT NULL,
+	ip TEXT NOT NULL,
+	userAgent TEXT NOT NULL,
+	actorId TEXT NOT NULL DEFAULT '',
+	lastSeenAt DATETIME NOT NULL,
+	expiresAt DATETIME NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE user_password_history (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL,
+	password TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE audit_log (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	userId TEXT NOT NULL,
+	actorId TEXT NOT NULL,
+	action TEXT NOT NULL,
+	path TEXT NOT NULL,
+	statusCode INTEGER NOT NULL,
+	ip TEXT NOT NULL,
+	sessionId TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE role (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	name TEXT NOT NULL UNIQUE,
+	displayName TEXT NOT NULL DEFAULT '',
+	description TEXT NOT NULL DEFAULT '',
+	status INTEGER NOT NULL DEFAULT 1,
+	dataScope INTEGER NOT NULL DEFAULT 5,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`INSERT INTO role (name, displayName, description, status, dataScope, createdAt, updatedAt) VALUES
+	('role::user', 'user', '', 1, 5, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP),
+	('role::admin', 'admin', '', 1, 1, CURRENT_TIMESTAMP, CURRENT_TIMESTAMP)`,
+	`CREATE TABLE menu (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	menuId TEXT NOT NULL DEFAULT '',
+	parentId TEXT NOT NULL DEFAULT '',
+	type INTEGER NOT NULL DEFAULT 2,
+	name TEXT NOT NULL DEFAULT '',
+	path TEXT NOT NULL DEFAULT '',
+	component TEXT NOT NULL DEFAULT '',
+	icon TEXT NOT NULL DEFAULT '',
+	sort INTEGER NOT NULL DEFAULT 0,
+	title TEXT NOT NULL DEFAULT '',
+	hidden INTEGER NOT NULL DEFAULT 0,
+	keepAlive INTEGER NOT NULL DEFAULT 0,
+	authMark TEXT NOT NULL DEFAULT '',
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE department (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	departmentId TEXT NOT NULL DEFAULT '',
+	parentId TEXT NOT NULL DEFAULT '',
+	name TEXT NOT NULL DEFAULT '',
+	sort INTEGER NOT NULL DEFAULT 0,
+	leaderId TEXT NOT NULL DEFAULT '',
+	status INTEGER NOT NULL DEFAULT 1,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL
+)`,
+	`CREATE TABLE role_menu (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	role TEXT NOT NULL,
+	menuId TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL,
+	UNIQUE (role, menuId)
+)`,
+	`CREATE TABLE role_department (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	role TEXT NOT NULL,
+	departmentId TEXT NOT NULL,
+	createdAt DATETIME NOT NULL,
+	updatedAt DATETIME NOT NULL,
+	UNIQUE (role, departmentId)
+)`,
+}
+
+// newTestDB creates an in-memory SQLite database with the schema.
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+
+	db, err := gorm.Open(sqlite.Open(":memory:"), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	// Every connection to ":memory:" opens a fresh database, so keep a single one.
+	sqlDB, _ := db.DB()
+	sqlDB.SetMaxOpenConns(1)
+	t.Cleanup(func() { _ = sqlDB.Close() })
+	for _, ddl := range schema {
+		if err := db.Exec(ddl).Error; err != nil {
+			t.Fatalf("create schema: %v", err)
+		}
+	}
+	return db
+}
+
+// newTestEngine builds the REST API on top of an in-memory SQLite database and
+// an in-process Redis, wired the same way as NewServer.
+// It also returns the Redis server, so that tests can inspect the stored state.
+func newTestEngine(t *testing.T, opts ...func(*Config)) (*gin.Engine, *miniredis.Miniredis) {
+	t.Helper()
+
+	_, engine, rds := newTestServer(t, opts...)
+	return engine, rds
+}
+
+// newTestServer is like newTestEngine, but also returns the ServerConfig the
+// engine is built from, so that tests can install the other APIs on it.
+func newTestServer(t *testing.T, opts ...func(*Config)) (*ServerConfig, *gin.Engine, *miniredis.Miniredis) {
+	t.Helper()
+
+	// The servers of a test, for example the replicas of a cluster, share the database of the first one.
+	if store.S == nil {
+		store.NewStore(newTestDB(t))
+		// Dropping the singleton lets the next test create a store of its own.
+		t.Cleanup(func() { store.S = nil })
+	}
+
+	rds := miniredis.RunT(t)
+	config := &Config{
+		JWTOptions:            options.NewJWTOptions(),
+		RedisOptions:          genericoptions.NewRedisOptions(),
+		LockoutOptions:        options.NewLockoutOptions(),
+		CaptchaOptions:        options.NewCaptchaOptions(),
+		SignatureOptions:      options.NewSignatureOptions(),
+		OIDCOptions:           options.NewOIDCOptions(),
+		LDAPOptions:           options.NewLDAPOptions(),
+		PasswordPolicyOptions: options.NewPasswordPolicyOptions(),
+		PasswordResetOptions:  options.NewPasswordResetOptions(),
+		RegistrationOptions:   options.NewRegistrationOptions(),
+		MailOptions:           options.NewMailOptions(),
+	}
+	config.JWTOptions.Key = "art-design-pro-go-e2e-test-key"
+	config.RedisOptions.Addr = rds.Addr()
+	config.LockoutOptions.MaxUserFailures = 3
+	config.MailOptions.Driver = options.MailDriverMemory
+	for _, opt := range opts {
+		opt(config)
+	}
+	jwtOpts, redisOpts := config.JWTOptions, config.RedisOptions
+
+	keyStore, err := auth.NewActiveKeyStore(redisOpts)
+	if err != nil {
+		t.Fatalf("create active key store: %v", err)
+	}
+	keys, err := auth.NewKeySet(jwtOpts, keyStore)
+	if err != nil {
+		t.Fatalf("create key set: %v", err)
+	}
+	sessions, err := auth.NewSessionStore(redisOpts, jwtOpts)
+	if err != nil {
+		t.Fatalf("create session store: %v", err)
+	}
+	datastore := store.S
+	authenticator, err := NewAuthenticator(jwtOpts, redisOpts, keys, sessions, &SessionRevoker{store: datastore, sessions: sessions})
+	if err != nil {
+		t.Fatalf("create authenticator: %v", err)
+	}
+
+	authnImpl, err := auth.NewAuthn(store.NewSecretSetter(datastore), keys, sessions, jwtOpts, redisOpts)
+	if err != nil {
+		t.Fatalf("create authn: %v", err)
+	}
+	// The policies are kept in the database of the test too, so that tests can inspect them through store.S.
+	authzImpl, err := authz.NewAuthz(datastore.DB(context.Background()), append(auth.AuthzOptions(), authz.WithAutoLoadPolicyTime(time.Hour))...)
+	if err != nil {
+		t.Fatalf("create authz: %v", err)
+	}
+	lockoutImpl, err := lockout.New(config.LockoutOptions, redisOpts)
+	if err != nil {
+		t.Fatalf("create lockout: %v", err)
+	}
+	captchaImpl, err := captcha.New(config.CaptchaOptions, redisOpts)
+	if err != nil {
+		t.Fatalf("create captcha: %v", err)
+	}
+	oidcImpl, err := oidc.New(config.OIDCOptions, redisOpts)
+	if err != nil {
+		t.Fatalf("create oidc: %v", err)
+	}
+	ldapImpl, err := ldap.New(config.LDAPOptions)
+	if err != nil {
+		t.Fatalf("create ldap: %v", err)
+	}
+	policy, err := pwdpolicy.New(config.PasswordPolicyOptions)
+	if err != nil {
+		t.Fatalf("create password policy: %v", err)
+	}
+	resetImpl, err := pwdreset.New(config.PasswordResetOptions, redisOpts)
+	if err != nil {
+		t.Fatalf("create password reset: %v", err)
+	}
+	registrationImpl, err := registration.New(config.RegistrationOptions, redisOpts)
+	if err != nil {
+		t.Fatalf("create registration: %v", err)
+	}
+	mailer, err := mail.New(config.MailOptions)
+	if err != nil {
+		t.Fatalf("create mailer: %v", err)
+	}
+	signatureVerifier, err := auth.NewSignatureVerifier(config.SignatureOptions, redisOpts, authnImpl)
+	if err != nil {
+		t.Fatalf("create signature verifier: %v", err)
+	}
+	authzInterface, err := ProvideAuthz(authzImpl, redisOpts)
+	if err != nil {
+		t.Fatalf("create authz interface: %v", err)
+	}
+
+	cfg := &ServerConfig{
+		Config:    config,
+		biz:       biz.NewBiz(datastore, authenticator, auth.NewAuth(authnImpl, authzInterface), lockoutImpl, captchaImpl, oidcImpl, ldapImpl, sessions, policy, resetImpl, registrationImpl, mailer),
+		val:       validation.New(datastore, policy, authzImpl),
+		retriever: &UserRetriever{store: datastore},
+		authn:     authnImpl,
+		authz:     authzImpl,
+		signature: signatureVerifier,
+		recorder:  &AuditRecorder{store: datastore},
+	}
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	if err := engine.SetTrustedProxies(config.TrustedProxies); err != nil {
+		t.Fatalf("set trusted proxies: %v", err)
+	}
+	engine.Use(mw.Context(), mw.I18n())
+	cfg.InstallRESTAPI(engine, authenticator)
+	return cfg, engine, rds
+}
+
+// do sends a JSON POST request to the engine and decodes the response into out.
+// It returns the status code and, for failed requests, the error reason.
+func do(t *testing.T, engine *gin.Engine, path, token string, in, out any) (int, string) {
+	t.Helper()
+
+	return doRequest(t, engine, http.MethodPost, path, token, in, out)
+}
+
+// doRequest is like do, but sends the request with the given method. A nil in sends no body.
+// The error of a failed request is decoded into out only if out is a *core.ErrorResponse.
+func doRequest(t *testing.T, engine *gin.Engine, method, path, token string, in, out any) (int, string) {
+	t.Helper()
+
+	var body io.Reader
+	if in != nil {
+		data, _ := json.Marshal(in)
+		body = bytes.NewReader(data)
+	}
+	rq := httptest.NewRequest(method, path, body)
+	rq.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		rq.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, rq)
+	if w.Code != http.StatusOK {
+		resp, ok := out.(*core.ErrorResponse)
+		if !ok {
+			resp = &core.ErrorResponse{}
+		}
+		_ = json.Unmarshal(w.Body.Bytes(), resp)
+		return w.Code, resp.Reason
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			t.Fatalf("decode %s response: %v", path, err)
+		}
+	}
+	return w.Code, ""
+}
+
+// loginAdmin logs in as the administrator, creating it on first use.
+func loginAdmin(t *testing.T, engine *gin.Engine) *v1.LoginReply {
+	t.Helper()
+
+	admin := &v1.CreateUserRequest{
+		Username: "root",
+		Nickname: "root",
+		Password: "root123456",
+		Email:    "root@example.com",
+		Phone:    "13800000002",
+	}
+	// The servers of a test share the database, see newTestServer.
+	if code, _ := do(t, engine, "/v1/users", "", admin, nil); code == http.StatusOK {
+		err := store.S.DB(context.Background()).Exec("UPDATE user SET userId = ? WHERE username = ?", known.AdminUserID, admin.Username).Error
+		if err != nil {
+			t.Fatalf("make admin: %v", err)
+		}
+	}
+
+	var login v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: admin.Username, Password: admin.Password}, &login); code != http.StatusOK {
+		t.Fatalf("admin login: got status %d", code)
+	}
+	return &login
+}
+
+// testUser is a user registered through the API by createUser.
+type testUser struct {
+	*v1.CreateUserRequest
+	// UserID is the ID assigned to the user on registration.
+	UserID string
+}
+
+// newUserRequest returns the registration of a user whose other fields are derived from the username.
+func newUserRequest(username string) *v1.CreateUserRequest {
+	return &v1.CreateUserRequest{
+		Username: username,
+		Nickname: username,
+		Password: username + "123",
+		Email:    username + "@example.com",
+		Phone:    "13800000000",
+	}
+}
+
+// createUser registers a user, see newUserRequest.
+func createUser(t *testing.T, engine *gin.Engine, username string) *testUser {
+	t.Helper()
+
+	rq := newUserRequest(username)
+	var created v1.CreateUserResponse
+	if code, reason := do(t, engine, "/v1/users", "", rq, &created); code != http.StatusOK {
+		t.Fatalf("create user %s: got status %d (%s)", username, code, reason)
+	}
+	return &testUser{CreateUserRequest: rq, UserID: created.UserID}
+}
+
+// login logs in as the user from chromeOnMac.
+func (u *testUser) login(t *testing.T, engine *gin.Engine) *v1.LoginReply {
+	t.Helper()
+
+	return loginFrom(t, engine, u.Username, u.Password, chromeOnMac)
+}
+
+// assignRoles assigns the roles to the user as the administrator.
+func assignRoles(t *testing.T, engine *gin.Engine, admin *v1.LoginReply, userID string, roles ...string) {
+	t.Helper()
+
+	if code, reason := do(t, engine, "/v1/users/"+userID+"/roles", admin.AccessToken, &v1.AssignUserRoleRequest{Roles: roles}, nil); code != http.StatusOK {
+		t.Fatalf("assign roles %v to %s: got status %d (%s)", roles, userID, code, reason)
+	}
+}
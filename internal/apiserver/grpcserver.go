@@ -0,0 +1,26 @@
+package apiserver
+
+import (
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/moweilong/milady/pkg/server"
+	"google.golang.org/grpc"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/handler"
+)
+
+// NewExtAuthzServer 创建实现 Envoy ext_authz Check 接口的 gRPC 服务器.
+func (c *ServerConfig) NewExtAuthzServer() (*server.GRPCServer, error) {
+	grpcOptions := genericoptions.NewGRPCOptions()
+	grpcOptions.Addr = c.ExtAuthzOptions.Addr
+
+	return server.NewGRPCServer(grpcOptions, c.TLSOptions, nil, c.InstallExtAuthz)
+}
+
+// InstallExtAuthz 注册 Envoy ext_authz 的 Check 接口.
+// Check 与 /v1/auth/forward 接口使用同一个 casbin enforcer 进行授权.
+func (c *ServerConfig) InstallExtAuthz(registrar grpc.ServiceRegistrar) {
+	// gRPC 接口自行完成认证和授权，不使用 Gin 中间件
+	hdl := handler.NewHandler(c.biz, c.val, nil, nil, nil, nil)
+	authv3.RegisterAuthorizationServer(registrar, hdl)
+}
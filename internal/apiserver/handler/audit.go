@@ -0,0 +1,27 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 用户查看自己的审计日志，例如管理员模拟登录期间执行的操作，因此只需要认证，不需要授权
+		v1.GET("/audit-logs", handler.authn, handler.ListAuditLog)
+		// 管理员查看任意用户的审计日志
+		v1.GET("/users/:userID/audit-logs", append(handler.mws, handler.ListAuditLog)...)
+	})
+}
+
+// ListAuditLog lists the audit trail of the logged-in user, or of the user in the path.
+func (h *Handler) ListAuditLog(c *gin.Context) {
+	// userID 位于路径中，offset 和 limit 位于查询参数中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.AuditV1().List, h.val.ValidateListAuditLogRequest)
+}
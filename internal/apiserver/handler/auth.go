@@ -12,8 +12,25 @@ func init() {
 	Register(func(v1 *gin.RouterGroup, handler *Handler) {
 		// 用户相关路由
 		rg := v1.Group("/auth")
-		rg.POST("/login", handler.Login) // 登录。这里要注意：登录是不用进行认证和授权的
+		rg.POST("/login", handler.Login)       // 登录。这里要注意：登录是不用进行认证和授权的
+		rg.GET("/captcha", handler.GetCaptcha) // 获取图形验证码，登录和注册前调用，不需要认证
+		// 开启两步验证的用户登录后只获得挑战令牌，使用挑战令牌和验证码换取正式令牌
+		rg.POST("/mfa/verify", handler.VerifyMFA)
+		// 密码过期的用户登录后只获得修改密码令牌，使用该令牌设置新密码后换取正式令牌
+		rg.POST("/change-expired-password", handler.ChangeExpiredPassword)
+		// 找回密码：通过邮件发送一次性的重置链接，使用链接中的令牌设置新密码
+		rg.POST("/password/forgot", handler.ForgotPassword)
+		rg.POST("/password/reset", handler.ResetPassword)
+		// 注册需要验证邮箱时，新用户通过邮件中的链接激活账号，激活前不能登录
+		rg.POST("/email/verify", handler.VerifyEmail)
+		rg.POST("/email/resend", handler.ResendVerification)
+		// 登出和刷新令牌只需要认证，不需要授权，否则未配置策略的用户无法登出
+		rg.POST("/logout", handler.authn, handler.Logout)
+		// 刷新令牌接口只接受刷新令牌，其他接口只接受访问令牌
+		rg.POST("/refresh-token", handler.refresh, handler.RefreshToken)
 		rg.Use(handler.mws...)
+		rg.POST("/authenticate", handler.Authenticate)
+		rg.POST("/authorize", handler.Authorize)
 	})
 }
 
@@ -22,37 +39,82 @@ func (h *Handler) Login(c *gin.Context) {
 	core.HandleJSONRequest(c, h.biz.AuthV1().Login, h.val.ValidateLoginRequest)
 }
 
+// ChangeExpiredPassword replaces an expired password and completes the login.
+func (h *Handler) ChangeExpiredPassword(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().ChangeExpiredPassword, h.val.ValidateChangeExpiredPasswordRequest)
+}
+
+// ForgotPassword mails a password reset link to the user.
+func (h *Handler) ForgotPassword(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().ForgotPassword, h.val.ValidateForgotPasswordRequest)
+}
+
+// ResetPassword sets a new password with a password reset token.
+func (h *Handler) ResetPassword(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().ResetPassword, h.val.ValidateResetPasswordRequest)
+}
+
+// VerifyEmail activates a registered user with an email verification token.
+func (h *Handler) VerifyEmail(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().VerifyEmail, h.val.ValidateVerifyEmailRequest)
+}
+
+// ResendVerification mails a new email verification link to a registered user.
+func (h *Handler) ResendVerification(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().ResendVerification, h.val.ValidateResendVerificationRequest)
+}
+
+// GetCaptcha generates a new image captcha.
+func (h *Handler) GetCaptcha(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AuthV1().GetCaptcha)
+}
+
 // Logout invalidates the user token.
-// func (h *Handler) Logout(c *gin.Context) {
-// 	core.HandleJSONRequest(c, h.biz.AuthV1().Logout, h.val.ValidateLogoutRequest)
-// }
+func (h *Handler) Logout(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().Logout, h.val.ValidateLogoutRequest)
+}
 
 // RefreshToken generates a new token using the refresh token.
-func (h *Handler) RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.LoginReply, error) {
-	return h.biz.AuthV1().RefreshToken(ctx, rq)
+func (h *Handler) RefreshToken(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().RefreshToken, h.val.ValidateRefreshTokenRequest)
 }
 
 // Authenticate validates the user token and returns the user ID.
-func (h *Handler) Authenticate(ctx context.Context, rq *v1.AuthenticateRequest) (*v1.AuthenticateResponse, error) {
-	return h.biz.AuthV1().Authenticate(ctx, rq.Token)
+func (h *Handler) Authenticate(c *gin.Context) {
+	core.HandleJSONRequest(c, h.authenticate, h.val.ValidateAuthenticateRequest)
 }
 
 // Authorize checks whether the user is authorized for the object/action.
-func (h *Handler) Authorize(ctx context.Context, rq *v1.AuthorizeRequest) (*v1.AuthorizeResponse, error) {
-	return h.biz.AuthV1().Authorize(ctx, rq.Sub, rq.Obj, rq.Act)
+func (h *Handler) Authorize(c *gin.Context) {
+	core.HandleJSONRequest(c, h.authorize, h.val.ValidateAuthorizeRequest)
+}
+
+// JWKS returns the public keys used to verify tokens.
+func (h *Handler) JWKS(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AuthV1().JWKS)
 }
 
 // Auth authenticates and authorizes the user token for an object/action.
 func (h *Handler) Auth(ctx context.Context, rq *v1.AuthRequest) (*v1.AuthResponse, error) {
-	authn, err := h.Authenticate(ctx, &v1.AuthenticateRequest{Token: rq.Token})
+	authn, err := h.authenticate(ctx, &v1.AuthenticateRequest{Token: rq.Token})
 	if err != nil {
 		return nil, err
 	}
 
-	authz, err := h.Authorize(ctx, &v1.AuthorizeRequest{Sub: authn.UserID, Obj: rq.Obj, Act: rq.Act})
+	authz, err := h.authorize(ctx, &v1.AuthorizeRequest{Sub: authn.UserID, Obj: rq.Obj, Act: rq.Act})
 	if err != nil {
 		return nil, err
 	}
 
-	return &v1.AuthResponse{UserID: authn.UserID, Allowed: authz.Allowed}, nil
+	return &v1.AuthResponse{UserID: authn.UserID, Allowed: authz.Allowed, SessionID: authn.SessionID, ActorID: authn.ActorID}, nil
+}
+
+// authenticate adapts AuthBiz.Authenticate to the request/response handler signature.
+func (h *Handler) authenticate(ctx context.Context, rq *v1.AuthenticateRequest) (*v1.AuthenticateResponse, error) {
+	return h.biz.AuthV1().Authenticate(ctx, rq.Token)
+}
+
+// authorize adapts AuthBiz.Authorize to the request/response handler signature.
+func (h *Handler) authorize(ctx context.Context, rq *v1.AuthorizeRequest) (*v1.AuthorizeResponse, error) {
+	return h.biz.AuthV1().Authorize(ctx, rq.Sub, rq.Obj, rq.Act)
 }
@@ -0,0 +1,77 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 部门相关路由，部门组成一棵组织树
+		rg := v1.Group("/departments", handler.mws...)
+		rg.POST("", handler.CreateDepartment)                             // 创建部门
+		rg.PUT(":departmentID", handler.UpdateDepartment)                 // 更新部门信息，可以连同下级部门移动到其他上级部门下
+		rg.DELETE(":departmentID", handler.DeleteDepartment)              // 删除部门，部门下不能有下级部门或用户
+		rg.GET(":departmentID", handler.GetDepartment)                    // 查询部门详情
+		rg.GET("", handler.ListDepartment)                                // 查询完整的部门树
+		rg.GET(":departmentID/subtree", handler.GetDepartmentSubtree)     // 查询部门及其下级部门组成的子树
+		rg.GET(":departmentID/ancestors", handler.ListDepartmentAncestor) // 查询部门的全部上级部门，从顶级部门开始
+
+		// 用户所属的部门，只有管理员可以修改
+		v1.PUT("/users/:userID/department", append(handler.mws, handler.UpdateUserDepartment)...)
+	})
+}
+
+// CreateDepartment handles the creation of a new department.
+func (h *Handler) CreateDepartment(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.DepartmentV1().Create, h.val.ValidateCreateDepartmentRequest)
+}
+
+// UpdateDepartment handles updating an existing department's details.
+func (h *Handler) UpdateDepartment(c *gin.Context) {
+	// departmentID 位于路径中，其他字段位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.DepartmentV1().Update, h.val.ValidateUpdateDepartmentRequest)
+}
+
+// DeleteDepartment handles the deletion of a department.
+func (h *Handler) DeleteDepartment(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.DepartmentV1().Delete, h.val.ValidateDeleteDepartmentRequest)
+}
+
+// GetDepartment retrieves information about a specific department.
+func (h *Handler) GetDepartment(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.DepartmentV1().Get, h.val.ValidateGetDepartmentRequest)
+}
+
+// ListDepartment retrieves the whole department tree.
+func (h *Handler) ListDepartment(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.DepartmentV1().List, h.val.ValidateListDepartmentRequest)
+}
+
+// GetDepartmentSubtree retrieves a department with its descendants.
+func (h *Handler) GetDepartmentSubtree(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.DepartmentV1().GetSubtree, h.val.ValidateGetDepartmentSubtreeRequest)
+}
+
+// ListDepartmentAncestor retrieves the ancestors of a department.
+func (h *Handler) ListDepartmentAncestor(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.DepartmentV1().ListAncestor, h.val.ValidateListDepartmentAncestorRequest)
+}
+
+// UpdateUserDepartment moves a user to a department.
+func (h *Handler) UpdateUserDepartment(c *gin.Context) {
+	// userID 位于路径中，departmentID 位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.DepartmentV1().UpdateUserDepartment, h.val.ValidateUpdateUserDepartmentRequest)
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+	"github.com/moweilong/milady/pkg/errorsx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// 网关在转发请求前携带原始请求信息的请求头
+const (
+	// Traefik、Caddy 等使用的 forward auth 请求头
+	headerForwardedMethod = "X-Forwarded-Method"
+	headerForwardedURI    = "X-Forwarded-Uri"
+	// nginx auth_request 需要通过 proxy_set_header 设置的请求头
+	headerOriginalMethod = "X-Original-Method"
+	headerOriginalURI    = "X-Original-URI"
+	headerOriginalURL    = "X-Original-URL"
+)
+
+// Ensure Handler implements the Envoy ext_authz Check API.
+var _ authv3.AuthorizationServer = (*Handler)(nil)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 网关的外部授权接口，供 nginx auth_request 和 Traefik 等的 forward auth 调用
+		// 接口根据请求头中的原始请求自行完成认证和授权，因此不使用认证和授权中间件
+		// 网关的子请求可能沿用原始请求的方法，因此接受所有方法
+		v1.Any("/auth/forward", handler.ForwardAuth)
+	})
+}
+
+// ForwardAuth authenticates and authorizes the original request of the gateway.
+// It responds 200 with the identity headers, or 401/403 if the request is rejected.
+func (h *Handler) ForwardAuth(c *gin.Context) {
+	header := c.Request.Header
+	rq := &v1.AuthRequest{
+		Token: bearerToken(header.Get("Authorization")),
+		Obj:   objectPath(firstHeader(header, headerForwardedURI, headerOriginalURI, headerOriginalURL)),
+		Act:   strings.ToUpper(firstHeader(header, headerForwardedMethod, headerOriginalMethod)),
+	}
+
+	resp, err := h.check(c.Request.Context(), rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	// 网关将这些响应头转发给后端服务，后端服务无需再校验令牌
+	for key, value := range identityHeaders(resp) {
+		if value != "" {
+			c.Header(key, value)
+		}
+	}
+	core.WriteResponse(c, resp, nil)
+}
+
+// Check implements the Envoy ext_authz Check API. Rejected requests are answered
+// with a denied response rather than an error, so that Envoy returns it to the client.
+func (h *Handler) Check(ctx context.Context, rq *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+	httpRequest := rq.GetAttributes().GetRequest().GetHttp()
+	// Envoy 传递的请求头名称均为小写
+	resp, err := h.check(ctx, &v1.AuthRequest{
+		Token: bearerToken(httpRequest.GetHeaders()["authorization"]),
+		Obj:   objectPath(httpRequest.GetPath()),
+		Act:   strings.ToUpper(httpRequest.GetMethod()),
+	})
+	if err != nil {
+		return deniedResponse(err), nil
+	}
+
+	okResponse := &authv3.OkHttpResponse{}
+	for key, value := range identityHeaders(resp) {
+		// 移除客户端自行携带的身份请求头，避免伪造身份
+		if value == "" {
+			okResponse.HeadersToRemove = append(okResponse.HeadersToRemove, key)
+			continue
+		}
+		okResponse.Headers = append(okResponse.Headers, &corev3.HeaderValueOption{
+			Header:       &corev3.HeaderValue{Key: key, Value: value},
+			AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+		})
+	}
+
+	return &authv3.CheckResponse{
+		Status:       status.New(codes.OK, "").Proto(),
+		HttpResponse: &authv3.CheckResponse_OkResponse{OkResponse: okResponse},
+	}, nil
+}
+
+// check authenticates and authorizes the request forwarded by the gateway,
+// converting a denied authorization into an error.
+func (h *Handler) check(ctx context.Context, rq *v1.AuthRequest) (*v1.AuthResponse, error) {
+	if err := h.val.ValidateAuthRequest(ctx, rq); err != nil {
+		return nil, err
+	}
+
+	resp, err := h.Auth(ctx, rq)
+	if err != nil {
+		return nil, err
+	}
+	if !resp.Allowed {
+		return nil, errno.ErrPermissionDenied.WithMessage(
+			"access denied: subject=%s, object=%s, action=%s", resp.UserID, rq.Obj, rq.Act)
+	}
+	return resp, nil
+}
+
+// deniedResponse converts err into a denied ext_authz response, whose body is the
+// same as the error responses of the REST API.
+func deniedResponse(err error) *authv3.CheckResponse {
+	errx := errorsx.FromError(err)
+	body, _ := json.Marshal(core.ErrorResponse{Reason: errx.Reason, Message: errx.Message, Metadata: errx.Metadata})
+
+	return &authv3.CheckResponse{
+		Status: errx.GRPCStatus().Proto(),
+		HttpResponse: &authv3.CheckResponse_DeniedResponse{
+			DeniedResponse: &authv3.DeniedHttpResponse{
+				Status: &typev3.HttpStatus{Code: typev3.StatusCode(errx.Code)},
+				Headers: []*corev3.HeaderValueOption{{
+					Header:       &corev3.HeaderValue{Key: "content-type", Value: "application/json"},
+					AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+				}},
+				Body: string(body),
+			},
+		},
+	}
+}
+
+// identityHeaders returns the headers which identify the user of an authorized request.
+func identityHeaders(resp *v1.AuthResponse) map[string]string {
+	return map[string]string{
+		known.XUserID:    resp.UserID,
+		known.XSessionID: resp.SessionID,
+		known.XActorID:   resp.ActorID,
+	}
+}
+
+// bearerToken returns the token of a Bearer authorization header.
+func bearerToken(authorization string) string {
+	scheme, token, ok := strings.Cut(authorization, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// firstHeader returns the first non-empty header of keys.
+func firstHeader(header http.Header, keys ...string) string {
+	for _, key := range keys {
+		if value := header.Get(key); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// objectPath returns the path of uri, which is authorized as the casbin object.
+// The query is dropped and dot segments are resolved, so that a path like
+// /v1/public/../users can not match the policies of another path.
+func objectPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Path == "" {
+		return ""
+	}
+
+	cleaned := path.Clean("/" + u.Path)
+	if strings.HasSuffix(u.Path, "/") && cleaned != "/" {
+		cleaned += "/"
+	}
+	return cleaned
+}
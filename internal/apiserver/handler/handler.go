@@ -11,6 +11,11 @@ import (
 type Handler struct {
 	biz biz.IBiz
 	val *validation.Validator
+	// authn 仅校验访问令牌，用于登出等不需要授权的接口
+	authn gin.HandlerFunc
+	// refresh 仅校验刷新令牌，只用于刷新令牌接口
+	refresh gin.HandlerFunc
+	// mws 依次进行认证、授权和注入用户的角色
 	mws []gin.HandlerFunc
 }
 
@@ -19,8 +24,8 @@ type Registrar func(v1 *gin.RouterGroup, h *Handler)
 var registrars []Registrar
 
 // NewHandler creates a new instance of Handler.
-func NewHandler(biz biz.IBiz, val *validation.Validator, mws ...gin.HandlerFunc) *Handler {
-	return &Handler{biz: biz, val: val, mws: mws}
+func NewHandler(biz biz.IBiz, val *validation.Validator, authn, refresh, authz, roles gin.HandlerFunc) *Handler {
+	return &Handler{biz: biz, val: val, authn: authn, refresh: refresh, mws: []gin.HandlerFunc{authn, authz, roles}}
 }
 
 func Register(r Registrar) {
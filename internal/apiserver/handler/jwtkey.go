@@ -0,0 +1,26 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// JWT 签名密钥管理路由，仅管理员可访问
+		rg := v1.Group("/jwt-keys", handler.mws...)
+		rg.GET("", handler.ListJWTKey)
+		// 提升下一个密钥为签名密钥，旧密钥签发的令牌在其退役前仍可校验
+		rg.POST("/promote", handler.PromoteJWTKey)
+	})
+}
+
+// ListJWTKey lists the signing keys and their states.
+func (h *Handler) ListJWTKey(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AuthV1().ListJWTKey, h.val.ValidateListJWTKeyRequest)
+}
+
+// PromoteJWTKey makes a signing key the active one.
+func (h *Handler) PromoteJWTKey(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().PromoteJWTKey, h.val.ValidatePromoteJWTKeyRequest)
+}
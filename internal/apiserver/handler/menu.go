@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 菜单相关路由，前端根据菜单树生成侧边栏和路由，根据权限标识显示按钮
+		rg := v1.Group("/menus", handler.mws...)
+		rg.POST("", handler.CreateMenu)          // 创建目录、菜单或按钮
+		rg.PUT(":menuID", handler.UpdateMenu)    // 更新菜单信息，可以移动到其他上级菜单下
+		rg.DELETE(":menuID", handler.DeleteMenu) // 删除菜单，菜单下不能有子菜单或按钮
+		rg.GET(":menuID", handler.GetMenu)       // 查询菜单详情
+		rg.GET("", handler.ListMenu)             // 查询完整的菜单树
+		rg.GET("mine", handler.ListMyMenu)       // 查询当前用户的角色可以访问的菜单树和权限标识
+
+		// 角色绑定的菜单，只有管理员可以查看和修改
+		role := v1.Group("/roles/:name/menus", handler.mws...)
+		role.GET("", handler.ListRoleMenu)
+		role.PUT("", handler.UpdateRoleMenu)
+	})
+}
+
+// CreateMenu handles the creation of a new menu.
+func (h *Handler) CreateMenu(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.MenuV1().Create, h.val.ValidateCreateMenuRequest)
+}
+
+// UpdateMenu handles updating an existing menu's details.
+func (h *Handler) UpdateMenu(c *gin.Context) {
+	// menuID 位于路径中，其他字段位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.MenuV1().Update, h.val.ValidateUpdateMenuRequest)
+}
+
+// DeleteMenu handles the deletion of a menu.
+func (h *Handler) DeleteMenu(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.MenuV1().Delete, h.val.ValidateDeleteMenuRequest)
+}
+
+// GetMenu retrieves information about a specific menu.
+func (h *Handler) GetMenu(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.MenuV1().Get, h.val.ValidateGetMenuRequest)
+}
+
+// ListMenu retrieves the whole menu tree.
+func (h *Handler) ListMenu(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.MenuV1().List, h.val.ValidateListMenuRequest)
+}
+
+// ListMyMenu retrieves the menu tree and permission marks of the current user.
+func (h *Handler) ListMyMenu(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.MenuV1().ListMine, h.val.ValidateListMyMenuRequest)
+}
+
+// ListRoleMenu retrieves the menus bound to a role.
+func (h *Handler) ListRoleMenu(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.MenuV1().ListRoleMenu, h.val.ValidateListRoleMenuRequest)
+}
+
+// UpdateRoleMenu replaces the menus bound to a role.
+func (h *Handler) UpdateRoleMenu(c *gin.Context) {
+	// name 位于路径中，菜单 ID 列表位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.MenuV1().UpdateRoleMenu, h.val.ValidateUpdateRoleMenuRequest)
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 两步验证管理路由，用户只能管理自己的两步验证，因此只需要认证，不需要授权
+		rg := v1.Group("/mfa", handler.authn)
+		rg.POST("/enroll", handler.EnrollMFA)   // 生成 TOTP 密钥，需调用 confirm 确认后才生效
+		rg.POST("/confirm", handler.ConfirmMFA) // 使用第一个验证码确认并开启两步验证，返回恢复码
+		rg.POST("/disable", handler.DisableMFA) // 使用验证码或恢复码关闭两步验证
+	})
+}
+
+// EnrollMFA generates a new TOTP secret for the logged-in user.
+func (h *Handler) EnrollMFA(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.MFAV1().Enroll, h.val.ValidateEnrollMFARequest)
+}
+
+// ConfirmMFA enables MFA with the first code and returns the recovery codes.
+func (h *Handler) ConfirmMFA(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.MFAV1().Confirm, h.val.ValidateConfirmMFARequest)
+}
+
+// DisableMFA disables MFA of the logged-in user.
+func (h *Handler) DisableMFA(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.MFAV1().Disable, h.val.ValidateDisableMFARequest)
+}
+
+// VerifyMFA exchanges an MFA challenge token and a code for tokens.
+func (h *Handler) VerifyMFA(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.AuthV1().VerifyMFA, h.val.ValidateVerifyMFARequest)
+}
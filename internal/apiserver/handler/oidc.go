@@ -0,0 +1,64 @@
+package handler
+
+import (
+	"crypto/rand"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+)
+
+const (
+	// oidcBindingCookie 保存发起单点登录的浏览器持有的随机值，回调时必须携带同一个值
+	oidcBindingCookie = "oidc_binding"
+	// oidcCookiePath 限定 Cookie 只发送给单点登录相关路由
+	oidcCookiePath = "/v1/auth/oidc"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 单点登录相关路由，不需要认证和授权
+		rg := v1.Group("/auth/oidc")
+		rg.GET("/providers", handler.ListOIDCProvider)
+		// 返回身份提供方的授权地址，由前端跳转。身份提供方回调 redirect-url 后，前端携带 code 和 state 调用 callback 换取令牌。
+		// login 会写入绑定浏览器的 Cookie，前端调用 callback 时必须带上它（跨域时需开启 credentials）
+		rg.GET("/:provider/login", handler.OIDCLogin)
+		rg.GET("/:provider/callback", handler.OIDCCallback)
+	})
+}
+
+// ListOIDCProvider returns the identity providers users can log in with.
+func (h *Handler) ListOIDCProvider(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.AuthV1().ListOIDCProvider)
+}
+
+// OIDCLogin returns the authorization URL of the identity provider.
+func (h *Handler) OIDCLogin(c *gin.Context) {
+	// state 与写入 HttpOnly Cookie 的随机值绑定，其他浏览器拿到 code 和 state 也无法完成登录，防止登录 CSRF
+	binding := rand.Text()
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcBindingCookie, binding, 0, oidcCookiePath, "", c.Request.TLS != nil, true)
+	c.Request = c.Request.WithContext(contextx.WithOIDCBinding(c.Request.Context(), binding))
+
+	core.HandleUriRequest(c, h.biz.AuthV1().OIDCLogin, h.val.ValidateOIDCLoginRequest)
+}
+
+// OIDCCallback exchanges the authorization code for a token.
+func (h *Handler) OIDCCallback(c *gin.Context) {
+	// Cookie 缺失时 binding 为空，回调会被拒绝。无论成败 state 都已失效，清除 Cookie
+	binding, _ := c.Cookie(oidcBindingCookie)
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie(oidcBindingCookie, "", -1, oidcCookiePath, "", c.Request.TLS != nil, true)
+	c.Request = c.Request.WithContext(contextx.WithOIDCBinding(c.Request.Context(), binding))
+
+	// provider 位于路径中，code 和 state 位于查询参数中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.AuthV1().OIDCCallback, h.val.ValidateOIDCCallbackRequest)
+}
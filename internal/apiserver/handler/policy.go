@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// casbin 策略相关路由，策略的变更通过 Redis 广播给其他副本
+		rg := v1.Group("/policies", handler.mws...)
+		rg.GET("", handler.ListPolicy)                   // 查询策略列表，可以按主体过滤
+		rg.POST("", handler.CreatePolicy)                // 添加策略
+		rg.PUT("", handler.UpdatePolicy)                 // 将一条策略替换为另一条策略
+		rg.DELETE("", handler.DeletePolicy)              // 删除策略，策略通过查询参数指定
+		rg.PUT(":subject", handler.ReplaceSubjectPolicy) // 替换主体的全部策略
+	})
+}
+
+// ListPolicy retrieves a list of policies based on query parameters.
+func (h *Handler) ListPolicy(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PolicyV1().List, h.val.ValidateListPolicyRequest)
+}
+
+// CreatePolicy handles the creation of a new policy.
+func (h *Handler) CreatePolicy(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PolicyV1().Create, h.val.ValidateCreatePolicyRequest)
+}
+
+// UpdatePolicy handles replacing a policy with another one.
+func (h *Handler) UpdatePolicy(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.PolicyV1().Update, h.val.ValidateUpdatePolicyRequest)
+}
+
+// DeletePolicy handles the deletion of a policy.
+func (h *Handler) DeletePolicy(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.PolicyV1().Delete, h.val.ValidateDeletePolicyRequest)
+}
+
+// ReplaceSubjectPolicy replaces all policies of a subject.
+func (h *Handler) ReplaceSubjectPolicy(c *gin.Context) {
+	// subject 位于路径中，新的策略位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.PolicyV1().ReplaceSubject, h.val.ValidateReplaceSubjectPolicyRequest)
+}
@@ -0,0 +1,91 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 角色相关路由，角色的分配保存为 casbin 的 g 策略
+		rg := v1.Group("/roles", handler.mws...)
+		rg.POST("", handler.CreateRole)                 // 创建角色
+		rg.PUT(":name", handler.UpdateRole)             // 更新角色信息，禁用的角色不能再分配给用户
+		rg.DELETE(":name", handler.DeleteRole)          // 删除角色，同时从全部成员中移除该角色
+		rg.GET(":name", handler.GetRole)                // 查询角色详情
+		rg.GET("", handler.ListRole)                    // 查询角色列表
+		rg.GET(":name/members", handler.ListRoleMember) // 查询拥有该角色的用户
+
+		// 用户的角色。用户可以查看自己的角色，只有管理员可以分配和移除角色
+		user := v1.Group("/users/:userID/roles", handler.mws...)
+		user.GET("", handler.ListUserRole)
+		user.POST("", handler.AssignUserRole)
+		user.DELETE(":name", handler.RemoveUserRole)
+	})
+}
+
+// CreateRole handles the creation of a new role.
+func (h *Handler) CreateRole(c *gin.Context) {
+	core.HandleJSONRequest(c, h.biz.RoleV1().Create, h.val.ValidateCreateRoleRequest)
+}
+
+// UpdateRole handles updating an existing role's details.
+func (h *Handler) UpdateRole(c *gin.Context) {
+	// name 位于路径中，其他字段位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.RoleV1().Update, h.val.ValidateUpdateRoleRequest)
+}
+
+// DeleteRole handles the deletion of a role.
+func (h *Handler) DeleteRole(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.RoleV1().Delete, h.val.ValidateDeleteRoleRequest)
+}
+
+// GetRole retrieves information about a specific role.
+func (h *Handler) GetRole(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.RoleV1().Get, h.val.ValidateGetRoleRequest)
+}
+
+// ListRole retrieves a list of roles based on query parameters.
+func (h *Handler) ListRole(c *gin.Context) {
+	core.HandleQueryRequest(c, h.biz.RoleV1().List, h.val.ValidateListRoleRequest)
+}
+
+// ListRoleMember retrieves the users the role is assigned to.
+func (h *Handler) ListRoleMember(c *gin.Context) {
+	// name 位于路径中，offset 和 limit 位于查询参数中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.RoleV1().ListMember, h.val.ValidateListRoleMemberRequest)
+}
+
+// ListUserRole retrieves the roles assigned to a user.
+func (h *Handler) ListUserRole(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.RoleV1().ListUserRole, h.val.ValidateListUserRoleRequest)
+}
+
+// AssignUserRole assigns roles to a user.
+func (h *Handler) AssignUserRole(c *gin.Context) {
+	// userID 位于路径中，角色列表位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.RoleV1().AssignUserRole, h.val.ValidateAssignUserRoleRequest)
+}
+
+// RemoveUserRole removes a role from a user.
+func (h *Handler) RemoveUserRole(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.RoleV1().RemoveUserRole, h.val.ValidateRemoveUserRoleRequest)
+}
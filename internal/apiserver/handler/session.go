@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+)
+
+func init() {
+	Register(func(v1 *gin.RouterGroup, handler *Handler) {
+		// 登录会话管理路由，用户只能管理自己的会话，因此只需要认证，不需要授权
+		rg := v1.Group("/sessions", handler.authn)
+		rg.GET("", handler.ListSession)                // 查询当前用户的登录会话
+		rg.DELETE(":sessionID", handler.DeleteSession) // 注销指定会话，会话的访问令牌和刷新令牌立即失效
+		rg.DELETE("", handler.DeleteAllSession)        // 注销全部会话，exceptCurrent=true 时保留当前会话
+
+		// 管理员管理任意用户的登录会话
+		admin := v1.Group("/users/:userID/sessions", handler.mws...)
+		admin.GET("", handler.ListSession)
+		admin.DELETE(":sessionID", handler.DeleteSession)
+		admin.DELETE("", handler.DeleteAllSession)
+	})
+}
+
+// ListSession lists the login sessions of the logged-in user, or of the user in the path.
+func (h *Handler) ListSession(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().List, h.val.ValidateListSessionRequest)
+}
+
+// DeleteSession revokes a login session.
+func (h *Handler) DeleteSession(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.SessionV1().Delete, h.val.ValidateDeleteSessionRequest)
+}
+
+// DeleteAllSession revokes all login sessions of a user.
+func (h *Handler) DeleteAllSession(c *gin.Context) {
+	// userID 位于路径中，exceptCurrent 位于查询参数中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindQuery(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.SessionV1().DeleteAll, h.val.ValidateDeleteAllSessionRequest)
+}
@@ -10,12 +10,16 @@ func init() {
 		// 用户相关路由
 		rg := v1.Group("/users")
 		rg.POST("", handler.CreateUser) // 创建用户。这里要注意：创建用户是不用进行认证和授权的
+		// 修改密码。用户只能修改自己的密码，因此只需要认证，不需要授权
+		rg.PUT(":userID/update-password", handler.authn, handler.UpdatePassword)
 		rg.Use(handler.mws...)
-		// rg.PUT(":userID/change-password", handler.ChangePassword) // 修改用户密码
-		rg.PUT(":userID", handler.UpdateUser)    // 更新用户信息
-		rg.DELETE(":userID", handler.DeleteUser) // 删除用户
-		rg.GET(":userID", handler.GetUser)       // 查询用户详情
-		rg.GET("", handler.ListUser)             // 查询用户列表.
+		rg.PUT(":userID", handler.UpdateUser)         // 更新用户信息
+		rg.DELETE(":userID", handler.DeleteUser)      // 删除用户
+		rg.GET(":userID", handler.GetUser)            // 查询用户详情
+		rg.GET("", handler.ListUser)                  // 查询用户列表.
+		rg.POST(":userID/unlock", handler.UnlockUser) // 解锁因登录失败次数过多被锁定的用户
+		// 管理员以其他用户的身份登录，令牌不能刷新，期间的全部操作写入审计日志
+		rg.POST(":userID/impersonate", handler.ImpersonateUser)
 	})
 }
 
@@ -31,20 +35,37 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 
 // DeleteUser handles the deletion of one or more users.
 func (h *Handler) DeleteUser(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.UserV1().Delete, h.val.ValidateDeleteUserRequest)
+	core.HandleUriRequest(c, h.biz.UserV1().Delete, h.val.ValidateDeleteUserRequest)
 }
 
 // GetUser retrieves information about a specific user.
 func (h *Handler) GetUser(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.UserV1().Get, h.val.ValidateGetUserRequest)
+	core.HandleUriRequest(c, h.biz.UserV1().Get, h.val.ValidateGetUserRequest)
 }
 
 // ListUser retrieves a list of users based on query parameters.
 func (h *Handler) ListUser(c *gin.Context) {
-	core.HandleJSONRequest(c, h.biz.UserV1().List, h.val.ValidateListUserRequest)
+	core.HandleQueryRequest(c, h.biz.UserV1().List, h.val.ValidateListUserRequest)
 }
 
 // UpdatePassword receives an UpdatePasswordRequest and updates the user's password in the datastore.
-// func (h *Handler) UpdatePassword(c *gin.Context) {
-// 	core.HandleJSONRequest(c, h.biz.UserV1().UpdatePassword, h.val.ValidateUpdatePasswordRequest)
-// }
+func (h *Handler) UpdatePassword(c *gin.Context) {
+	// userID 位于路径中，旧密码和新密码位于请求体中
+	bind := func(obj any) error {
+		if err := c.ShouldBindUri(obj); err != nil {
+			return err
+		}
+		return c.ShouldBindJSON(obj)
+	}
+	core.HandleRequest(c, bind, h.biz.UserV1().UpdatePassword, h.val.ValidateUpdatePasswordRequest)
+}
+
+// UnlockUser unlocks a user locked by too many failed logins.
+func (h *Handler) UnlockUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.AuthV1().UnlockUser, h.val.ValidateUnlockUserRequest)
+}
+
+// ImpersonateUser issues a short-lived access token to act as the user.
+func (h *Handler) ImpersonateUser(c *gin.Context) {
+	core.HandleUriRequest(c, h.biz.AuthV1().ImpersonateUser, h.val.ValidateImpersonateUserRequest)
+}
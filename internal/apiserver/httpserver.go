@@ -21,6 +21,8 @@ import (
 // ginServer 定义一个使用 Gin 框架开发的 HTTP 服务器.
 type ginServer struct {
 	srv server.Server
+	// extAuthz 是可选的 Envoy ext_authz gRPC 服务器，与 HTTP 服务器一同启动和停止
+	extAuthz server.Server
 }
 
 // 确保 *ginServer 实现了 server.Server 接口.
@@ -29,6 +31,10 @@ var _ server.Server = (*ginServer)(nil)
 func (c *ServerConfig) NewGinServer(authn authn.Authenticator) (*ginServer, error) {
 	// 创建 Gin 引擎
 	engine := gin.New()
+	// 客户端 IP 用于登录失败次数统计和找回密码限流，只信任配置的反向代理设置的 X-Forwarded-For
+	if err := engine.SetTrustedProxies(c.TrustedProxies); err != nil {
+		return nil, err
+	}
 
 	// 注册全局中间件，用于恢复 panic、设置 HTTP 头、添加请求 ID 等
 	engine.Use(
@@ -42,6 +48,7 @@ func (c *ServerConfig) NewGinServer(authn authn.Authenticator) (*ginServer, erro
 		})),
 		genericmw.Observability(),
 		mw.Context(),
+		mw.I18n(),
 	)
 
 	// 注册.R API 路由
@@ -49,7 +56,16 @@ func (c *ServerConfig) NewGinServer(authn authn.Authenticator) (*ginServer, erro
 
 	httpsrv := server.NewHTTPServer(c.HTTPOptions, c.TLSOptions, engine)
 
-	return &ginServer{srv: httpsrv}, nil
+	if !c.ExtAuthzOptions.Enabled {
+		return &ginServer{srv: httpsrv}, nil
+	}
+
+	grpcsrv, err := c.NewExtAuthzServer()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ginServer{srv: httpsrv, extAuthz: grpcsrv}, nil
 }
 
 // 注册 API 路由。路由的路径和 HTTP 方法，严格遵循.R 规范.
@@ -58,16 +74,22 @@ func (c *ServerConfig) InstallRESTAPI(engine *gin.Engine, authn authn.Authentica
 	InstallGenericAPI(engine)
 
 	// 认证和授权中间件
-	authMiddlewares := []gin.HandlerFunc{mw.AuthnMiddleware(authn, c.retriever), mw.AuthzMiddleware(c.authz)}
+	// 访问令牌由 c.authn 校验，刷新令牌由 authn 校验
+	// 使用 AK/SK 签名的请求由 c.signature 校验，其余请求仍使用访问令牌认证
+	// 管理员模拟登录期间的请求由 c.recorder 写入审计日志
+	authnMiddleware := mw.AuditMiddleware(c.recorder,
+		mw.SignatureAuthnMiddleware(c.signature, c.retriever, mw.AuthnMiddleware(c.authn, c.retriever)))
+	refreshMiddleware := mw.RefreshAuthnMiddleware(authn, c.retriever)
+	authzMiddleware := mw.AuthzMiddleware(c.authz)
+	// 用户的角色决定 store 层查询时使用的数据权限
+	roleMiddleware := mw.RoleMiddleware(c.authz)
 
 	// 创建核心业务处理器
-	hdl := handler.NewHandler(c.biz, c.val, authMiddlewares...)
+	hdl := handler.NewHandler(c.biz, c.val, authnMiddleware, refreshMiddleware, authzMiddleware, roleMiddleware)
 	// 注册健康检查接口
 	engine.GET("/healthz", hdl.Healthz)
-	// 注册用户登录和令牌刷新接口。这2个接口比较简单，所以没有 API 版本
-	// engine.POST("/login", hdl.Login)
-	// 注意：认证中间件要在 hdl.RefreshToken 之前加载
-	// engine.PUT("/refresh-token", mw.AuthnMiddleware(c.retriever), hdl.RefreshToken)
+	// 发布校验令牌使用的公钥，供其他服务和网关在不持有共享密钥的情况下校验令牌
+	engine.GET("/.well-known/jwks.json", hdl.JWKS)
 
 	// 注册 v1 版本 API 路由分组
 	v1 := engine.Group("/v1")
@@ -93,10 +115,16 @@ func InstallGenericAPI(engine *gin.Engine) {
 
 // RunOrDie 启动 Gin 服务器，出错则程序崩溃退出.
 func (s *ginServer) RunOrDie() {
+	if s.extAuthz != nil {
+		go s.extAuthz.RunOrDie()
+	}
 	s.srv.RunOrDie()
 }
 
 // GracefulStop 优雅停止服务器.
 func (s *ginServer) GracefulStop(ctx context.Context) {
+	if s.extAuthz != nil {
+		s.extAuthz.GracefulStop(ctx)
+	}
 	s.srv.GracefulStop(ctx)
 }
@@ -0,0 +1,134 @@
+package apiserver
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestImpersonation(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	user := createUser(t, engine, "impersonated")
+	login := user.login(t, engine)
+	admin := loginAdmin(t, engine)
+
+	impersonate := "/v1/users/" + user.UserID + "/impersonate"
+	if code, _ := do(t, engine, impersonate, login.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("impersonate as non-admin: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	var token v1.ImpersonateUserResponse
+	if code, _ := do(t, engine, impersonate, admin.AccessToken, nil, &token); code != http.StatusOK || token.AccessToken == "" {
+		t.Fatalf("impersonate: got status %d token %q", code, token.AccessToken)
+	}
+	if subject(t, token.AccessToken) != user.UserID {
+		t.Fatalf("impersonation token must be issued for the user")
+	}
+
+	// The token acts as the user, but can neither be refreshed nor impersonate again.
+	var sessions v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", token.AccessToken, nil, &sessions); code != http.StatusOK {
+		t.Fatalf("list sessions while impersonating: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", token.AccessToken, &v1.RefreshTokenRequest{}, nil); code == http.StatusOK {
+		t.Fatalf("refresh with an impersonation token must fail")
+	}
+	if code, _ := do(t, engine, "/v1/users/"+user.UserID+"/impersonate", token.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("nested impersonation: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	// The user sees the session started by the administrator.
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", login.AccessToken, nil, &sessions); code != http.StatusOK {
+		t.Fatalf("list sessions: got status %d", code)
+	}
+	var impersonatedBy string
+	for _, session := range sessions.Sessions {
+		if session.SessionID == token.SessionID {
+			impersonatedBy = session.ImpersonatedBy
+		}
+	}
+	if adminID := subject(t, admin.AccessToken); impersonatedBy != adminID {
+		t.Fatalf("impersonated session: got impersonatedBy %q, want %q", impersonatedBy, adminID)
+	}
+
+	// Every action of the administrator is in the audit trail of the user, newest first.
+	var logs v1.ListAuditLogResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/audit-logs?limit=10", login.AccessToken, nil, &logs); code != http.StatusOK {
+		t.Fatalf("list audit logs: got status %d", code)
+	}
+	var actions []string
+	for _, auditLog := range logs.AuditLogs {
+		if auditLog.ActorID != subject(t, admin.AccessToken) || auditLog.SessionID != token.SessionID {
+			t.Fatalf("audit log of another actor or session: %+v", auditLog)
+		}
+		actions = append(actions, auditLog.Action)
+	}
+	want := []string{"POST /v1/users/:userID/impersonate", "GET /v1/sessions", "impersonate"}
+	if len(actions) != len(want) {
+		t.Fatalf("audit logs: got actions %v, want %v", actions, want)
+	}
+	for i := range want {
+		if actions[i] != want[i] {
+			t.Fatalf("audit logs: got actions %v, want %v", actions, want)
+		}
+	}
+	if logs.AuditLogs[0].StatusCode != http.StatusForbidden {
+		t.Fatalf("audit log of a rejected request: got status %d", logs.AuditLogs[0].StatusCode)
+	}
+
+	// The audit trail of the users out of the data scope is not listed, revoking the session ends the impersonation.
+	userLogs := "/v1/users/" + user.UserID + "/audit-logs?limit=10"
+	stranger := createUser(t, engine, "auditstranger").login(t, engine)
+	var hidden v1.ListAuditLogResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, userLogs, stranger.AccessToken, nil, &hidden); code != http.StatusOK || hidden.Total != 0 {
+		t.Fatalf("list audit logs of a user out of the scope: got status %d total %d", code, hidden.Total)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, userLogs, admin.AccessToken, nil, &logs); code != http.StatusOK || logs.Total != 3 {
+		t.Fatalf("list audit logs of a user as admin: got status %d total %d", code, logs.Total)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/sessions/"+token.SessionID, login.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("revoke impersonated session: got status %d", code)
+	}
+	if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", token.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("impersonation token after revoke: got status %d reason %q", code, reason)
+	}
+}
+
+func TestImpersonationRestrictions(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	// The users holding the administrator role can not be impersonated.
+	manager := createUser(t, engine, "impersonatedadmin")
+	assignRoles(t, engine, admin, manager.UserID, known.RoleAdmin)
+	if code, _ := do(t, engine, "/v1/users/"+manager.UserID+"/impersonate", admin.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("impersonate an administrator: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	// The requests denied by the policies are recorded in the audit trail of the user too.
+	user := createUser(t, engine, "impersonateddenied")
+	userSessions := "/v1/users/" + user.UserID + "/sessions"
+	deny := &v1.CreatePolicyRequest{Subject: user.UserID, Object: userSessions, Action: http.MethodGet, Effect: known.PolicyEffectDeny}
+	if code, reason := do(t, engine, "/v1/policies", admin.AccessToken, deny, nil); code != http.StatusOK {
+		t.Fatalf("create policy: got status %d (%s)", code, reason)
+	}
+	var token v1.ImpersonateUserResponse
+	if code, _ := do(t, engine, "/v1/users/"+user.UserID+"/impersonate", admin.AccessToken, nil, &token); code != http.StatusOK {
+		t.Fatalf("impersonate: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, userSessions, token.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("request denied by the policy: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	var logs v1.ListAuditLogResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/users/"+user.UserID+"/audit-logs?limit=10", admin.AccessToken, nil, &logs); code != http.StatusOK || logs.Total != 2 {
+		t.Fatalf("list audit logs: got status %d total %d", code, logs.Total)
+	}
+	denied := logs.AuditLogs[0]
+	if denied.Action != "GET /v1/users/:userID/sessions" || denied.StatusCode != http.StatusForbidden || denied.SessionID != token.SessionID || denied.ActorID != subject(t, admin.AccessToken) {
+		t.Fatalf("audit log of the denied request: %+v", denied)
+	}
+}
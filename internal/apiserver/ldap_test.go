@@ -0,0 +1,330 @@
+package apiserver
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// LDAP protocol operations, see RFC 4511.
+const (
+	ldapBindRequest     ber.Tag = 0
+	ldapBindResponse    ber.Tag = 1
+	ldapUnbindRequest   ber.Tag = 2
+	ldapSearchRequest   ber.Tag = 3
+	ldapSearchEntry     ber.Tag = 4
+	ldapSearchDone      ber.Tag = 5
+	ldapResultSuccess           = 0
+	ldapResultBadCreds          = 49
+	ldapResultUnwilling         = 53
+	ldapFilterAnd       ber.Tag = 0
+	ldapFilterOr        ber.Tag = 1
+	ldapFilterNot       ber.Tag = 2
+	ldapFilterEquality  ber.Tag = 3
+	ldapFilterPresent   ber.Tag = 7
+)
+
+// ldapEntry is an entry of the mock directory.
+type ldapEntry struct {
+	dn       string
+	password string
+	attrs    map[string][]string
+}
+
+// mockLDAP is an in-process directory which speaks just enough LDAP for
+// simple binds and searches with and, or, not, equality and presence filters.
+type mockLDAP struct {
+	t  *testing.T
+	ln net.Listener
+
+	mu      sync.Mutex
+	entries []*ldapEntry
+}
+
+func newMockLDAP(t *testing.T, entries ...*ldapEntry) *mockLDAP {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	l := &mockLDAP{t: t, ln: ln, entries: entries}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go l.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { _ = ln.Close() })
+
+	return l
+}
+
+// URL returns the address of the directory.
+func (l *mockLDAP) URL() string {
+	return "ldap://" + l.ln.Addr().String()
+}
+
+// update changes the directory while it is running.
+func (l *mockLDAP) update(fn func(entries []*ldapEntry)) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	fn(l.entries)
+}
+
+func (l *mockLDAP) serve(conn net.Conn) {
+	defer conn.Close()
+
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil {
+			if !errors.Is(err, io.EOF) && !errors.Is(err, net.ErrClosed) {
+				l.t.Logf("read ldap packet: %v", err)
+			}
+			return
+		}
+		id := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+
+		switch op.Tag {
+		case ldapBindRequest:
+			_, _ = conn.Write(ldapMessage(id, ldapResult(ldapBindResponse, l.bind(op.Children[1].Data.String(), op.Children[2].Data.String()))))
+		case ldapSearchRequest:
+			for _, entry := range l.search(op.Children[0].Data.String(), op.Children[6]) {
+				_, _ = conn.Write(ldapMessage(id, entry))
+			}
+			_, _ = conn.Write(ldapMessage(id, ldapResult(ldapSearchDone, ldapResultSuccess)))
+		case ldapUnbindRequest:
+			return
+		}
+	}
+}
+
+// bind checks the simple bind of dn.
+func (l *mockLDAP) bind(dn, password string) int {
+	if password == "" {
+		return ldapResultUnwilling
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range l.entries {
+		if strings.EqualFold(e.dn, dn) && e.password == password {
+			return ldapResultSuccess
+		}
+	}
+	return ldapResultBadCreds
+}
+
+// search returns the entries below base which match filter.
+func (l *mockLDAP) search(base string, filter *ber.Packet) []*ber.Packet {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var results []*ber.Packet
+	for _, e := range l.entries {
+		if !strings.HasSuffix(strings.ToLower(e.dn), strings.ToLower(base)) || !e.match(filter) {
+			continue
+		}
+
+		op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldapSearchEntry, nil, "SearchResultEntry")
+		op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "objectName"))
+		attrs := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attributes")
+		for name, values := range e.attrs {
+			attr := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "attribute")
+			attr.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "type"))
+			vals := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "vals")
+			for _, v := range values {
+				vals.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, v, "value"))
+			}
+			attr.AppendChild(vals)
+			attrs.AppendChild(attr)
+		}
+		op.AppendChild(attrs)
+		results = append(results, op)
+	}
+	return results
+}
+
+// match evaluates a search filter against the entry.
+func (e *ldapEntry) match(filter *ber.Packet) bool {
+	switch filter.Tag {
+	case ldapFilterAnd:
+		for _, f := range filter.Children {
+			if !e.match(f) {
+				return false
+			}
+		}
+		return true
+	case ldapFilterOr:
+		return slices.ContainsFunc(filter.Children, e.match)
+	case ldapFilterNot:
+		return !e.match(filter.Children[0])
+	case ldapFilterEquality:
+		want := filter.Children[1].Data.String()
+		return slices.ContainsFunc(e.values(filter.Children[0].Data.String()), func(v string) bool { return strings.EqualFold(v, want) })
+	case ldapFilterPresent:
+		return len(e.values(filter.Data.String())) > 0
+	}
+	return false
+}
+
+// values returns the values of the attribute, whose name is case-insensitive.
+func (e *ldapEntry) values(name string) []string {
+	for k, v := range e.attrs {
+		if strings.EqualFold(k, name) {
+			return v
+		}
+	}
+	return nil
+}
+
+func ldapMessage(id int64, op *ber.Packet) []byte {
+	p := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "LDAPMessage")
+	p.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, id, "messageID"))
+	p.AppendChild(op)
+	return p.Bytes()
+}
+
+func ldapResult(tag ber.Tag, code int) *ber.Packet {
+	op := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "LDAPResult")
+	op.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, code, "resultCode"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "matchedDN"))
+	op.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "diagnosticMessage"))
+	return op
+}
+
+// casbinRoles returns the casbin roles granted to userID.
+func casbinRoles(t *testing.T, userID string) []string {
+	t.Helper()
+
+	var roles []string
+	err := store.S.DB(context.Background()).Raw("SELECT v1 FROM casbin_rule WHERE ptype = 'g' AND v0 = ? ORDER BY v1", userID).Scan(&roles).Error
+	if err != nil {
+		t.Fatalf("query roles: %v", err)
+	}
+	return roles
+}
+
+func TestLDAPLogin(t *testing.T) {
+	const (
+		aliceDN  = "uid=ldapalice,ou=people,dc=example,dc=com"
+		bobDN    = "uid=ldapbob,ou=people,dc=example,dc=com"
+		adminsDN = "cn=admins,ou=groups,dc=example,dc=com"
+	)
+	directory := newMockLDAP(t,
+		&ldapEntry{dn: "cn=service,dc=example,dc=com", password: "service-secret"},
+		&ldapEntry{dn: aliceDN, password: "alice123456", attrs: map[string][]string{
+			"objectClass": {"person"}, "uid": {"ldapalice"}, "entryUUID": {"7d1c4a3e-alice"},
+			"cn": {"Alice Liddell"}, "mail": {"alice@corp.example.com"},
+		}},
+		&ldapEntry{dn: bobDN, password: "bob123456", attrs: map[string][]string{
+			"objectClass": {"person"}, "uid": {"ldapbob"}, "entryUUID": {"7d1c4a3e-bob"}, "cn": {"Bob"},
+		}},
+		&ldapEntry{dn: adminsDN, attrs: map[string][]string{"objectClass": {"groupOfNames"}, "member": {aliceDN}}},
+		&ldapEntry{dn: "cn=devs,ou=groups,dc=example,dc=com", attrs: map[string][]string{"objectClass": {"groupOfNames"}, "member": {aliceDN, bobDN}}},
+	)
+	configure := func(autoProvision bool) func(*Config) {
+		return func(c *Config) {
+			c.LDAPOptions.Enabled = true
+			c.LDAPOptions.URL = directory.URL()
+			c.LDAPOptions.BindDN = "cn=service,dc=example,dc=com"
+			c.LDAPOptions.BindPassword = "service-secret"
+			c.LDAPOptions.BaseDN = "ou=people,dc=example,dc=com"
+			c.LDAPOptions.UserFilter = "(&(objectClass=person)(uid=%s))"
+			c.LDAPOptions.IDAttribute = "entryUUID"
+			c.LDAPOptions.GroupBaseDN = "ou=groups,dc=example,dc=com"
+			c.LDAPOptions.GroupRoles = []options.LDAPGroupRole{
+				{Group: "CN=Admins,OU=Groups,DC=example,DC=com", Role: "role::admin"},
+				{Group: "cn=devs,ou=groups,dc=example,dc=com", Role: "role::dev"},
+			}
+			c.LDAPOptions.AutoProvision = autoProvision
+		}
+	}
+	engine, _ := newTestEngine(t, configure(true))
+
+	login := func(username, password string) (*v1.LoginReply, int) {
+		var reply v1.LoginReply
+		code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: username, Password: password}, &reply)
+		return &reply, code
+	}
+
+	first, code := login("ldapalice", "alice123456")
+	if code != http.StatusOK {
+		t.Fatalf("first login: got status %d", code)
+	}
+	aliceID := subject(t, first.AccessToken)
+	user, err := store.S.User().Get(context.Background(), where.F("userID", aliceID))
+	if err != nil {
+		t.Fatalf("get provisioned user: %v", err)
+	}
+	if user.Username != "ldapalice" || user.Nickname != "Alice Liddell" || user.Email != "alice@corp.example.com" {
+		t.Fatalf("provisioned user: got %s/%s/%s", user.Username, user.Nickname, user.Email)
+	}
+	// Provisioned users get role::user like the users created through the API.
+	if roles := casbinRoles(t, aliceID); !slices.Equal(roles, []string{"role::admin", "role::dev", "role::user"}) {
+		t.Fatalf("roles after first login: got %v", roles)
+	}
+
+	for name, password := range map[string]string{"wrong password": "wrong123456", "empty password": ""} {
+		if _, code := login("ldapalice", password); code == http.StatusOK {
+			t.Errorf("%s: got status %d", name, code)
+		}
+	}
+	if _, code := login("nobody", "nobody123456"); code == http.StatusOK {
+		t.Errorf("unknown user: got status %d", code)
+	}
+	// Local users are still checked after the directory.
+	loginAdmin(t, engine)
+
+	// Leaving a group revokes its role on the next login.
+	directory.update(func(entries []*ldapEntry) {
+		for _, e := range entries {
+			if e.dn == adminsDN {
+				e.attrs["member"] = nil
+			}
+		}
+	})
+	second, code := login("ldapalice", "alice123456")
+	if code != http.StatusOK || subject(t, second.AccessToken) != aliceID {
+		t.Fatalf("second login: got status %d, want the same user", code)
+	}
+	if roles := casbinRoles(t, aliceID); !slices.Equal(roles, []string{"role::dev", "role::user"}) {
+		t.Fatalf("roles after leaving admins: got %v", roles)
+	}
+
+	// Without auto-provisioning, directory users are linked to the local user of the same name.
+	engine, _ = newTestEngine(t, configure(false))
+	if _, code := login("ldapbob", "bob123456"); code == http.StatusOK {
+		t.Fatalf("login without local user: got status %d", code)
+	}
+	bob := createUser(t, engine, "ldapbob")
+	linked, code := login("ldapbob", "bob123456")
+	if code != http.StatusOK || subject(t, linked.AccessToken) != bob.UserID {
+		t.Fatalf("linked login: got status %d, want user %s", code, bob.UserID)
+	}
+	// The local password of a directory user is never checked.
+	if _, code := login("ldapbob", bob.Password); code == http.StatusOK {
+		t.Errorf("local password of directory user: got status %d", code)
+	}
+
+	// Local users can log in while the directory is down.
+	_ = directory.ln.Close()
+	loginAdmin(t, engine)
+}
@@ -0,0 +1,173 @@
+package apiserver
+
+import (
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// menuTitles returns the titles of the menus and their children in depth-first order.
+func menuTitles(menus []*v1.Menu) []string {
+	var titles []string
+	for _, menu := range menus {
+		titles = append(titles, menu.Title)
+		titles = append(titles, menuTitles(menu.Children)...)
+	}
+	return titles
+}
+
+func TestMenuManagement(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	user := createUser(t, engine, "menuuser")
+	login := user.login(t, engine)
+
+	createMenu := func(rq *v1.CreateMenuRequest) string {
+		t.Helper()
+
+		var resp v1.CreateMenuResponse
+		if code, reason := do(t, engine, "/v1/menus", admin.AccessToken, rq, &resp); code != http.StatusOK {
+			t.Fatalf("create menu %s: got status %d (%s)", rq.Title, code, reason)
+		}
+		return resp.MenuID
+	}
+	myMenus := func(token string) *v1.ListMyMenuResponse {
+		t.Helper()
+
+		var resp v1.ListMyMenuResponse
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/menus/mine", token, nil, &resp); code != http.StatusOK {
+			t.Fatalf("list my menus: got status %d (%s)", code, reason)
+		}
+		return &resp
+	}
+
+	// Only the administrator manages the menus.
+	system := &v1.CreateMenuRequest{Type: known.MenuTypeDirectory, Name: "System", Path: "/system", Title: "menus.system.title", Sort: 2}
+	if code, _ := do(t, engine, "/v1/menus", login.AccessToken, system, nil); code != http.StatusForbidden {
+		t.Fatalf("create menu as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+	systemID := createMenu(system)
+	usersID := createMenu(&v1.CreateMenuRequest{ParentID: systemID, Type: known.MenuTypeMenu, Name: "User", Path: "user", Component: "/system/user", Title: "menus.system.user", KeepAlive: true})
+	addID := createMenu(&v1.CreateMenuRequest{ParentID: usersID, Type: known.MenuTypeButton, Title: "add", AuthMark: "user:add"})
+	createMenu(&v1.CreateMenuRequest{ParentID: usersID, Type: known.MenuTypeButton, Title: "delete", AuthMark: "user:delete"})
+	dashboardID := createMenu(&v1.CreateMenuRequest{Type: known.MenuTypeDirectory, Name: "Dashboard", Path: "/dashboard", Title: "menus.dashboard.title", Sort: 1})
+	createMenu(&v1.CreateMenuRequest{ParentID: dashboardID, Type: known.MenuTypeMenu, Name: "Console", Path: "console", Component: "/dashboard/console", Title: "menus.dashboard.console"})
+
+	invalid := []*v1.CreateMenuRequest{
+		{Type: 4, Path: "/other", Title: "other"},
+		{Type: known.MenuTypeDirectory, Path: "/other"},
+		{Type: known.MenuTypeDirectory, Title: "other"},
+		{ParentID: usersID, Type: known.MenuTypeButton, Title: "edit"},
+		{ParentID: usersID, Type: known.MenuTypeButton, Title: "edit", AuthMark: "user edit"},
+		{Type: known.MenuTypeButton, Title: "edit", AuthMark: "user:edit"},
+		{ParentID: systemID, Type: known.MenuTypeButton, Title: "edit", AuthMark: "user:edit"},
+		{ParentID: usersID, Type: known.MenuTypeMenu, Path: "other", Title: "other"},
+	}
+	for _, rq := range invalid {
+		if code, _ := do(t, engine, "/v1/menus", admin.AccessToken, rq, nil); code != http.StatusBadRequest {
+			t.Errorf("create menu %+v: got status %d, want %d", rq, code, http.StatusBadRequest)
+		}
+	}
+	if code, reason := do(t, engine, "/v1/menus", admin.AccessToken, &v1.CreateMenuRequest{ParentID: "menu-missing", Type: known.MenuTypeMenu, Path: "other", Title: "other"}, nil); code != http.StatusNotFound || reason != v1.ErrorReason_MenuNotFound.String() {
+		t.Fatalf("create menu under a missing parent: got status %d (%s)", code, reason)
+	}
+
+	// The tree is ordered by sort.
+	var tree v1.ListMenuResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/menus", admin.AccessToken, nil, &tree); code != http.StatusOK {
+		t.Fatalf("list menus: got status %d", code)
+	}
+	want := []string{"menus.dashboard.title", "menus.dashboard.console", "menus.system.title", "menus.system.user", "add", "delete"}
+	if titles := menuTitles(tree.Menus); !slices.Equal(titles, want) {
+		t.Fatalf("menu tree: got %v, want %v", titles, want)
+	}
+	var got v1.GetMenuResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/menus/"+usersID, admin.AccessToken, nil, &got); code != http.StatusOK {
+		t.Fatalf("get menu: got status %d", code)
+	}
+	if got.Menu.ParentID != systemID || got.Menu.Component != "/system/user" || !got.Menu.KeepAlive || len(got.Menu.Children) != 0 {
+		t.Fatalf("get menu: got %+v", got.Menu)
+	}
+
+	// Without any bindings the user has no menus, the administrator has all of them.
+	if mine := myMenus(login.AccessToken); len(mine.Menus) != 0 || len(mine.AuthMarks) != 0 {
+		t.Fatalf("menus without bindings: got %v, marks %v", menuTitles(mine.Menus), mine.AuthMarks)
+	}
+	if mine := myMenus(admin.AccessToken); len(mine.Menus) != 2 || !slices.Equal(mine.AuthMarks, []string{"user:add", "user:delete"}) {
+		t.Fatalf("menus of the administrator: got %v, marks %v", menuTitles(mine.Menus), mine.AuthMarks)
+	}
+
+	// Bind the user menu and one of its buttons to a role of the user.
+	role := &v1.CreateRoleRequest{Name: "role::menuviewer"}
+	if code, _ := do(t, engine, "/v1/roles", admin.AccessToken, role, nil); code != http.StatusOK {
+		t.Fatalf("create role: got status %d", code)
+	}
+	assignRoles(t, engine, admin, user.UserID, role.Name)
+	roleMenus := "/v1/roles/" + role.Name + "/menus"
+	bind := &v1.UpdateRoleMenuRequest{MenuIDs: []string{usersID, addID, addID}}
+	if code, _ := doRequest(t, engine, http.MethodPut, roleMenus, login.AccessToken, bind, nil); code != http.StatusForbidden {
+		t.Fatalf("bind menus as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if code, reason := doRequest(t, engine, http.MethodPut, roleMenus, admin.AccessToken, bind, nil); code != http.StatusOK {
+		t.Fatalf("bind menus: got status %d (%s)", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodPut, roleMenus, admin.AccessToken, &v1.UpdateRoleMenuRequest{MenuIDs: []string{"menu-missing"}}, nil); code != http.StatusNotFound || reason != v1.ErrorReason_MenuNotFound.String() {
+		t.Fatalf("bind missing menu: got status %d (%s)", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/roles/role::missing/menus", admin.AccessToken, bind, nil); code != http.StatusNotFound || reason != v1.ErrorReason_RoleNotFound.String() {
+		t.Fatalf("bind menus to missing role: got status %d (%s)", code, reason)
+	}
+	var bound v1.ListRoleMenuResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, roleMenus, admin.AccessToken, nil, &bound); code != http.StatusOK {
+		t.Fatalf("list role menus: got status %d", code)
+	}
+	if want := []string{addID, usersID}; !slices.Equal(bound.MenuIDs, slices.Sorted(slices.Values(want))) {
+		t.Fatalf("role menus: got %v", bound.MenuIDs)
+	}
+
+	// The tree contains the bound menu and its directory, the marks the bound button.
+	mine := myMenus(login.AccessToken)
+	if titles := menuTitles(mine.Menus); !slices.Equal(titles, []string{"menus.system.title", "menus.system.user"}) {
+		t.Fatalf("menus of the user: got %v", titles)
+	}
+	if !slices.Equal(mine.AuthMarks, []string{"user:add"}) {
+		t.Fatalf("marks of the user: got %v", mine.AuthMarks)
+	}
+
+	// Moving a directory under its own menu is rejected, moving the menu changes the tree of the user.
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/menus/"+systemID, admin.AccessToken, &v1.UpdateMenuRequest{ParentID: &usersID}, nil); code != http.StatusBadRequest {
+		t.Fatalf("move menu under itself: got status %d, want %d", code, http.StatusBadRequest)
+	}
+	hidden := true
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/menus/"+usersID, admin.AccessToken, &v1.UpdateMenuRequest{ParentID: &dashboardID, Hidden: &hidden}, nil); code != http.StatusOK {
+		t.Fatalf("move menu: got status %d (%s)", code, reason)
+	}
+	mine = myMenus(login.AccessToken)
+	if titles := menuTitles(mine.Menus); !slices.Equal(titles, []string{"menus.dashboard.title", "menus.system.user"}) || !mine.Menus[0].Children[0].Hidden {
+		t.Fatalf("menus after moving: got %v", titles)
+	}
+
+	// Menus with children can not be deleted, deleted menus are unbound.
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/menus/"+usersID, admin.AccessToken, nil, nil); code != http.StatusConflict || reason != v1.ErrorReason_MenuHasChildren.String() {
+		t.Fatalf("delete menu with children: got status %d (%s)", code, reason)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/menus/"+addID, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete button: got status %d", code)
+	}
+	if mine := myMenus(login.AccessToken); len(mine.AuthMarks) != 0 {
+		t.Fatalf("marks after deleting the button: got %v", mine.AuthMarks)
+	}
+
+	// The menus of a disabled role are not shown.
+	disabled := int32(known.RoleStatusDisabled)
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/roles/"+role.Name, admin.AccessToken, &v1.UpdateRoleRequest{Status: &disabled}, nil); code != http.StatusOK {
+		t.Fatalf("disable role: got status %d", code)
+	}
+	if mine := myMenus(login.AccessToken); len(mine.Menus) != 0 {
+		t.Fatalf("menus of a disabled role: got %v", menuTitles(mine.Menus))
+	}
+}
@@ -1,11 +1,15 @@
 package model
 
 import (
+	"time"
+
 	"github.com/google/uuid"
-	"github.com/moweilong/milady/pkg/authn"
 	"github.com/moweilong/milady/pkg/rid"
 	"github.com/moweilong/milady/pkg/store/registry"
 	"gorm.io/gorm"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
 )
 
 // BeforeCreate runs before creating a SecretM database record and initializes various fields.
@@ -26,15 +30,26 @@ func (m *SecretM) BeforeCreate(tx *gorm.DB) (err error) {
 	return nil
 }
 
-// BeforeCreate encrypts the plaintext password before creating a database record.
+// BeforeCreate encrypts the plaintext password, records when it was set and
+// defaults the status before creating a database record.
 func (m *UserM) BeforeCreate(tx *gorm.DB) error {
 	// Encrypt the user password.
 	var err error
-	m.Password, err = authn.Encrypt(m.Password)
+	m.Password, err = pwdhash.Hash(m.Password)
 	if err != nil {
 		return err
 	}
 
+	// Users are active unless they have to verify their email first.
+	if m.Status == "" {
+		m.Status = known.UserStatusActived
+	}
+
+	// The initial password expires like any other one.
+	if m.PasswordChangedAt.IsZero() {
+		m.PasswordChangedAt = time.Now()
+	}
+
 	return nil
 }
 
@@ -45,6 +60,29 @@ func (m *UserM) AfterCreate(tx *gorm.DB) error {
 	return tx.Save(m).Error
 }
 
+// BeforeCreate generates a random SessionID, which can not be guessed from other sessions.
+func (m *UserSessionM) BeforeCreate(tx *gorm.DB) error {
+	if m.SessionID == "" {
+		m.SessionID = uuid.New().String()
+	}
+
+	return nil
+}
+
+// AfterCreate generates a menuID after creating a database record.
+func (m *MenuM) AfterCreate(tx *gorm.DB) error {
+	m.MenuID = rid.NewResourceID("menu").New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate generates a departmentID after creating a database record.
+func (m *DepartmentM) AfterCreate(tx *gorm.DB) error {
+	m.DepartmentID = rid.NewResourceID("dept").New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
+
 func init() {
 	registry.Register(&UserM{})
 }
@@ -12,16 +12,18 @@ const TableNameUserM = "user"
 
 // UserM 用户表
 type UserM struct {
-	ID        int64     `gorm:"column:id;type:bigint unsigned;primaryKey;autoIncrement:true;comment:主键 ID" json:"id"`                        // 主键 ID
-	UserID    string    `gorm:"column:userId;type:varchar(253);not null;uniqueIndex:idx_user_id,priority:1;comment:用户 ID" json:"userId"`     // 用户 ID
-	Username  string    `gorm:"column:username;type:varchar(253);not null;uniqueIndex:idx_username,priority:1;comment:用户名称" json:"username"` // 用户名称
-	Status    int32     `gorm:"column:status;type:tinyint unsigned;not null;default:1;comment:用户状态，0-禁用；1-启用" json:"status"`                 // 用户状态，0-禁用；1-启用
-	Nickname  string    `gorm:"column:nickname;type:varchar(253);not null;comment:用户昵称" json:"nickname"`                                     // 用户昵称
-	Password  string    `gorm:"column:password;type:varchar(64);not null;comment:用户加密后的密码" json:"password"`                                  // 用户加密后的密码
-	Email     string    `gorm:"column:email;type:varchar(253);not null;comment:用户电子邮箱" json:"email"`                                         // 用户电子邮箱
-	Phone     string    `gorm:"column:phone;type:varchar(16);not null;comment:用户手机号" json:"phone"`                                           // 用户手机号
-	CreatedAt time.Time `gorm:"column:createdAt;type:datetime;not null;comment:创建时间" json:"createdAt"`                                       // 创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;type:datetime;not null;comment:最后修改时间" json:"updatedAt"`                                     // 最后修改时间
+	ID                int64     `gorm:"column:id;type:bigint unsigned;primaryKey;autoIncrement:true;comment:主键 ID" json:"id"`                                                 // 主键 ID
+	UserID            string    `gorm:"column:userId;type:varchar(253);not null;uniqueIndex:idx_user_id,priority:1;comment:用户 ID" json:"userId"`                              // 用户 ID
+	Username          string    `gorm:"column:username;type:varchar(253);not null;uniqueIndex:idx_username,priority:1;comment:用户名称" json:"username"`                          // 用户名称
+	Status            string    `gorm:"column:status;type:varchar(16);not null;default:actived;comment:用户状态，registered-待激活；actived-已激活" json:"status"`                        // 用户状态，registered-待激活；actived-已激活
+	Nickname          string    `gorm:"column:nickname;type:varchar(253);not null;comment:用户昵称" json:"nickname"`                                                              // 用户昵称
+	Password          string    `gorm:"column:password;type:varchar(255);not null;comment:用户加密后的密码" json:"password"`                                                          // 用户加密后的密码
+	Email             string    `gorm:"column:email;type:varchar(253);not null;comment:用户电子邮箱" json:"email"`                                                                  // 用户电子邮箱
+	Phone             string    `gorm:"column:phone;type:varchar(16);not null;comment:用户手机号" json:"phone"`                                                                    // 用户手机号
+	DepartmentID      string    `gorm:"column:departmentId;type:varchar(36);not null;index:idx_user_department_id,priority:1;comment:所属部门 ID，不属于任何部门时为空" json:"departmentId"` // 所属部门 ID，不属于任何部门时为空
+	PasswordChangedAt time.Time `gorm:"column:passwordChangedAt;type:datetime;not null;default:CURRENT_TIMESTAMP;comment:最近一次修改密码的时间" json:"passwordChangedAt"`               // 最近一次修改密码的时间
+	CreatedAt         time.Time `gorm:"column:createdAt;type:datetime;not null;comment:创建时间" json:"createdAt"`                                                                // 创建时间
+	UpdatedAt         time.Time `gorm:"column:updatedAt;type:datetime;not null;comment:最后修改时间" json:"updatedAt"`                                                              // 最后修改时间
 }
 
 // TableName UserM's table name
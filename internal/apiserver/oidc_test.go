@@ -0,0 +1,304 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/cookiejar"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/moweilong/milady/pkg/core"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// mockIdP is a minimal OpenID Connect provider. It issues an authorization
+// code for every login it is told about, and checks the PKCE verifier when the
+// code is exchanged.
+type mockIdP struct {
+	*httptest.Server
+	t   *testing.T
+	key *rsa.PrivateKey
+
+	mu    sync.Mutex
+	codes map[string]mockGrant
+}
+
+// mockGrant is what the mock IdP remembers about an authorization code.
+type mockGrant struct {
+	challenge string
+	claims    jwt.MapClaims
+}
+
+func newMockIdP(t *testing.T) *mockIdP {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("generate key: %v", err)
+	}
+	idp := &mockIdP{t: t, key: key, codes: map[string]mockGrant{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 idp.URL,
+			"authorization_endpoint": idp.URL + "/authorize",
+			"token_endpoint":         idp.URL + "/token",
+			"jwks_uri":               idp.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": "idp-key",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		grant, ok := idp.codes[r.PostFormValue("code")]
+		delete(idp.codes, r.PostFormValue("code"))
+		idp.mu.Unlock()
+
+		sum := sha256.Sum256([]byte(r.PostFormValue("code_verifier")))
+		if !ok || base64.RawURLEncoding.EncodeToString(sum[:]) != grant.challenge {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"invalid_grant"}`))
+			return
+		}
+
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, grant.claims)
+		token.Header["kid"] = "idp-key"
+		idToken, err := token.SignedString(key)
+		if err != nil {
+			t.Errorf("sign id_token: %v", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "idp-access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idToken,
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	t.Cleanup(idp.Close)
+
+	return idp
+}
+
+// authorize plays the user logging in at the IdP: it reads the authorization
+// URL and returns the code and state the IdP redirects back with.
+func (idp *mockIdP) authorize(authURL string, claims jwt.MapClaims) (code, state string) {
+	idp.t.Helper()
+
+	u, err := url.Parse(authURL)
+	if err != nil {
+		idp.t.Fatalf("parse auth url: %v", err)
+	}
+	q := u.Query()
+	if q.Get("code_challenge_method") != "S256" || q.Get("code_challenge") == "" {
+		idp.t.Fatalf("auth url without PKCE: %s", authURL)
+	}
+
+	full := jwt.MapClaims{
+		"iss":   idp.URL,
+		"aud":   q.Get("client_id"),
+		"exp":   time.Now().Add(time.Minute).Unix(),
+		"iat":   time.Now().Unix(),
+		"nonce": q.Get("nonce"),
+	}
+	for k, v := range claims {
+		full[k] = v
+	}
+
+	code = rand.Text()
+	idp.mu.Lock()
+	idp.codes[code] = mockGrant{challenge: q.Get("code_challenge"), claims: full}
+	idp.mu.Unlock()
+
+	return code, q.Get("state")
+}
+
+// get sends a GET request to the engine and decodes the response into out.
+func get(t *testing.T, engine *gin.Engine, path string, out any) (int, string) {
+	t.Helper()
+
+	return doRequest(t, engine, http.MethodGet, path, "", nil, out)
+}
+
+func TestOIDCLogin(t *testing.T) {
+	idp := newMockIdP(t)
+	engine, _ := newTestEngine(t, func(c *Config) {
+		c.OIDCOptions.Providers = []options.OIDCProviderOptions{{
+			Name:        "mock",
+			DisplayName: "Mock IdP",
+			Issuer:      idp.URL,
+			ClientID:    "art",
+			RedirectURL: "http://localhost/sso/callback",
+		}}
+	})
+
+	var providers v1.ListOIDCProviderResponse
+	if code, _ := get(t, engine, "/v1/auth/oidc/providers", &providers); code != http.StatusOK {
+		t.Fatalf("list providers: got status %d", code)
+	}
+	if len(providers.Providers) != 1 || providers.Providers[0].DisplayName != "Mock IdP" {
+		t.Fatalf("list providers: got %v", providers.Providers)
+	}
+	if code, reason := get(t, engine, "/v1/auth/oidc/unknown/login", nil); code != http.StatusNotFound || reason != "OIDCProviderNotFound" {
+		t.Fatalf("unknown provider: got status %d (%s)", code, reason)
+	}
+
+	// start begins a login in a browser and returns the code and state the IdP redirects back with.
+	start := func(browser http.CookieJar, claims jwt.MapClaims) (string, string) {
+		var start v1.OIDCLoginResponse
+		if code, reason := browse(t, engine, browser, "/v1/auth/oidc/mock/login", &start); code != http.StatusOK {
+			t.Fatalf("start login: got status %d (%s)", code, reason)
+		}
+		return idp.authorize(start.AuthURL, claims)
+	}
+	// callback completes a login in a browser and returns the status of the callback.
+	callback := func(browser http.CookieJar, code, state string) (*v1.LoginReply, int, string) {
+		var reply v1.LoginReply
+		status, reason := browse(t, engine, browser, "/v1/auth/oidc/mock/callback?"+url.Values{"code": {code}, "state": {state}}.Encode(), &reply)
+		return &reply, status, reason
+	}
+	// login runs a full login in a new browser and returns the status of the callback.
+	login := func(claims jwt.MapClaims, tamper func(code, state string) (string, string)) (*v1.LoginReply, int, string) {
+		browser := newBrowser(t)
+		code, state := start(browser, claims)
+		if tamper != nil {
+			code, state = tamper(code, state)
+		}
+		return callback(browser, code, state)
+	}
+
+	alice := jwt.MapClaims{"sub": "alice-sub", "preferred_username": "alice.sso", "email": "alice@example.com", "name": "Alice"}
+	first, status, reason := login(alice, nil)
+	if status != http.StatusOK || first.AccessToken == "" || first.RefreshToken == "" {
+		t.Fatalf("first login: got status %d (%s)", status, reason)
+	}
+
+	user, err := store.S.User().Get(context.Background(), where.F("userID", subject(t, first.AccessToken)))
+	if err != nil {
+		t.Fatalf("get provisioned user: %v", err)
+	}
+	if user.Username != "alice_sso" || user.Nickname != "Alice" || user.Email != "alice@example.com" {
+		t.Fatalf("provisioned user: got %s/%s/%s", user.Username, user.Nickname, user.Email)
+	}
+
+	second, status, reason := login(alice, nil)
+	if status != http.StatusOK || subject(t, second.AccessToken) != subject(t, first.AccessToken) {
+		t.Fatalf("second login: got status %d (%s), want the same user", status, reason)
+	}
+
+	tests := map[string]struct {
+		claims jwt.MapClaims
+		tamper func(code, state string) (string, string)
+	}{
+		"unknown state":  {alice, func(code, _ string) (string, string) { return code, "unknown" }},
+		"wrong code":     {alice, func(_, state string) (string, string) { return "wrong", state }},
+		"wrong nonce":    {jwt.MapClaims{"sub": "alice-sub", "nonce": "wrong"}, nil},
+		"wrong issuer":   {jwt.MapClaims{"sub": "alice-sub", "iss": "https://evil.example.com"}, nil},
+		"wrong audience": {jwt.MapClaims{"sub": "alice-sub", "aud": "other"}, nil},
+		"expired":        {jwt.MapClaims{"sub": "alice-sub", "exp": time.Now().Add(-time.Minute).Unix()}, nil},
+	}
+	for name, tt := range tests {
+		if _, status, reason := login(tt.claims, tt.tamper); status != http.StatusUnauthorized || reason != "OIDCLoginFailed" {
+			t.Errorf("%s: got status %d (%s)", name, status, reason)
+		}
+	}
+
+	// A callback only completes the login in the browser that started it: a victim
+	// following the callback URL of an attacker is not logged in as the attacker.
+	attacker, victim := newBrowser(t), newBrowser(t)
+	code, state := start(attacker, alice)
+	if _, status, reason := callback(victim, code, state); status != http.StatusUnauthorized || reason != "OIDCLoginFailed" {
+		t.Errorf("callback without the cookie: got status %d (%s)", status, reason)
+	}
+	if _, status, reason := callback(attacker, code, state); status != http.StatusUnauthorized || reason != "OIDCLoginFailed" {
+		t.Errorf("state used by another browser: got status %d (%s), want it consumed", status, reason)
+	}
+	start(victim, alice)
+	code, state = start(attacker, alice)
+	if _, status, reason := callback(victim, code, state); status != http.StatusUnauthorized || reason != "OIDCLoginFailed" {
+		t.Errorf("callback with the cookie of another login: got status %d (%s)", status, reason)
+	}
+	if cookies := victim.Cookies(engineURL); len(cookies) != 0 {
+		t.Errorf("callback must clear the cookie, got %v", cookies)
+	}
+}
+
+// engineURL is the address the test browsers send their requests to.
+var engineURL = &url.URL{Scheme: "http", Host: "example.com", Path: "/v1/auth/oidc/"}
+
+// newBrowser returns the cookie jar of a browser.
+func newBrowser(t *testing.T) http.CookieJar {
+	t.Helper()
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		t.Fatalf("create cookie jar: %v", err)
+	}
+	return jar
+}
+
+// browse sends a GET request from a browser to the engine: the request carries
+// the cookies of the browser, and the cookies set by the response are kept.
+func browse(t *testing.T, engine *gin.Engine, browser http.CookieJar, path string, out any) (int, string) {
+	t.Helper()
+
+	target, err := engineURL.Parse(path)
+	if err != nil {
+		t.Fatalf("parse %s: %v", path, err)
+	}
+	rq := httptest.NewRequest(http.MethodGet, target.String(), nil)
+	for _, cookie := range browser.Cookies(rq.URL) {
+		rq.AddCookie(cookie)
+	}
+	w := httptest.NewRecorder()
+	engine.ServeHTTP(w, rq)
+	browser.SetCookies(rq.URL, w.Result().Cookies())
+
+	if w.Code != http.StatusOK {
+		var resp core.ErrorResponse
+		_ = json.Unmarshal(w.Body.Bytes(), &resp)
+		return w.Code, resp.Reason
+	}
+	if out != nil {
+		if err := json.Unmarshal(w.Body.Bytes(), out); err != nil {
+			t.Fatalf("decode %s response: %v", path, err)
+		}
+	}
+	return w.Code, ""
+}
+
+// subject returns the user ID a token was issued to, without verifying it.
+func subject(t *testing.T, token string) string {
+	t.Helper()
+
+	var claims jwt.RegisteredClaims
+	if _, _, err := new(jwt.Parser).ParseUnverified(token, &claims); err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+	return claims.Subject
+}
@@ -0,0 +1,70 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestPasswordRehash(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	user := createUser(t, engine, "rehashuser")
+	db := store.S.DB(context.Background())
+	hash := func() string {
+		var password string
+		if err := db.Raw("SELECT password FROM user WHERE username = ?", user.Username).Scan(&password).Error; err != nil {
+			t.Fatalf("read password hash: %v", err)
+		}
+		return password
+	}
+	login := func(password string) int {
+		code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: password}, nil)
+		return code
+	}
+
+	if got := hash(); !strings.HasPrefix(got, "$argon2id$v=19$m=65536,t=3,p=2$") {
+		t.Fatalf("new password: got hash %q, want argon2id with the default parameters", got)
+	}
+
+	// The bcrypt hashes of older releases still verify, and are replaced on the next login.
+	legacy, _ := bcrypt.GenerateFromPassword([]byte(user.Password), bcrypt.DefaultCost)
+	if err := db.Exec("UPDATE user SET password = ? WHERE username = ?", string(legacy), user.Username).Error; err != nil {
+		t.Fatalf("store bcrypt hash: %v", err)
+	}
+	if code := login("wrong123456"); code == http.StatusOK {
+		t.Fatalf("wrong password against a bcrypt hash must fail")
+	}
+	if got := hash(); got != string(legacy) {
+		t.Fatalf("failed login must not rehash the password")
+	}
+	if code := login(user.Password); code != http.StatusOK {
+		t.Fatalf("login with a bcrypt hash: got status %d", code)
+	}
+	if got := hash(); !strings.HasPrefix(got, "$argon2id$") {
+		t.Fatalf("login with a bcrypt hash: got hash %q, want argon2id", got)
+	}
+
+	// Changing the parameters rehashes the passwords the same way.
+	opts := options.NewPasswordHashOptions()
+	opts.Iterations = 2
+	pwdhash.Init(opts)
+	t.Cleanup(func() { pwdhash.Init(options.NewPasswordHashOptions()) })
+	if code := login(user.Password); code != http.StatusOK {
+		t.Fatalf("login with outdated parameters: got status %d", code)
+	}
+	if got := hash(); !strings.HasPrefix(got, "$argon2id$v=19$m=65536,t=2,p=2$") {
+		t.Fatalf("login with outdated parameters: got hash %q", got)
+	}
+	if code := login(user.Password); code != http.StatusOK {
+		t.Fatalf("login with a rehashed password: got status %d", code)
+	}
+}
@@ -0,0 +1,107 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moweilong/milady/pkg/core"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestPasswordPolicy(t *testing.T) {
+	engine, _ := newTestEngine(t, func(cfg *Config) {
+		cfg.PasswordPolicyOptions.HistorySize = 3
+		cfg.PasswordPolicyOptions.MaxAge = 24 * time.Hour
+	})
+
+	// Violations are reported per rule.
+	user := newUserRequest("policyuser")
+	user.Password = "abc"
+	var violation core.ErrorResponse
+	if code, reason := do(t, engine, "/v1/users", "", user, &violation); code != http.StatusBadRequest || reason != v1.ErrorReason_PasswordPolicyViolation.String() {
+		t.Fatalf("register with a weak password: got status %d reason %q", code, reason)
+	}
+	if violation.Metadata[pwdpolicy.RuleMinLength] == "" || violation.Metadata[pwdpolicy.RuleDigit] == "" || violation.Metadata[pwdpolicy.RuleLetter] != "" {
+		t.Fatalf("register with a weak password: unexpected violations %v", violation.Metadata)
+	}
+	user.Password = "Password1"
+	if code, _ := do(t, engine, "/v1/users", "", user, &violation); code != http.StatusBadRequest || violation.Metadata[pwdpolicy.RuleBlocklist] == "" {
+		t.Fatalf("register with a common password: got status %d violations %v", code, violation.Metadata)
+	}
+
+	user.Password = "policy123456"
+	var created v1.CreateUserResponse
+	if code, _ := do(t, engine, "/v1/users", "", user, &created); code != http.StatusOK {
+		t.Fatalf("create user: got status %d", code)
+	}
+	login := loginFrom(t, engine, user.Username, user.Password, chromeOnMac)
+	if login.PasswordExpired || login.AccessToken == "" {
+		t.Fatalf("login with a new password: got %+v", login)
+	}
+
+	// The current password and the previous ones within the history size can not be reused.
+	updatePassword := func(oldPassword, newPassword string) (int, *core.ErrorResponse) {
+		t.Helper()
+		rq := &v1.UpdatePasswordRequest{OldPassword: oldPassword, NewPassword: newPassword}
+		var resp core.ErrorResponse
+		code, _ := doRequest(t, engine, http.MethodPut, "/v1/users/"+created.UserID+"/update-password", login.AccessToken, rq, &resp)
+		return code, &resp
+	}
+	if code, resp := updatePassword("policy123456", "policy123456"); code != http.StatusBadRequest || resp.Metadata[pwdpolicy.RuleHistory] == "" {
+		t.Fatalf("reuse the current password: got status %d violations %v", code, resp.Metadata)
+	}
+	for _, step := range []struct {
+		oldPassword, newPassword string
+		code                     int
+	}{
+		{"policy123456", "policy234567", http.StatusOK},
+		{"policy234567", "policy123456", http.StatusBadRequest},
+		{"policy234567", "policy345678", http.StatusOK},
+		{"policy345678", "policy456789", http.StatusOK},
+		// The first password has left the history.
+		{"policy456789", "policy123456", http.StatusOK},
+	} {
+		if code, resp := updatePassword(step.oldPassword, step.newPassword); code != step.code {
+			t.Fatalf("change password from %s to %s: got status %d (%s), want %d", step.oldPassword, step.newPassword, code, resp.Message, step.code)
+		}
+	}
+	var history int64
+	store.S.DB(context.Background()).Table("user_password_history").Where("userId = ?", created.UserID).Count(&history)
+	if history != 2 {
+		t.Fatalf("password history: got %d passwords, want 2", history)
+	}
+
+	// An expired password is exchanged for tokens only after it has been changed.
+	err := store.S.DB(context.Background()).Exec("UPDATE user SET passwordChangedAt = ? WHERE userId = ?", time.Now().Add(-48*time.Hour), created.UserID).Error
+	if err != nil {
+		t.Fatalf("expire password: %v", err)
+	}
+	expired := loginFrom(t, engine, user.Username, "policy123456", chromeOnMac)
+	if !expired.PasswordExpired || expired.PasswordToken == "" || expired.AccessToken != "" {
+		t.Fatalf("login with an expired password: got %+v", expired)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", expired.PasswordToken, nil, nil); code != http.StatusUnauthorized {
+		t.Fatalf("access with a password change token: got status %d", code)
+	}
+
+	change := &v1.ChangeExpiredPasswordRequest{PasswordToken: expired.PasswordToken, NewPassword: "policy123456"}
+	if code, reason := do(t, engine, "/v1/auth/change-expired-password", "", change, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_PasswordPolicyViolation.String() {
+		t.Fatalf("keep the expired password: got status %d reason %q", code, reason)
+	}
+	change.NewPassword = "policy567890"
+	var changed v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/change-expired-password", "", change, &changed); code != http.StatusOK || changed.AccessToken == "" {
+		t.Fatalf("change expired password: got status %d reply %+v", code, &changed)
+	}
+	if code, _ := do(t, engine, "/v1/auth/change-expired-password", "", change, nil); code != http.StatusUnauthorized {
+		t.Fatalf("reuse the password change token: got status %d", code)
+	}
+	if relogin := loginFrom(t, engine, user.Username, change.NewPassword, chromeOnMac); relogin.PasswordExpired || relogin.AccessToken == "" {
+		t.Fatalf("login with the changed password: got %+v", relogin)
+	}
+}
@@ -0,0 +1,163 @@
+package apiserver
+
+import (
+	"io"
+	"mime/quotedprintable"
+	"net/http"
+	"net/mail"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+var tokenLinkRegex = regexp.MustCompile(`token=(\S+)`)
+
+// mailedTokens returns the tokens of the links in the mails to the address to
+// written to dir, oldest first.
+func mailedTokens(t *testing.T, dir, to string) []string {
+	t.Helper()
+
+	files, _ := filepath.Glob(filepath.Join(dir, "*.eml"))
+	sort.Strings(files)
+
+	var tokens []string
+	for _, file := range files {
+		f, err := os.Open(file)
+		if err != nil {
+			t.Fatalf("open mail: %v", err)
+		}
+		msg, err := mail.ReadMessage(f)
+		if err != nil {
+			t.Fatalf("parse mail: %v", err)
+		}
+		if msg.Header.Get("To") != "<"+to+">" {
+			f.Close()
+			continue
+		}
+		body, _ := io.ReadAll(quotedprintable.NewReader(msg.Body))
+		f.Close()
+
+		match := tokenLinkRegex.FindSubmatch(body)
+		if match == nil {
+			t.Fatalf("no link with a token in mail %s", body)
+		}
+		token, _ := url.QueryUnescape(string(match[1]))
+		tokens = append(tokens, token)
+	}
+
+	return tokens
+}
+
+func TestPasswordReset(t *testing.T) {
+	dir := t.TempDir()
+	engine, _ := newTestEngine(t, func(cfg *Config) {
+		cfg.MailOptions.Driver = options.MailDriverFile
+		cfg.MailOptions.Dir = dir
+		cfg.PasswordResetOptions.MaxUserRequests = 2
+	})
+
+	user := createUser(t, engine, "resetuser")
+	laptop := user.login(t, engine)
+	desktop := loginFrom(t, engine, user.Username, user.Password, firefoxOnLinux)
+
+	// Unknown usernames get the same reply, but no mail.
+	if code, _ := do(t, engine, "/v1/auth/password/forgot", "", &v1.ForgotPasswordRequest{Username: "nosuchuser"}, nil); code != http.StatusOK {
+		t.Fatalf("forgot password of an unknown user: got status %d", code)
+	}
+	if tokens := mailedTokens(t, dir, user.Email); len(tokens) != 0 {
+		t.Fatalf("forgot password of an unknown user: got %d mails", len(tokens))
+	}
+
+	// Every request mails a new token, which replaces the previous one, until the limit is reached.
+	forgot := &v1.ForgotPasswordRequest{Username: user.Username}
+	for i := 0; i < 2; i++ {
+		if code, _ := do(t, engine, "/v1/auth/password/forgot", "", forgot, nil); code != http.StatusOK {
+			t.Fatalf("forgot password %d: got status %d", i, code)
+		}
+	}
+	if code, reason := do(t, engine, "/v1/auth/password/forgot", "", forgot, nil); code != http.StatusTooManyRequests || reason != v1.ErrorReason_PasswordResetRateLimited.String() {
+		t.Fatalf("forgot password over the limit: got status %d reason %q", code, reason)
+	}
+	tokens := mailedTokens(t, dir, user.Email)
+	if len(tokens) != 2 || tokens[0] == tokens[1] {
+		t.Fatalf("forgot password: got tokens %v", tokens)
+	}
+	if code, reason := do(t, engine, "/v1/auth/password/reset", "", &v1.ResetPasswordRequest{Token: tokens[0], NewPassword: "reset234567"}, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidPasswordResetToken.String() {
+		t.Fatalf("reset with a replaced token: got status %d reason %q", code, reason)
+	}
+
+	// A password rejected by the policy does not use up the token.
+	reset := &v1.ResetPasswordRequest{Token: tokens[1], NewPassword: user.Password}
+	if code, reason := do(t, engine, "/v1/auth/password/reset", "", reset, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_PasswordPolicyViolation.String() {
+		t.Fatalf("reset to the current password: got status %d reason %q", code, reason)
+	}
+	reset.NewPassword = "reset234567"
+	if code, _ := do(t, engine, "/v1/auth/password/reset", "", reset, nil); code != http.StatusOK {
+		t.Fatalf("reset password: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/password/reset", "", reset, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidPasswordResetToken.String() {
+		t.Fatalf("reuse a reset token: got status %d reason %q", code, reason)
+	}
+
+	// All sessions end with the reset.
+	for _, login := range []*v1.LoginReply{laptop, desktop} {
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", login.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != v1.ErrorReason_SessionRevoked.String() {
+			t.Fatalf("access token after reset: got status %d reason %q", code, reason)
+		}
+	}
+	if code, _ := do(t, engine, "/v1/auth/login", "", &v1.LoginRequest{Username: user.Username, Password: user.Password}, nil); code == http.StatusOK {
+		t.Fatalf("login with the old password: got status %d", code)
+	}
+	loginFrom(t, engine, user.Username, reset.NewPassword, chromeOnMac)
+}
+
+func TestConcurrentPasswordReset(t *testing.T) {
+	dir := t.TempDir()
+	engine, _ := newTestEngine(t, func(cfg *Config) {
+		cfg.MailOptions.Driver = options.MailDriverFile
+		cfg.MailOptions.Dir = dir
+	})
+
+	user := createUser(t, engine, "concurrentreset")
+	if code, _ := do(t, engine, "/v1/auth/password/forgot", "", &v1.ForgotPasswordRequest{Username: user.Username}, nil); code != http.StatusOK {
+		t.Fatalf("forgot password: got status %d", code)
+	}
+	tokens := mailedTokens(t, dir, user.Email)
+	if len(tokens) != 1 {
+		t.Fatalf("forgot password: got tokens %v", tokens)
+	}
+
+	// The token is used once, no matter how many requests present it at the same time.
+	const requests = 5
+	codes := make(chan int, requests)
+	var wg sync.WaitGroup
+	for i := 0; i < requests; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			reset := &v1.ResetPasswordRequest{Token: tokens[0], NewPassword: "concurrent" + strconv.Itoa(100000+i)}
+			code, _ := do(t, engine, "/v1/auth/password/reset", "", reset, nil)
+			codes <- code
+		}()
+	}
+	wg.Wait()
+	close(codes)
+
+	var succeeded int
+	for code := range codes {
+		if code == http.StatusOK {
+			succeeded++
+		}
+	}
+	if succeeded != 1 {
+		t.Fatalf("concurrent resets with the same token: %d succeeded, want 1", succeeded)
+	}
+}
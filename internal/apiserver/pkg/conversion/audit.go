@@ -0,0 +1,23 @@
+package conversion
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// AuditLogMToAuditLogV1 converts an AuditLogM object from the internal model
+// to an AuditLog object in the v1 API format.
+func AuditLogMToAuditLogV1(auditLogModel *model.AuditLogM) *v1.AuditLog {
+	return &v1.AuditLog{
+		UserID:     auditLogModel.UserID,
+		ActorID:    auditLogModel.ActorID,
+		Action:     auditLogModel.Action,
+		Path:       auditLogModel.Path,
+		StatusCode: auditLogModel.StatusCode,
+		Ip:         auditLogModel.IP,
+		SessionID:  auditLogModel.SessionID,
+		CreatedAt:  timestamppb.New(auditLogModel.CreatedAt),
+	}
+}
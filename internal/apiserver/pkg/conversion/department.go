@@ -0,0 +1,23 @@
+package conversion
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// DepartmentMToDepartmentV1 converts a DepartmentM object from the internal model
+// to a Department object in the v1 API format, without its children.
+func DepartmentMToDepartmentV1(departmentModel *model.DepartmentM) *v1.Department {
+	return &v1.Department{
+		DepartmentID: departmentModel.DepartmentID,
+		ParentID:     departmentModel.ParentID,
+		Name:         departmentModel.Name,
+		Sort:         departmentModel.Sort,
+		LeaderID:     departmentModel.LeaderID,
+		Status:       departmentModel.Status,
+		CreatedAt:    timestamppb.New(departmentModel.CreatedAt),
+		UpdatedAt:    timestamppb.New(departmentModel.UpdatedAt),
+	}
+}
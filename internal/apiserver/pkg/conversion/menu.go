@@ -0,0 +1,29 @@
+package conversion
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// MenuMToMenuV1 converts a MenuM object from the internal model
+// to a Menu object in the v1 API format, without its children.
+func MenuMToMenuV1(menuModel *model.MenuM) *v1.Menu {
+	return &v1.Menu{
+		MenuID:    menuModel.MenuID,
+		ParentID:  menuModel.ParentID,
+		Type:      menuModel.Type,
+		Name:      menuModel.Name,
+		Path:      menuModel.Path,
+		Component: menuModel.Component,
+		Icon:      menuModel.Icon,
+		Sort:      menuModel.Sort,
+		Title:     menuModel.Title,
+		Hidden:    menuModel.Hidden,
+		KeepAlive: menuModel.KeepAlive,
+		AuthMark:  menuModel.AuthMark,
+		CreatedAt: timestamppb.New(menuModel.CreatedAt),
+		UpdatedAt: timestamppb.New(menuModel.UpdatedAt),
+	}
+}
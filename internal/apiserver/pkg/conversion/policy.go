@@ -0,0 +1,25 @@
+package conversion
+
+import (
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// PolicyV1ToRule converts a Policy object in the v1 API format
+// to a casbin p rule of sub, obj, act and eft.
+func PolicyV1ToRule(policy *v1.Policy) []string {
+	return []string{policy.GetSubject(), policy.GetObject(), policy.GetAction(), policy.GetEffect()}
+}
+
+// RuleToPolicyV1 converts a casbin p rule to a Policy object in the v1 API format.
+// Rules without an effect allow the action.
+func RuleToPolicyV1(rule []string) *v1.Policy {
+	policy := &v1.Policy{Effect: known.PolicyEffectAllow}
+	fields := []*string{&policy.Subject, &policy.Object, &policy.Action, &policy.Effect}
+	for i, value := range rule {
+		if i < len(fields) && value != "" {
+			*fields[i] = value
+		}
+	}
+	return policy
+}
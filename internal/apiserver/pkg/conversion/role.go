@@ -0,0 +1,26 @@
+package conversion
+
+import (
+	"slices"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// RoleMToRoleV1 converts a RoleM object from the internal model
+// to a Role object in the v1 API format, without the departments of its data scope.
+func RoleMToRoleV1(roleModel *model.RoleM) *v1.Role {
+	return &v1.Role{
+		Name:        roleModel.Name,
+		DisplayName: roleModel.DisplayName,
+		Description: roleModel.Description,
+		Status:      roleModel.Status,
+		Builtin:     slices.Contains(known.BuiltinRoles, roleModel.Name),
+		DataScope:   roleModel.DataScope,
+		CreatedAt:   timestamppb.New(roleModel.CreatedAt),
+		UpdatedAt:   timestamppb.New(roleModel.UpdatedAt),
+	}
+}
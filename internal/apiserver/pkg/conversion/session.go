@@ -0,0 +1,23 @@
+package conversion
+
+import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// UserSessionMToSessionV1 converts a UserSessionM object from the internal model
+// to a Session object in the v1 API format.
+func UserSessionMToSessionV1(sessionModel *model.UserSessionM) *v1.Session {
+	return &v1.Session{
+		SessionID:      sessionModel.SessionID,
+		Device:         sessionModel.Device,
+		Ip:             sessionModel.IP,
+		UserAgent:      sessionModel.UserAgent,
+		IssuedAt:       timestamppb.New(sessionModel.CreatedAt),
+		LastSeenAt:     timestamppb.New(sessionModel.LastSeenAt),
+		ExpiresAt:      timestamppb.New(sessionModel.ExpiresAt),
+		ImpersonatedBy: sessionModel.ActorID,
+	}
+}
@@ -0,0 +1,15 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ValidateListAuditLogRequest 校验 ListAuditLogRequest 结构体的有效性.
+func (v *Validator) ValidateListAuditLogRequest(ctx context.Context, rq *v1.ListAuditLogRequest) error {
+	// userID 为空时表示当前用户，数据权限范围外的用户的审计日志由 store 层过滤
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Offset", "Limit")
+}
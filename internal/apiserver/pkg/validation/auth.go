@@ -0,0 +1,110 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateAuthRules() genericvalidation.Rules {
+	// 非空字段的通用校验函数
+	notEmpty := func(field string) genericvalidation.ValidatorFunc {
+		return func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("%s cannot be empty", field)
+			}
+			return nil
+		}
+	}
+
+	return genericvalidation.Rules{
+		"Token":        notEmpty("token"),
+		"RefreshToken": notEmpty("refreshToken"),
+		"Sub":          notEmpty("sub"),
+		"Obj":          notEmpty("obj"),
+		"Act":          notEmpty("act"),
+		// 新密码是否符合密码策略由 v.policy 校验
+		"PasswordToken": notEmpty("passwordToken"),
+		"NewPassword":   notEmpty("newPassword"),
+	}
+}
+
+// ValidateLogoutRequest 校验 LogoutRequest 结构体的有效性.
+func (v *Validator) ValidateLogoutRequest(ctx context.Context, rq *v1.LogoutRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules())
+}
+
+// ValidateChangeExpiredPasswordRequest 校验 ChangeExpiredPasswordRequest 结构体的有效性.
+func (v *Validator) ValidateChangeExpiredPasswordRequest(ctx context.Context, rq *v1.ChangeExpiredPasswordRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules()); err != nil {
+		return err
+	}
+	return v.policy.Validate(ctx, rq.GetNewPassword())
+}
+
+// ValidateForgotPasswordRequest 校验 ForgotPasswordRequest 结构体的有效性.
+func (v *Validator) ValidateForgotPasswordRequest(ctx context.Context, rq *v1.ForgotPasswordRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateResetPasswordRequest 校验 ResetPasswordRequest 结构体的有效性.
+func (v *Validator) ValidateResetPasswordRequest(ctx context.Context, rq *v1.ResetPasswordRequest) error {
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules()); err != nil {
+		return err
+	}
+	return v.policy.Validate(ctx, rq.GetNewPassword())
+}
+
+// ValidateVerifyEmailRequest 校验 VerifyEmailRequest 结构体的有效性.
+func (v *Validator) ValidateVerifyEmailRequest(ctx context.Context, rq *v1.VerifyEmailRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules())
+}
+
+// ValidateResendVerificationRequest 校验 ResendVerificationRequest 结构体的有效性.
+func (v *Validator) ValidateResendVerificationRequest(ctx context.Context, rq *v1.ResendVerificationRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
+
+// ValidateRefreshTokenRequest 校验 RefreshTokenRequest 结构体的有效性.
+func (v *Validator) ValidateRefreshTokenRequest(ctx context.Context, rq *v1.RefreshTokenRequest) error {
+	return nil
+}
+
+// ValidateAuthenticateRequest 校验 AuthenticateRequest 结构体的有效性.
+func (v *Validator) ValidateAuthenticateRequest(ctx context.Context, rq *v1.AuthenticateRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules())
+}
+
+// ValidateAuthorizeRequest 校验 AuthorizeRequest 结构体的有效性.
+func (v *Validator) ValidateAuthorizeRequest(ctx context.Context, rq *v1.AuthorizeRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules())
+}
+
+// ValidateAuthRequest 校验 AuthRequest 结构体的有效性.
+func (v *Validator) ValidateAuthRequest(ctx context.Context, rq *v1.AuthRequest) error {
+	// 网关转发的请求没有携带令牌时按未认证处理，而不是参数错误
+	if rq.Token == "" {
+		return errno.ErrUnauthenticated.WithMessage("token is missing")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateAuthRules())
+}
+
+// ValidateListJWTKeyRequest 校验 ListJWTKeyRequest 结构体的有效性.
+func (v *Validator) ValidateListJWTKeyRequest(ctx context.Context, rq *v1.ListJWTKeyRequest) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can list jwt keys")
+	}
+	return nil
+}
+
+// ValidatePromoteJWTKeyRequest 校验 PromoteJWTKeyRequest 结构体的有效性.
+func (v *Validator) ValidatePromoteJWTKeyRequest(ctx context.Context, rq *v1.PromoteJWTKeyRequest) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can promote jwt keys")
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ValidateDepartmentRules 返回部门相关字段的校验规则.
+func (v *Validator) ValidateDepartmentRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"ParentID": func(value any) error {
+			if len(value.(string)) > 36 {
+				return errno.ErrInvalidArgument.WithMessage("parentID must be at most 36 characters")
+			}
+			return nil
+		},
+		"LeaderID": func(value any) error {
+			if len(value.(string)) > 36 {
+				return errno.ErrInvalidArgument.WithMessage("leaderID must be at most 36 characters")
+			}
+			return nil
+		},
+		"Name": func(value any) error {
+			if name := value.(string); name == "" || len(name) > 64 {
+				return errno.ErrInvalidArgument.WithMessage("name must be between 1 and 64 characters")
+			}
+			return nil
+		},
+		"Status": func(value any) error {
+			if status := value.(int32); status != known.DepartmentStatusDisabled && status != known.DepartmentStatusEnabled {
+				return errno.ErrInvalidArgument.WithMessage("status must be 0 (disabled) or 1 (enabled)")
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateDepartmentRequest 校验 CreateDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateCreateDepartmentRequest(ctx context.Context, rq *v1.CreateDepartmentRequest) error {
+	if err := validateDepartmentAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateDepartmentRules())
+}
+
+// ValidateUpdateDepartmentRequest 校验 UpdateDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateDepartmentRequest(ctx context.Context, rq *v1.UpdateDepartmentRequest) error {
+	if err := validateDepartmentAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateDepartmentRules())
+}
+
+// ValidateDeleteDepartmentRequest 校验 DeleteDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteDepartmentRequest(ctx context.Context, rq *v1.DeleteDepartmentRequest) error {
+	return validateDepartmentAdmin(ctx)
+}
+
+// ValidateGetDepartmentRequest 校验 GetDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateGetDepartmentRequest(ctx context.Context, rq *v1.GetDepartmentRequest) error {
+	// 所有用户都可以查看部门
+	return nil
+}
+
+// ValidateListDepartmentRequest 校验 ListDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateListDepartmentRequest(ctx context.Context, rq *v1.ListDepartmentRequest) error {
+	return nil
+}
+
+// ValidateGetDepartmentSubtreeRequest 校验 GetDepartmentSubtreeRequest 结构体的有效性.
+func (v *Validator) ValidateGetDepartmentSubtreeRequest(ctx context.Context, rq *v1.GetDepartmentSubtreeRequest) error {
+	return nil
+}
+
+// ValidateListDepartmentAncestorRequest 校验 ListDepartmentAncestorRequest 结构体的有效性.
+func (v *Validator) ValidateListDepartmentAncestorRequest(ctx context.Context, rq *v1.ListDepartmentAncestorRequest) error {
+	return nil
+}
+
+// ValidateUpdateUserDepartmentRequest 校验 UpdateUserDepartmentRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateUserDepartmentRequest(ctx context.Context, rq *v1.UpdateUserDepartmentRequest) error {
+	// departmentID 可以为空，表示将用户移出部门
+	return validateDepartmentAdmin(ctx)
+}
+
+// validateDepartmentAdmin 只允许管理员管理部门及用户所属的部门.
+func validateDepartmentAdmin(ctx context.Context) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can manage the departments")
+	}
+	return nil
+}
@@ -0,0 +1,125 @@
+package validation
+
+import (
+	"context"
+	"regexp"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// authMarkRegex 权限标识，例如 user:add 或 system.user.edit
+var authMarkRegex = regexp.MustCompile(`^[A-Za-z0-9_:.-]{0,128}$`)
+
+// ValidateMenuRules 返回菜单相关字段的校验规则.
+func (v *Validator) ValidateMenuRules() genericvalidation.Rules {
+	// maxLength 返回校验字段长度的规则
+	maxLength := func(field string, max int) func(value any) error {
+		return func(value any) error {
+			if len(value.(string)) > max {
+				return errno.ErrInvalidArgument.WithMessage("%s must be at most %d characters", field, max)
+			}
+			return nil
+		}
+	}
+
+	return genericvalidation.Rules{
+		"ParentID":  maxLength("parentID", 36),
+		"Name":      maxLength("name", 64),
+		"Path":      maxLength("path", 255),
+		"Component": maxLength("component", 255),
+		"Icon":      maxLength("icon", 64),
+		"Type": func(value any) error {
+			if menuType := value.(int32); menuType < known.MenuTypeDirectory || menuType > known.MenuTypeButton {
+				return errno.ErrInvalidArgument.WithMessage("type must be 1 (directory), 2 (menu) or 3 (button)")
+			}
+			return nil
+		},
+		"Title": func(value any) error {
+			if title := value.(string); title == "" || len(title) > 128 {
+				return errno.ErrInvalidArgument.WithMessage("title must be between 1 and 128 characters")
+			}
+			return nil
+		},
+		"AuthMark": func(value any) error {
+			if !authMarkRegex.MatchString(value.(string)) {
+				return errno.ErrInvalidArgument.WithMessage("authMark must match %s", authMarkRegex.String())
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateCreateMenuRequest 校验 CreateMenuRequest 结构体的有效性.
+func (v *Validator) ValidateCreateMenuRequest(ctx context.Context, rq *v1.CreateMenuRequest) error {
+	if err := validateMenuAdmin(ctx); err != nil {
+		return err
+	}
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateMenuRules()); err != nil {
+		return err
+	}
+
+	// 按钮通过权限标识控制是否显示，目录和菜单需要生成路由
+	if rq.GetType() == known.MenuTypeButton {
+		if rq.GetAuthMark() == "" {
+			return errno.ErrInvalidArgument.WithMessage("authMark cannot be empty for a button")
+		}
+		return nil
+	}
+	if rq.GetPath() == "" {
+		return errno.ErrInvalidArgument.WithMessage("path cannot be empty for a directory or menu")
+	}
+	return nil
+}
+
+// ValidateUpdateMenuRequest 校验 UpdateMenuRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateMenuRequest(ctx context.Context, rq *v1.UpdateMenuRequest) error {
+	if err := validateMenuAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMenuRules())
+}
+
+// ValidateDeleteMenuRequest 校验 DeleteMenuRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteMenuRequest(ctx context.Context, rq *v1.DeleteMenuRequest) error {
+	return validateMenuAdmin(ctx)
+}
+
+// ValidateGetMenuRequest 校验 GetMenuRequest 结构体的有效性.
+func (v *Validator) ValidateGetMenuRequest(ctx context.Context, rq *v1.GetMenuRequest) error {
+	return validateMenuAdmin(ctx)
+}
+
+// ValidateListMenuRequest 校验 ListMenuRequest 结构体的有效性.
+func (v *Validator) ValidateListMenuRequest(ctx context.Context, rq *v1.ListMenuRequest) error {
+	return validateMenuAdmin(ctx)
+}
+
+// ValidateListMyMenuRequest 校验 ListMyMenuRequest 结构体的有效性.
+func (v *Validator) ValidateListMyMenuRequest(ctx context.Context, rq *v1.ListMyMenuRequest) error {
+	// 所有用户都可以查看自己的菜单
+	return nil
+}
+
+// ValidateListRoleMenuRequest 校验 ListRoleMenuRequest 结构体的有效性.
+func (v *Validator) ValidateListRoleMenuRequest(ctx context.Context, rq *v1.ListRoleMenuRequest) error {
+	return validateMenuAdmin(ctx)
+}
+
+// ValidateUpdateRoleMenuRequest 校验 UpdateRoleMenuRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateRoleMenuRequest(ctx context.Context, rq *v1.UpdateRoleMenuRequest) error {
+	// menuIDs 可以为空，表示解除角色的全部菜单
+	return validateMenuAdmin(ctx)
+}
+
+// validateMenuAdmin 只允许管理员管理菜单及菜单与角色的绑定.
+func validateMenuAdmin(ctx context.Context) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can manage the menus")
+	}
+	return nil
+}
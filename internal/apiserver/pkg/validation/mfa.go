@@ -0,0 +1,47 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateMFARules() genericvalidation.Rules {
+	// 非空字段的通用校验函数
+	notEmpty := func(field string) genericvalidation.ValidatorFunc {
+		return func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("%s cannot be empty", field)
+			}
+			return nil
+		}
+	}
+
+	return genericvalidation.Rules{
+		"MfaToken": notEmpty("mfaToken"),
+		"Code":     notEmpty("code"),
+	}
+}
+
+// ValidateVerifyMFARequest 校验 VerifyMFARequest 结构体的有效性.
+func (v *Validator) ValidateVerifyMFARequest(ctx context.Context, rq *v1.VerifyMFARequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMFARules())
+}
+
+// ValidateEnrollMFARequest 校验 EnrollMFARequest 结构体的有效性.
+func (v *Validator) ValidateEnrollMFARequest(ctx context.Context, rq *v1.EnrollMFARequest) error {
+	return nil
+}
+
+// ValidateConfirmMFARequest 校验 ConfirmMFARequest 结构体的有效性.
+func (v *Validator) ValidateConfirmMFARequest(ctx context.Context, rq *v1.ConfirmMFARequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMFARules())
+}
+
+// ValidateDisableMFARequest 校验 DisableMFARequest 结构体的有效性.
+func (v *Validator) ValidateDisableMFARequest(ctx context.Context, rq *v1.DisableMFARequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateMFARules())
+}
@@ -0,0 +1,43 @@
+package validation
+
+import (
+	"context"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateOIDCRules() genericvalidation.Rules {
+	// 非空字段的通用校验函数
+	notEmpty := func(field string) genericvalidation.ValidatorFunc {
+		return func(value any) error {
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("%s cannot be empty", field)
+			}
+			return nil
+		}
+	}
+
+	return genericvalidation.Rules{
+		"Provider": notEmpty("provider"),
+		"Code":     notEmpty("code"),
+		"State":    notEmpty("state"),
+	}
+}
+
+// ValidateOIDCLoginRequest 校验 OIDCLoginRequest 结构体的有效性.
+func (v *Validator) ValidateOIDCLoginRequest(ctx context.Context, rq *v1.OIDCLoginRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateOIDCRules())
+}
+
+// ValidateOIDCCallbackRequest 校验 OIDCCallbackRequest 结构体的有效性.
+func (v *Validator) ValidateOIDCCallbackRequest(ctx context.Context, rq *v1.OIDCCallbackRequest) error {
+	return genericvalidation.ValidateAllFields(rq, v.ValidateOIDCRules())
+}
+
+// ValidateListOIDCProviderRequest 校验 ListOIDCProviderRequest 结构体的有效性.
+func (v *Validator) ValidateListOIDCProviderRequest(ctx context.Context, rq *v1.ListOIDCProviderRequest) error {
+	return nil
+}
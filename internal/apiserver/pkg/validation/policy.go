@@ -0,0 +1,156 @@
+package validation
+
+import (
+	"context"
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+var (
+	// policyParamRegex 策略对象中的路由参数，例如 :userID
+	policyParamRegex = regexp.MustCompile(`^:[A-Za-z_][A-Za-z0-9_]*$`)
+	// policySegmentRegex 策略对象中的普通路径段，可以为空以匹配以 / 结尾的路径
+	policySegmentRegex = regexp.MustCompile(`^[A-Za-z0-9._~-]*$`)
+)
+
+// policyActions 策略中允许使用的操作，即请求的 HTTP 方法
+var policyActions = []string{
+	http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+	http.MethodPatch, http.MethodDelete, http.MethodOptions,
+}
+
+// ValidatePolicyRules 返回策略相关字段的校验规则.
+func (v *Validator) ValidatePolicyRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"Subject": func(value any) error {
+			subject := value.(string)
+			if subject == "" || len(subject) > 253 || strings.ContainsFunc(subject, unicode.IsSpace) {
+				return errno.ErrInvalidArgument.WithMessage("subject must be a userID or a role name")
+			}
+			return nil
+		},
+		"Object": func(value any) error {
+			return ValidatePolicyObject(value.(string))
+		},
+		"Action": func(value any) error {
+			if !slices.Contains(policyActions, value.(string)) {
+				return errno.ErrInvalidArgument.WithMessage("action must be one of %s", strings.Join(policyActions, ", "))
+			}
+			return nil
+		},
+		"Effect": func(value any) error {
+			if effect := value.(string); effect != known.PolicyEffectAllow && effect != known.PolicyEffectDeny {
+				return errno.ErrInvalidArgument.WithMessage("effect must be %s or %s", known.PolicyEffectAllow, known.PolicyEffectDeny)
+			}
+			return nil
+		},
+	}
+}
+
+// ValidateListPolicyRequest 校验 ListPolicyRequest 结构体的有效性.
+func (v *Validator) ValidateListPolicyRequest(ctx context.Context, rq *v1.ListPolicyRequest) error {
+	if err := validatePolicyAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Offset", "Limit")
+}
+
+// ValidateCreatePolicyRequest 校验 CreatePolicyRequest 结构体的有效性.
+func (v *Validator) ValidateCreatePolicyRequest(ctx context.Context, rq *v1.CreatePolicyRequest) error {
+	if err := validatePolicyAdmin(ctx); err != nil {
+		return err
+	}
+	return v.validatePolicy(&v1.Policy{Subject: rq.GetSubject(), Object: rq.GetObject(), Action: rq.GetAction(), Effect: rq.GetEffect()})
+}
+
+// ValidateUpdatePolicyRequest 校验 UpdatePolicyRequest 结构体的有效性.
+func (v *Validator) ValidateUpdatePolicyRequest(ctx context.Context, rq *v1.UpdatePolicyRequest) error {
+	if err := validatePolicyAdmin(ctx); err != nil {
+		return err
+	}
+	if rq.GetOldPolicy() == nil || rq.GetNewPolicy() == nil {
+		return errno.ErrInvalidArgument.WithMessage("oldPolicy and newPolicy cannot be empty")
+	}
+	// 旧策略只需要能够定位到已有的策略，因此不校验
+	return v.validatePolicy(rq.GetNewPolicy())
+}
+
+// ValidateDeletePolicyRequest 校验 DeletePolicyRequest 结构体的有效性.
+func (v *Validator) ValidateDeletePolicyRequest(ctx context.Context, rq *v1.DeletePolicyRequest) error {
+	return validatePolicyAdmin(ctx)
+}
+
+// ValidateReplaceSubjectPolicyRequest 校验 ReplaceSubjectPolicyRequest 结构体的有效性.
+func (v *Validator) ValidateReplaceSubjectPolicyRequest(ctx context.Context, rq *v1.ReplaceSubjectPolicyRequest) error {
+	if err := validatePolicyAdmin(ctx); err != nil {
+		return err
+	}
+	for _, policy := range rq.GetPolicies() {
+		// 策略的主体可以省略，省略时使用路径中的主体
+		if policy.GetSubject() != "" && policy.GetSubject() != rq.GetSubject() {
+			return errno.ErrInvalidArgument.WithMessage("the subject of policy %s %s must be %s", policy.GetAction(), policy.GetObject(), rq.GetSubject())
+		}
+		if err := v.validatePolicy(&v1.Policy{Subject: rq.GetSubject(), Object: policy.GetObject(), Action: policy.GetAction(), Effect: policy.GetEffect()}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePolicy 校验策略的各个字段.
+func (v *Validator) validatePolicy(policy *v1.Policy) error {
+	if err := genericvalidation.ValidateAllFields(policy, v.ValidatePolicyRules()); err != nil {
+		return err
+	}
+	// 拒绝管理员的策略可能导致管理员无法再修改策略
+	if policy.GetEffect() == known.PolicyEffectDeny && (policy.GetSubject() == known.AdminUserID || policy.GetSubject() == known.RoleAdmin) {
+		return errno.ErrInvalidArgument.WithMessage("the administrator cannot be denied")
+	}
+	return nil
+}
+
+// ValidatePolicyObject 校验策略的对象. 对象是以 / 开头的路由模板，由 keyMatch2 匹配：
+// :name 段匹配任意一段路径，其中 :owner 段为资源所有者的 userID；最后一段可以是 *，用于匹配具有该前缀的全部路径
+func ValidatePolicyObject(object string) error {
+	if !strings.HasPrefix(object, "/") {
+		return errno.ErrInvalidArgument.WithMessage("object must be a path starting with /")
+	}
+	segments := strings.Split(object, "/")[1:]
+	for i, segment := range segments {
+		switch {
+		case segment == "*":
+			if i != len(segments)-1 {
+				return errno.ErrInvalidArgument.WithMessage("object can only end with /*")
+			}
+		case strings.HasPrefix(segment, ":"):
+			if !policyParamRegex.MatchString(segment) {
+				return errno.ErrInvalidArgument.WithMessage("invalid parameter %s in object", segment)
+			}
+		// 授权时请求路径中的 . 和 .. 已被解析，包含它们的策略永远不会匹配
+		case segment == "." || segment == "..":
+			return errno.ErrInvalidArgument.WithMessage("object cannot contain . or .. segments")
+		// keyMatch2 将对象转换为正则表达式，因此不允许其他字符
+		case !policySegmentRegex.MatchString(segment):
+			return errno.ErrInvalidArgument.WithMessage("invalid segment %q in object", segment)
+		}
+	}
+	return nil
+}
+
+// validatePolicyAdmin 只允许管理员管理策略.
+func validatePolicyAdmin(ctx context.Context) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can manage the policies")
+	}
+	return nil
+}
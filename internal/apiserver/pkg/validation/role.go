@@ -0,0 +1,147 @@
+package validation
+
+import (
+	"context"
+	"regexp"
+
+	genericvalidation "github.com/moweilong/milady/pkg/validation"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// roleNameRegex 角色名称必须以 role:: 开头，与用户 ID 区分开
+var roleNameRegex = regexp.MustCompile(`^role::[a-z0-9][a-z0-9_.-]{0,63}$`)
+
+// ValidateRoleRules 返回角色相关字段的校验规则.
+func (v *Validator) ValidateRoleRules() genericvalidation.Rules {
+	return genericvalidation.Rules{
+		"Name": func(value any) error {
+			if !roleNameRegex.MatchString(value.(string)) {
+				return errno.ErrInvalidArgument.WithMessage("role name must match %s", roleNameRegex.String())
+			}
+			return nil
+		},
+		"DisplayName": func(value any) error {
+			if len(value.(string)) > 253 {
+				return errno.ErrInvalidArgument.WithMessage("displayName must be at most 253 characters")
+			}
+			return nil
+		},
+		"Description": func(value any) error {
+			if len(value.(string)) > 255 {
+				return errno.ErrInvalidArgument.WithMessage("description must be at most 255 characters")
+			}
+			return nil
+		},
+		"Status": func(value any) error {
+			if status := value.(int32); status != 0 && status != 1 {
+				return errno.ErrInvalidArgument.WithMessage("status must be 0 or 1")
+			}
+			return nil
+		},
+		"DataScope": func(value any) error {
+			if dataScope := value.(int32); dataScope < known.DataScopeAll || dataScope > known.DataScopeSelf {
+				return errno.ErrInvalidArgument.WithMessage("dataScope must be between %d and %d", known.DataScopeAll, known.DataScopeSelf)
+			}
+			return nil
+		},
+	}
+}
+
+// validateDataScope 校验自定义数据权限的部门，只有自定义数据权限可以并且必须指定部门.
+func validateDataScope(dataScope *int32, departmentIDs []string) error {
+	if dataScope == nil || *dataScope != known.DataScopeCustom {
+		if len(departmentIDs) > 0 {
+			return errno.ErrInvalidArgument.WithMessage("departmentIDs can only be set with the custom data scope")
+		}
+		return nil
+	}
+	if len(departmentIDs) == 0 {
+		return errno.ErrInvalidArgument.WithMessage("departmentIDs cannot be empty for the custom data scope")
+	}
+	return nil
+}
+
+// ValidateCreateRoleRequest 校验 CreateRoleRequest 结构体的有效性.
+func (v *Validator) ValidateCreateRoleRequest(ctx context.Context, rq *v1.CreateRoleRequest) error {
+	if err := validateRoleAdmin(ctx); err != nil {
+		return err
+	}
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateRoleRules()); err != nil {
+		return err
+	}
+	return validateDataScope(rq.DataScope, rq.GetDepartmentIDs())
+}
+
+// ValidateUpdateRoleRequest 校验 UpdateRoleRequest 结构体的有效性.
+func (v *Validator) ValidateUpdateRoleRequest(ctx context.Context, rq *v1.UpdateRoleRequest) error {
+	if err := validateRoleAdmin(ctx); err != nil {
+		return err
+	}
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateRoleRules()); err != nil {
+		return err
+	}
+	return validateDataScope(rq.DataScope, rq.GetDepartmentIDs())
+}
+
+// ValidateDeleteRoleRequest 校验 DeleteRoleRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteRoleRequest(ctx context.Context, rq *v1.DeleteRoleRequest) error {
+	return validateRoleAdmin(ctx)
+}
+
+// ValidateGetRoleRequest 校验 GetRoleRequest 结构体的有效性.
+func (v *Validator) ValidateGetRoleRequest(ctx context.Context, rq *v1.GetRoleRequest) error {
+	return validateRoleAdmin(ctx)
+}
+
+// ValidateListRoleRequest 校验 ListRoleRequest 结构体的有效性.
+func (v *Validator) ValidateListRoleRequest(ctx context.Context, rq *v1.ListRoleRequest) error {
+	if err := validateRoleAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Offset", "Limit")
+}
+
+// ValidateListRoleMemberRequest 校验 ListRoleMemberRequest 结构体的有效性.
+func (v *Validator) ValidateListRoleMemberRequest(ctx context.Context, rq *v1.ListRoleMemberRequest) error {
+	if err := validateRoleAdmin(ctx); err != nil {
+		return err
+	}
+	return genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "Offset", "Limit")
+}
+
+// ValidateListUserRoleRequest 校验 ListUserRoleRequest 结构体的有效性.
+func (v *Validator) ValidateListUserRoleRequest(ctx context.Context, rq *v1.ListUserRoleRequest) error {
+	// 用户可以查看自己的角色，只有管理员可以查看其他用户的角色
+	if rq.GetUserID() != contextx.UserID(ctx) && !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can read the roles of other users")
+	}
+	return nil
+}
+
+// ValidateAssignUserRoleRequest 校验 AssignUserRoleRequest 结构体的有效性.
+func (v *Validator) ValidateAssignUserRoleRequest(ctx context.Context, rq *v1.AssignUserRoleRequest) error {
+	if err := validateRoleAdmin(ctx); err != nil {
+		return err
+	}
+	if len(rq.GetRoles()) == 0 {
+		return errno.ErrInvalidArgument.WithMessage("roles cannot be empty")
+	}
+	return nil
+}
+
+// ValidateRemoveUserRoleRequest 校验 RemoveUserRoleRequest 结构体的有效性.
+func (v *Validator) ValidateRemoveUserRoleRequest(ctx context.Context, rq *v1.RemoveUserRoleRequest) error {
+	return validateRoleAdmin(ctx)
+}
+
+// validateRoleAdmin 只允许管理员管理角色及角色的分配.
+func validateRoleAdmin(ctx context.Context) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can manage the roles")
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package validation
+
+import (
+	"context"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ValidateListSessionRequest 校验 ListSessionRequest 结构体的有效性.
+func (v *Validator) ValidateListSessionRequest(ctx context.Context, rq *v1.ListSessionRequest) error {
+	// 数据权限范围外的用户的会话由 store 层过滤
+	return nil
+}
+
+// ValidateDeleteSessionRequest 校验 DeleteSessionRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteSessionRequest(ctx context.Context, rq *v1.DeleteSessionRequest) error {
+	if rq.GetSessionID() == "" {
+		return errno.ErrInvalidArgument.WithMessage("sessionID cannot be empty")
+	}
+	return validateSessionOwner(ctx, rq.GetUserID())
+}
+
+// ValidateDeleteAllSessionRequest 校验 DeleteAllSessionRequest 结构体的有效性.
+func (v *Validator) ValidateDeleteAllSessionRequest(ctx context.Context, rq *v1.DeleteAllSessionRequest) error {
+	return validateSessionOwner(ctx, rq.GetUserID())
+}
+
+// validateSessionOwner 只允许管理员管理其他用户的登录会话. userID 为空时表示当前用户.
+func validateSessionOwner(ctx context.Context, userID string) error {
+	if userID != "" && !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can manage the sessions of other users")
+	}
+	return nil
+}
@@ -11,10 +11,14 @@ import (
 )
 
 func (v *Validator) ValidateUserRules() genericvalidation.Rules {
-	// 通用的密码校验函数
+	// 通用的密码校验函数。这里只校验密码非空，新密码是否符合密码策略由 v.policy 校验，
+	// 这样不符合当前策略的旧密码仍然可以登录
 	validatePassword := func() genericvalidation.ValidatorFunc {
 		return func(value any) error {
-			return isValidPassword(value.(string))
+			if value.(string) == "" {
+				return errno.ErrInvalidArgument.WithMessage("password cannot be empty")
+			}
+			return nil
 		}
 	}
 
@@ -64,17 +68,24 @@ func (v *Validator) ValidateLoginRequest(ctx context.Context, rq *v1.LoginReques
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
 
-// ValidateChangePasswordRequest 校验 ChangePasswordRequest 结构体的有效性.
-// func (v *Validator) ValidateChangePasswordRequest(ctx context.Context, rq *v1.ChangePasswordRequest) error {
-// 	if rq.GetUserID() != contextx.UserID(ctx) {
-// 		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
-// 	}
-// 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
-// }
+// ValidateUpdatePasswordRequest 校验 UpdatePasswordRequest 结构体的有效性.
+func (v *Validator) ValidateUpdatePasswordRequest(ctx context.Context, rq *v1.UpdatePasswordRequest) error {
+	if rq.GetUserID() != contextx.UserID(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
+	}
+	if err := genericvalidation.ValidateSelectedFields(rq, v.ValidateUserRules(), "UserID", "OldPassword", "NewPassword"); err != nil {
+		return err
+	}
+	// 是否与最近使用过的密码重复在 biz 层校验
+	return v.policy.Validate(ctx, rq.GetNewPassword())
+}
 
 // ValidateCreateUserRequest 校验 CreateUserRequest 结构体的有效性.
 func (v *Validator) ValidateCreateUserRequest(ctx context.Context, rq *v1.CreateUserRequest) error {
-	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateUserRules()); err != nil {
+		return err
+	}
+	return v.policy.Validate(ctx, rq.GetPassword())
 }
 
 // ValidateUpdateUserRequest 校验更新用户请求.
@@ -87,14 +98,16 @@ func (v *Validator) ValidateUpdateUserRequest(ctx context.Context, rq *v1.Update
 
 // ValidateDeleteUserRequest 校验 DeleteUserRequest 结构体的有效性.
 func (v *Validator) ValidateDeleteUserRequest(ctx context.Context, rq *v1.DeleteUserRequest) error {
+	// 数据权限只决定可以查看哪些用户，只有用户本人和管理员可以删除用户
+	if rq.GetUserID() != contextx.UserID(ctx) && !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can delete other users")
+	}
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
 
 // ValidateGetUserRequest 校验 GetUserRequest 结构体的有效性.
 func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *v1.GetUserRequest) error {
-	if rq.GetUserID() != contextx.UserID(ctx) {
-		return errno.ErrPermissionDenied.WithMessage("The logged-in user `%s` does not match request user `%s`", contextx.UserID(ctx), rq.GetUserID())
-	}
+	// 数据权限范围外的用户由 store 层过滤
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
 
@@ -102,3 +115,35 @@ func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *v1.GetUserRe
 func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
 	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
 }
+
+// ValidateImpersonateUserRequest 校验 ImpersonateUserRequest 结构体的有效性.
+func (v *Validator) ValidateImpersonateUserRequest(ctx context.Context, rq *v1.ImpersonateUserRequest) error {
+	// 模拟登录的令牌不能再次模拟登录，避免审计日志中的实际操作人被掩盖
+	if contextx.Impersonated(ctx) || !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can impersonate users")
+	}
+	if rq.GetUserID() == contextx.UserID(ctx) {
+		return errno.ErrInvalidArgument.WithMessage("You can not impersonate yourself")
+	}
+	if err := genericvalidation.ValidateAllFields(rq, v.ValidateUserRules()); err != nil {
+		return err
+	}
+
+	// 管理员不能被模拟登录，否则模拟令牌会获得管理员的全部权限
+	roles, err := v.roles.GetImplicitRolesForUser(rq.GetUserID())
+	if err != nil {
+		return errno.ErrInternal.WithMessage("%s", err.Error())
+	}
+	if contextx.IsAdmin(contextx.WithRoles(contextx.WithUserID(ctx, rq.GetUserID()), roles)) {
+		return errno.ErrPermissionDenied.WithMessage("The administrator can not be impersonated")
+	}
+	return nil
+}
+
+// ValidateUnlockUserRequest 校验 UnlockUserRequest 结构体的有效性.
+func (v *Validator) ValidateUnlockUserRequest(ctx context.Context, rq *v1.UnlockUserRequest) error {
+	if !contextx.IsAdmin(ctx) {
+		return errno.ErrPermissionDenied.WithMessage("Only the administrator can unlock users")
+	}
+	return genericvalidation.ValidateAllFields(rq, v.ValidateUserRules())
+}
@@ -7,7 +7,8 @@ import (
 
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
-	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	mw "github.com/moweilong/art-design-pro-go/internal/pkg/middleware"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
 )
 
 // Validator is a struct that implements custom validation logic.
@@ -16,14 +17,16 @@ type Validator struct {
 	// This is just an example. If validation requires other dependencies
 	// like clients, services, resources, etc., they can all be injected here.
 	store store.IStore
+	// policy checks the new passwords.
+	policy *pwdpolicy.Policy
+	// roles looks up the roles of the users other than the logged-in one.
+	roles mw.RoleRetriever
 }
 
 // Use globally precompiled regular expressions to avoid creating and compiling them repeatedly.
 var (
 	lengthRegex = regexp.MustCompile(`^.{3,20}$`)                                        // Length between 3 and 20 characters
 	validRegex  = regexp.MustCompile(`^[A-Za-z0-9_]+$`)                                  // Only letters, numbers, and underscores
-	letterRegex = regexp.MustCompile(`[A-Za-z]`)                                         // At least one letter
-	numberRegex = regexp.MustCompile(`\d`)                                               // At least one number
 	emailRegex  = regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`) // Email format
 	phoneRegex  = regexp.MustCompile(`^1[3-9]\d{9}$`)                                    // Chinese phone number
 )
@@ -32,8 +35,8 @@ var (
 var ProviderSet = wire.NewSet(New, wire.Bind(new(any), new(*Validator)))
 
 // New creates a new instance of Validator.
-func New(store store.IStore) *Validator {
-	return &Validator{store: store}
+func New(store store.IStore, policy *pwdpolicy.Policy, roles mw.RoleRetriever) *Validator {
+	return &Validator{store: store, policy: policy, roles: roles}
 }
 
 // isValidUsername validates if a username is valid.
@@ -65,25 +68,6 @@ func isValidNickname(nickname string) bool {
 	return true
 }
 
-// isValidPassword checks whether a password meets complexity requirements.
-func isValidPassword(password string) error {
-	switch {
-	// Check if the new password is empty
-	case password == "":
-		return errno.ErrInvalidArgument.WithMessage("password cannot be empty")
-	// Check the length requirement of the new password
-	case len(password) < 6:
-		return errno.ErrInvalidArgument.WithMessage("password must be at least 6 characters long")
-	// Use a regular expression to check if it contains at least one letter
-	case !letterRegex.MatchString(password):
-		return errno.ErrInvalidArgument.WithMessage("password must contain at least one letter")
-	// Use a regular expression to check if it contains at least one number
-	case !numberRegex.MatchString(password):
-		return errno.ErrInvalidArgument.WithMessage("password must contain at least one number")
-	}
-	return nil
-}
-
 // isValidEmail checks whether an email is valid.
 func isValidEmail(email string) error {
 	// Check if the email is empty
@@ -113,7 +97,3 @@ func isValidPhone(phone string) error {
 
 	return nil
 }
-
-func IsAdminUser(userID string) bool {
-	return userID == known.AdminUserID
-}
@@ -0,0 +1,245 @@
+package apiserver
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// deletePolicyPath returns the path which deletes policy.
+func deletePolicyPath(policy *v1.Policy) string {
+	query := url.Values{"subject": {policy.Subject}, "object": {policy.Object}, "action": {policy.Action}, "effect": {policy.Effect}}
+	return "/v1/policies?" + query.Encode()
+}
+
+func TestPolicyManagement(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	user := createUser(t, engine, "casbinuser")
+	login := user.login(t, engine)
+	forwarded := func(method, uri string) int {
+		t.Helper()
+		return forwardAuth(t, engine, login.AccessToken, map[string]string{"X-Forwarded-Method": method, "X-Forwarded-Uri": uri}).Code
+	}
+
+	deny := &v1.CreatePolicyRequest{Subject: user.UserID, Object: "/v1/orders/*", Action: http.MethodDelete, Effect: known.PolicyEffectDeny}
+	if code, _ := do(t, engine, "/v1/policies", login.AccessToken, deny, nil); code != http.StatusForbidden {
+		t.Fatalf("create policy as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	invalid := []*v1.CreatePolicyRequest{
+		{Subject: user.UserID, Object: "/v1/orders/*", Action: http.MethodDelete, Effect: "block"},
+		{Subject: user.UserID, Object: "v1/orders", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/*/items", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/orders*", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/orders/:order-id", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/orders/(1|2)", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/public/../orders", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: user.UserID, Object: "/v1/orders/*", Action: "delete", Effect: known.PolicyEffectDeny},
+		{Subject: "", Object: "/v1/orders/*", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: known.RoleAdmin, Object: "/v1/policies", Action: http.MethodPost, Effect: known.PolicyEffectDeny},
+	}
+	for _, rq := range invalid {
+		if code, _ := do(t, engine, "/v1/policies", admin.AccessToken, rq, nil); code != http.StatusBadRequest {
+			t.Errorf("create policy %s %s %s %s: got status %d, want %d", rq.Subject, rq.Action, rq.Object, rq.Effect, code, http.StatusBadRequest)
+		}
+	}
+
+	// A deny policy takes effect immediately.
+	if code, reason := do(t, engine, "/v1/policies", admin.AccessToken, deny, nil); code != http.StatusOK {
+		t.Fatalf("create policy: got status %d (%s)", code, reason)
+	}
+	if code, _ := do(t, engine, "/v1/policies", admin.AccessToken, deny, nil); code != http.StatusConflict {
+		t.Fatalf("create duplicated policy: got status %d, want %d", code, http.StatusConflict)
+	}
+	if code := forwarded(http.MethodDelete, "/v1/orders/1"); code != http.StatusForbidden {
+		t.Fatalf("denied request: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if code := forwarded(http.MethodGet, "/v1/orders/1"); code != http.StatusOK {
+		t.Fatalf("other request: got status %d, want %d", code, http.StatusOK)
+	}
+
+	var list v1.ListPolicyResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/policies?subject="+user.UserID+"&offset=0&limit=10", admin.AccessToken, nil, &list); code != http.StatusOK {
+		t.Fatalf("list policies: got status %d", code)
+	}
+	if list.Total != 1 || list.Policies[0].Object != deny.Object || list.Policies[0].Effect != known.PolicyEffectDeny {
+		t.Fatalf("list policies: got %v", list.Policies)
+	}
+
+	// Updating the policy denies another action.
+	oldPolicy := &v1.Policy{Subject: deny.Subject, Object: deny.Object, Action: deny.Action, Effect: deny.Effect}
+	newPolicy := &v1.Policy{Subject: deny.Subject, Object: deny.Object, Action: http.MethodGet, Effect: deny.Effect}
+	update := &v1.UpdatePolicyRequest{OldPolicy: oldPolicy, NewPolicy: newPolicy}
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/policies", admin.AccessToken, update, nil); code != http.StatusOK {
+		t.Fatalf("update policy: got status %d (%s)", code, reason)
+	}
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/policies", admin.AccessToken, update, nil); code != http.StatusConflict {
+		t.Fatalf("update to an existing policy: got status %d, want %d", code, http.StatusConflict)
+	}
+	missing := &v1.UpdatePolicyRequest{OldPolicy: oldPolicy, NewPolicy: &v1.Policy{Subject: deny.Subject, Object: deny.Object, Action: http.MethodPost, Effect: deny.Effect}}
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/policies", admin.AccessToken, missing, nil); code != http.StatusNotFound {
+		t.Fatalf("update missing policy: got status %d, want %d", code, http.StatusNotFound)
+	}
+	if code := forwarded(http.MethodDelete, "/v1/orders/1"); code != http.StatusOK {
+		t.Fatalf("request allowed again: got status %d, want %d", code, http.StatusOK)
+	}
+	if code := forwarded(http.MethodGet, "/v1/orders/1"); code != http.StatusForbidden {
+		t.Fatalf("request denied by the updated policy: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	// Deleting the policy allows the request again.
+	if code, _ := doRequest(t, engine, http.MethodDelete, deletePolicyPath(newPolicy), admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete policy: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, deletePolicyPath(newPolicy), admin.AccessToken, nil, nil); code != http.StatusNotFound {
+		t.Fatalf("delete missing policy: got status %d, want %d", code, http.StatusNotFound)
+	}
+	if code := forwarded(http.MethodGet, "/v1/orders/1"); code != http.StatusOK {
+		t.Fatalf("request after deleting the policy: got status %d, want %d", code, http.StatusOK)
+	}
+
+	// Replace all policies of the subject.
+	replace := &v1.ReplaceSubjectPolicyRequest{Policies: []*v1.Policy{
+		{Object: "/v1/orders/*", Action: http.MethodPost, Effect: known.PolicyEffectDeny},
+		{Object: "/v1/orders/*", Action: http.MethodPut, Effect: known.PolicyEffectDeny},
+	}}
+	replacePath := "/v1/policies/" + user.UserID
+	if code, reason := doRequest(t, engine, http.MethodPut, replacePath, admin.AccessToken, replace, nil); code != http.StatusOK {
+		t.Fatalf("replace policies: got status %d (%s)", code, reason)
+	}
+	replace.Policies = replace.Policies[1:]
+	if code, _ := doRequest(t, engine, http.MethodPut, replacePath, admin.AccessToken, replace, nil); code != http.StatusOK {
+		t.Fatalf("replace policies again: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/policies?subject="+user.UserID+"&offset=0&limit=10", admin.AccessToken, nil, &list); code != http.StatusOK || list.Total != 1 || list.Policies[0].Action != http.MethodPut {
+		t.Fatalf("policies after replacement: got status %d, policies %v", code, list.Policies)
+	}
+	if code := forwarded(http.MethodPost, "/v1/orders/1"); code != http.StatusOK {
+		t.Fatalf("request of a replaced policy: got status %d, want %d", code, http.StatusOK)
+	}
+	replace.Policies = []*v1.Policy{{Subject: "user-other", Object: "/v1/orders/*", Action: http.MethodGet, Effect: known.PolicyEffectDeny}}
+	if code, _ := doRequest(t, engine, http.MethodPut, replacePath, admin.AccessToken, replace, nil); code != http.StatusBadRequest {
+		t.Fatalf("replace with the policy of another subject: got status %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, _ := doRequest(t, engine, http.MethodPut, replacePath, admin.AccessToken, &v1.ReplaceSubjectPolicyRequest{}, nil); code != http.StatusOK {
+		t.Fatalf("remove all policies: got status %d", code)
+	}
+	var removed v1.ListPolicyResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/policies?subject="+user.UserID+"&offset=0&limit=10", admin.AccessToken, nil, &removed); code != http.StatusOK || removed.Total != 0 {
+		t.Fatalf("policies after removing all: got status %d, total %d", code, removed.Total)
+	}
+}
+
+func TestPolicyMatrix(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	role := &v1.CreateRoleRequest{Name: "role::matrix", DisplayName: "Matrix"}
+	if code, reason := do(t, engine, "/v1/roles", admin.AccessToken, role, nil); code != http.StatusOK {
+		t.Fatalf("create role: got status %d (%s)", code, reason)
+	}
+	type member struct {
+		userID string
+		token  string
+	}
+	newMember := func(name string) member {
+		t.Helper()
+
+		user := createUser(t, engine, name)
+		assignRoles(t, engine, admin, user.UserID, role.Name)
+		return member{userID: user.UserID, token: user.login(t, engine).AccessToken}
+	}
+	alice, bob := newMember("matrixa"), newMember("matrixb")
+
+	policies := []*v1.CreatePolicyRequest{
+		// The members may only modify themselves.
+		{Subject: role.Name, Object: "/v1/users/:owner", Action: http.MethodPut, Effect: known.PolicyEffectDeny},
+		// One policy covers the orders of any ID.
+		{Subject: role.Name, Object: "/v1/orders/:orderID", Action: http.MethodDelete, Effect: known.PolicyEffectDeny},
+		{Subject: alice.userID, Object: "/v1/reports/*", Action: http.MethodGet, Effect: known.PolicyEffectDeny},
+		{Subject: alice.userID, Object: "/v1/users/:userID/roles", Action: http.MethodGet, Effect: known.PolicyEffectDeny},
+	}
+	for _, rq := range policies {
+		if code, reason := do(t, engine, "/v1/policies", admin.AccessToken, rq, nil); code != http.StatusOK {
+			t.Fatalf("create policy %s %s %s: got status %d (%s)", rq.Subject, rq.Action, rq.Object, code, reason)
+		}
+	}
+	tests := []struct {
+		name   string
+		member member
+		method string
+		uri    string
+		want   int
+	}{
+		{"owner modifies itself", alice, http.MethodPut, "/v1/users/" + alice.userID, http.StatusOK},
+		{"member modifies another user", alice, http.MethodPut, "/v1/users/" + bob.userID, http.StatusForbidden},
+		{"another member modifies the user", bob, http.MethodPut, "/v1/users/" + alice.userID, http.StatusForbidden},
+		{"other owner modifies itself", bob, http.MethodPut, "/v1/users/" + bob.userID, http.StatusOK},
+		{"other action on another user", alice, http.MethodGet, "/v1/users/" + bob.userID, http.StatusOK},
+		{"owner template does not match sub-paths", alice, http.MethodPut, "/v1/users/" + bob.userID + "/roles", http.StatusOK},
+		{"template matches any ID", bob, http.MethodDelete, "/v1/orders/1", http.StatusForbidden},
+		{"template matches another ID", alice, http.MethodDelete, "/v1/orders/order-ab12cd", http.StatusForbidden},
+		{"template ignores the query", alice, http.MethodDelete, "/v1/orders/1?force=true", http.StatusForbidden},
+		{"template matches resolved dot segments", alice, http.MethodDelete, "/v1/public/../orders/1", http.StatusForbidden},
+		{"template does not match sub-paths", alice, http.MethodDelete, "/v1/orders/1/items/2", http.StatusOK},
+		{"template does not match the collection", alice, http.MethodDelete, "/v1/orders", http.StatusOK},
+		{"wildcard matches nested paths", alice, http.MethodGet, "/v1/reports/2026/q1", http.StatusForbidden},
+		{"wildcard does not match the prefix", alice, http.MethodGet, "/v1/reports", http.StatusOK},
+		{"user policy does not apply to other members", bob, http.MethodGet, "/v1/reports/2026/q1", http.StatusOK},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers := map[string]string{"X-Forwarded-Method": tt.method, "X-Forwarded-Uri": tt.uri}
+			if code := forwardAuth(t, engine, tt.member.token, headers).Code; code != tt.want {
+				t.Errorf("%s %s: got status %d, want %d", tt.method, tt.uri, code, tt.want)
+			}
+		})
+	}
+
+	// The authorization middleware matches the route templates too.
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/users/"+alice.userID+"/roles", alice.token, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("request denied by a template: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/users/"+bob.userID+"/roles", bob.token, nil, nil); code != http.StatusOK {
+		t.Fatalf("request of another user: got status %d, want %d", code, http.StatusOK)
+	}
+}
+
+func TestPolicyWatcher(t *testing.T) {
+	engine, rds := newTestEngine(t)
+	// The other replica shares the database and Redis.
+	replica, _ := newTestEngine(t, func(c *Config) { c.RedisOptions.Addr = rds.Addr() })
+	admin := loginAdmin(t, engine)
+
+	user := createUser(t, engine, "watched")
+	login := user.login(t, replica)
+	waitFor := func(want int) {
+		t.Helper()
+
+		var code int
+		for deadline := time.Now().Add(5 * time.Second); time.Now().Before(deadline); time.Sleep(20 * time.Millisecond) {
+			code = forwardAuth(t, replica, login.AccessToken, map[string]string{"X-Forwarded-Method": "DELETE", "X-Forwarded-Uri": "/v1/orders/1"}).Code
+			if code == want {
+				return
+			}
+		}
+		t.Fatalf("request on the replica: got status %d, want %d", code, want)
+	}
+
+	deny := &v1.CreatePolicyRequest{Subject: user.UserID, Object: "/v1/orders/*", Action: http.MethodDelete, Effect: known.PolicyEffectDeny}
+	if code, _ := do(t, engine, "/v1/policies", admin.AccessToken, deny, nil); code != http.StatusOK {
+		t.Fatalf("create policy: got status %d", code)
+	}
+	waitFor(http.StatusForbidden)
+
+	if code, _ := doRequest(t, engine, http.MethodDelete, deletePolicyPath(&v1.Policy{Subject: deny.Subject, Object: deny.Object, Action: deny.Action, Effect: deny.Effect}), admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete policy: got status %d", code)
+	}
+	waitFor(http.StatusOK)
+}
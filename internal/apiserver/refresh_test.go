@@ -0,0 +1,151 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestRefreshRotation(t *testing.T) {
+	engine, rds := newTestEngine(t, func(c *Config) {
+		c.JWTOptions.Expired = 10 * time.Minute
+		c.JWTOptions.RefreshExpired = time.Hour
+		c.JWTOptions.MaxRefresh = time.Hour + 30*time.Minute
+	})
+
+	user := createUser(t, engine, "rotationuser")
+	claims := func(token string) *auth.Claims {
+		t.Helper()
+		c, err := auth.ParseUnverified(token)
+		if err != nil {
+			t.Fatalf("parse token: %v", err)
+		}
+		return c
+	}
+	lifetime := func(c *auth.Claims) time.Duration {
+		return c.ExpiresAt.Sub(c.IssuedAt.Time)
+	}
+
+	login := user.login(t, engine)
+	access, refresh := claims(login.AccessToken), claims(login.RefreshToken)
+	if lifetime(access) != 10*time.Minute || login.ExpiresAt != access.ExpiresAt.Unix() {
+		t.Fatalf("access token: got lifetime %s", lifetime(access))
+	}
+	if lifetime(refresh) != time.Hour || refresh.AuthTime == nil || !refresh.AuthTime.Equal(refresh.IssuedAt.Time) {
+		t.Fatalf("refresh token: got lifetime %s, auth time %v", lifetime(refresh), refresh.AuthTime)
+	}
+
+	// Rotated refresh tokens keep the login time, and never outlive max-refresh after it.
+	time.Sleep(time.Second)
+	var refreshed v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, &refreshed); code != http.StatusOK {
+		t.Fatalf("refresh: got status %d", code)
+	}
+	rotated := claims(refreshed.RefreshToken)
+	if !rotated.AuthTime.Equal(refresh.AuthTime.Time) || rotated.SessionID != refresh.SessionID {
+		t.Fatalf("rotated token: got auth time %v session %q, want %v %q", rotated.AuthTime, rotated.SessionID, refresh.AuthTime, refresh.SessionID)
+	}
+	if !rotated.ExpiresAt.After(refresh.ExpiresAt.Time) {
+		t.Fatalf("rotated token must extend the session, got expiry %v", rotated.ExpiresAt)
+	}
+	if end := refresh.AuthTime.Add(time.Hour + 30*time.Minute); rotated.ExpiresAt.After(end) {
+		t.Fatalf("rotated token expires at %v, after the end of the session at %v", rotated.ExpiresAt, end)
+	}
+
+	// Presenting the rotated token again after the grace window means it was stolen: the whole family is revoked.
+	other := loginFrom(t, engine, user.Username, user.Password, firefoxOnLinux)
+	rds.FastForward(time.Minute)
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "RefreshTokenReused" {
+		t.Fatalf("reuse rotated token: got status %d reason %q", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", refreshed.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("refresh token of the revoked family: got status %d reason %q", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", refreshed.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("access token of the revoked family: got status %d reason %q", code, reason)
+	}
+
+	// Other sessions of the user are not affected, and the revoked session is gone.
+	var sessions v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", other.AccessToken, nil, &sessions); code != http.StatusOK {
+		t.Fatalf("list sessions: got status %d", code)
+	}
+	for _, session := range sessions.Sessions {
+		if session.SessionID == refresh.SessionID {
+			t.Fatalf("revoked session %s is still listed", session.SessionID)
+		}
+	}
+	if sessions.Total != 1 {
+		t.Fatalf("list sessions: got %d sessions, want 1", sessions.Total)
+	}
+}
+
+func TestConcurrentRefresh(t *testing.T) {
+	engine, rds := newTestEngine(t)
+
+	user := createUser(t, engine, "concurrentuser")
+	login := user.login(t, engine)
+
+	// Two tabs refresh with the same token at once, both get a successor and the session is kept.
+	var first, second v1.LoginReply
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, &first); code != http.StatusOK {
+		t.Fatalf("first refresh: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, &second); code != http.StatusOK {
+		t.Fatalf("concurrent refresh: got status %d reason %q", code, reason)
+	}
+	for _, reply := range []*v1.LoginReply{&first, &second} {
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", reply.AccessToken, nil, nil); code != http.StatusOK {
+			t.Fatalf("access after concurrent refresh: got status %d reason %q", code, reason)
+		}
+	}
+
+	// The grace window is used up, a second replay within it revokes the session.
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "RefreshTokenReused" {
+		t.Fatalf("second replay within the grace window: got status %d reason %q", code, reason)
+	}
+	for _, reply := range []*v1.LoginReply{&first, &second} {
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", reply.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != v1.ErrorReason_SessionRevoked.String() {
+			t.Fatalf("access after the second replay: got status %d reason %q", code, reason)
+		}
+	}
+
+	// Past the grace window a rotated token revokes the session, even the first time it is replayed.
+	other := user.login(t, engine)
+	if code, _ := do(t, engine, "/v1/auth/refresh-token", other.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusOK {
+		t.Fatalf("refresh: got status %d", code)
+	}
+	rds.FastForward(11 * time.Second)
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", other.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "RefreshTokenReused" {
+		t.Fatalf("refresh after the grace window: got status %d reason %q", code, reason)
+	}
+}
+
+func TestRefreshMissingSession(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	user := createUser(t, engine, "missingsessionuser")
+	login := user.login(t, engine)
+	refresh, err := auth.ParseUnverified(login.RefreshToken)
+	if err != nil {
+		t.Fatalf("parse token: %v", err)
+	}
+
+	// A session whose row is gone is never created again by a refresh.
+	if err := store.S.DB(context.Background()).Exec("DELETE FROM user_session WHERE sessionId = ?", refresh.SessionID).Error; err != nil {
+		t.Fatalf("delete session: %v", err)
+	}
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", login.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != v1.ErrorReason_SessionRevoked.String() {
+		t.Fatalf("refresh without session: got status %d reason %q", code, reason)
+	}
+	var count int64
+	store.S.DB(context.Background()).Table("user_session").Where("sessionId = ?", refresh.SessionID).Count(&count)
+	if count != 0 {
+		t.Fatalf("refresh without session: the session was created again")
+	}
+}
@@ -0,0 +1,86 @@
+package apiserver
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestRegistration(t *testing.T) {
+	dir := t.TempDir()
+	engine, rds := newTestEngine(t, func(cfg *Config) {
+		cfg.RegistrationOptions.VerifyEmail = true
+		cfg.MailOptions.Driver = options.MailDriverFile
+		cfg.MailOptions.Dir = dir
+	})
+
+	user := newUserRequest("registereduser")
+	var created v1.CreateUserResponse
+	if code, _ := do(t, engine, "/v1/users", "", user, &created); code != http.StatusOK || !created.VerificationRequired {
+		t.Fatalf("create user: got status %d verificationRequired %v", code, created.VerificationRequired)
+	}
+	if tokens := mailedTokens(t, dir, user.Email); len(tokens) != 1 {
+		t.Fatalf("create user: got %d verification mails, want 1", len(tokens))
+	}
+
+	// Registered users can not log in before they verified their email.
+	login := &v1.LoginRequest{Username: user.Username, Password: user.Password}
+	if code, reason := do(t, engine, "/v1/auth/login", "", login, nil); code != http.StatusForbidden || reason != v1.ErrorReason_UserNotActivated.String() {
+		t.Fatalf("login before verification: got status %d reason %q", code, reason)
+	}
+
+	// Another mail can only be requested once the cooldown has passed, it replaces the previous link.
+	resend := &v1.ResendVerificationRequest{Username: user.Username}
+	if code, _ := do(t, engine, "/v1/auth/email/resend", "", resend, nil); code != http.StatusOK {
+		t.Fatalf("resend verification: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/email/resend", "", resend, nil); code != http.StatusTooManyRequests || reason != v1.ErrorReason_EmailVerificationRateLimited.String() {
+		t.Fatalf("resend verification within the cooldown: got status %d reason %q", code, reason)
+	}
+	tokens := mailedTokens(t, dir, user.Email)
+	if len(tokens) != 2 || tokens[0] == tokens[1] {
+		t.Fatalf("resend verification: got tokens %v", tokens)
+	}
+	if code, reason := do(t, engine, "/v1/auth/email/verify", "", &v1.VerifyEmailRequest{Token: tokens[0]}, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidEmailVerificationToken.String() {
+		t.Fatalf("verify with a replaced token: got status %d reason %q", code, reason)
+	}
+
+	verify := &v1.VerifyEmailRequest{Token: tokens[1]}
+	if code, _ := do(t, engine, "/v1/auth/email/verify", "", verify, nil); code != http.StatusOK {
+		t.Fatalf("verify email: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/auth/email/verify", "", verify, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidEmailVerificationToken.String() {
+		t.Fatalf("reuse a verification token: got status %d reason %q", code, reason)
+	}
+	loginFrom(t, engine, user.Username, user.Password, chromeOnMac)
+
+	// Active and unknown users get the same reply, but no mail.
+	rds.FastForward(time.Minute)
+	for _, username := range []string{user.Username, "nosuchuser"} {
+		if code, _ := do(t, engine, "/v1/auth/email/resend", "", &v1.ResendVerificationRequest{Username: username}, nil); code != http.StatusOK {
+			t.Fatalf("resend verification to %s: got status %d", username, code)
+		}
+	}
+	if tokens := mailedTokens(t, dir, user.Email); len(tokens) != 2 {
+		t.Fatalf("resend verification to an active user: got %d mails, want 2", len(tokens))
+	}
+
+	// Expired links can be replaced by a new one.
+	late := createUser(t, engine, "lateuser")
+	rds.FastForward(25 * time.Hour)
+	expired := mailedTokens(t, dir, late.Email)
+	if code, reason := do(t, engine, "/v1/auth/email/verify", "", &v1.VerifyEmailRequest{Token: expired[0]}, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_InvalidEmailVerificationToken.String() {
+		t.Fatalf("verify with an expired token: got status %d reason %q", code, reason)
+	}
+	if code, _ := do(t, engine, "/v1/auth/email/resend", "", &v1.ResendVerificationRequest{Username: late.Username}, nil); code != http.StatusOK {
+		t.Fatalf("resend an expired verification: got status %d", code)
+	}
+	tokens = mailedTokens(t, dir, late.Email)
+	if code, _ := do(t, engine, "/v1/auth/email/verify", "", &v1.VerifyEmailRequest{Token: tokens[len(tokens)-1]}, nil); code != http.StatusOK {
+		t.Fatalf("verify with a resent token: got status %d", code)
+	}
+	late.login(t, engine)
+}
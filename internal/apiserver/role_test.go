@@ -0,0 +1,164 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestRoleManagement(t *testing.T) {
+	engine, _ := newTestEngine(t)
+	admin := loginAdmin(t, engine)
+
+	user := createUser(t, engine, "roleuser")
+	login := user.login(t, engine)
+
+	listRoles := func(token, userID string) []string {
+		t.Helper()
+
+		var resp v1.ListUserRoleResponse
+		if code, reason := doRequest(t, engine, http.MethodGet, "/v1/users/"+userID+"/roles", token, nil, &resp); code != http.StatusOK {
+			t.Fatalf("list user roles: got status %d (%s)", code, reason)
+		}
+		names := make([]string, 0, len(resp.Roles))
+		for _, role := range resp.Roles {
+			names = append(names, role.Name)
+		}
+		slices.Sort(names)
+		return names
+	}
+
+	// New users get role::user, and can list their own roles.
+	if roles := listRoles(login.AccessToken, user.UserID); !slices.Equal(roles, []string{known.RoleUser}) {
+		t.Fatalf("roles of new user: got %v", roles)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/users/"+known.AdminUserID+"/roles", login.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("list roles of another user: got status %d, want %d", code, http.StatusForbidden)
+	}
+
+	// Only the administrator manages the roles.
+	auditor := &v1.CreateRoleRequest{Name: "role::auditor", DisplayName: "Auditor", Description: "reads the audit logs"}
+	if code, _ := do(t, engine, "/v1/roles", login.AccessToken, auditor, nil); code != http.StatusForbidden {
+		t.Fatalf("create role as user: got status %d, want %d", code, http.StatusForbidden)
+	}
+	if code, _ := do(t, engine, "/v1/roles", admin.AccessToken, &v1.CreateRoleRequest{Name: "auditor"}, nil); code != http.StatusBadRequest {
+		t.Fatalf("create role without prefix: got status %d, want %d", code, http.StatusBadRequest)
+	}
+	if code, reason := do(t, engine, "/v1/roles", admin.AccessToken, auditor, nil); code != http.StatusOK {
+		t.Fatalf("create role: got status %d (%s)", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/roles", admin.AccessToken, auditor, nil); code != http.StatusConflict || reason != v1.ErrorReason_RoleAlreadyExists.String() {
+		t.Fatalf("create duplicated role: got status %d (%s)", code, reason)
+	}
+
+	var got v1.GetRoleResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles/role::auditor", admin.AccessToken, nil, &got); code != http.StatusOK {
+		t.Fatalf("get role: got status %d", code)
+	}
+	if got.Role.DisplayName != auditor.DisplayName || got.Role.Status != known.RoleStatusEnabled || got.Role.Builtin {
+		t.Fatalf("get role: got %+v", got.Role)
+	}
+	var list v1.ListRoleResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles?offset=0&limit=100", admin.AccessToken, nil, &list); code != http.StatusOK {
+		t.Fatalf("list roles: got status %d", code)
+	}
+	if list.Total < 3 {
+		t.Fatalf("list roles: got total %d, want the builtin roles and role::auditor", list.Total)
+	}
+
+	// Assign the role, its members are listed.
+	assign := &v1.AssignUserRoleRequest{Roles: []string{"role::auditor"}}
+	if code, reason := do(t, engine, "/v1/users/"+user.UserID+"/roles", admin.AccessToken, assign, nil); code != http.StatusOK {
+		t.Fatalf("assign role: got status %d (%s)", code, reason)
+	}
+	if roles := listRoles(admin.AccessToken, user.UserID); !slices.Equal(roles, []string{"role::auditor", known.RoleUser}) {
+		t.Fatalf("roles after assignment: got %v", roles)
+	}
+	var members v1.ListRoleMemberResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles/role::auditor/members?offset=0&limit=10", admin.AccessToken, nil, &members); code != http.StatusOK {
+		t.Fatalf("list role members: got status %d", code)
+	}
+	if members.Total != 1 || members.Users[0].UserID != user.UserID {
+		t.Fatalf("list role members: got %d members", members.Total)
+	}
+	if code, reason := do(t, engine, "/v1/users/"+user.UserID+"/roles", admin.AccessToken, &v1.AssignUserRoleRequest{Roles: []string{"role::missing"}}, nil); code != http.StatusNotFound || reason != v1.ErrorReason_RoleNotFound.String() {
+		t.Fatalf("assign missing role: got status %d (%s)", code, reason)
+	}
+
+	// Disabled roles can not be assigned.
+	disabled := int32(known.RoleStatusDisabled)
+	if code, _ := doRequest(t, engine, http.MethodPut, "/v1/roles/role::auditor", admin.AccessToken, &v1.UpdateRoleRequest{Status: &disabled}, nil); code != http.StatusOK {
+		t.Fatalf("disable role: got status %d", code)
+	}
+	if code, reason := do(t, engine, "/v1/users/"+known.AdminUserID+"/roles", admin.AccessToken, assign, nil); code != http.StatusBadRequest || reason != v1.ErrorReason_RoleDisabled.String() {
+		t.Fatalf("assign disabled role: got status %d (%s)", code, reason)
+	}
+
+	// Builtin roles can be neither disabled nor deleted.
+	if code, reason := doRequest(t, engine, http.MethodPut, "/v1/roles/"+known.RoleUser, admin.AccessToken, &v1.UpdateRoleRequest{Status: &disabled}, nil); code != http.StatusForbidden || reason != v1.ErrorReason_RoleBuiltin.String() {
+		t.Fatalf("disable builtin role: got status %d (%s)", code, reason)
+	}
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/roles/"+known.RoleAdmin, admin.AccessToken, nil, nil); code != http.StatusForbidden || reason != v1.ErrorReason_RoleBuiltin.String() {
+		t.Fatalf("delete builtin role: got status %d (%s)", code, reason)
+	}
+
+	// Remove the role from the user.
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+user.UserID+"/roles/"+known.RoleUser, admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("remove role: got status %d", code)
+	}
+	if roles := listRoles(admin.AccessToken, user.UserID); !slices.Equal(roles, []string{"role::auditor"}) {
+		t.Fatalf("roles after removal: got %v", roles)
+	}
+
+	// Deleting the role revokes it from its members.
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/roles/role::auditor", admin.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete role: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/roles/role::auditor", admin.AccessToken, nil, nil); code != http.StatusNotFound {
+		t.Fatalf("get deleted role: got status %d, want %d", code, http.StatusNotFound)
+	}
+	if roles := casbinRoles(t, user.UserID); len(roles) != 0 {
+		t.Fatalf("bindings after deleting the role: got %v", roles)
+	}
+
+	// Deleting the user removes its bindings and secrets, the cached secrets stop authenticating.
+	assignRoles(t, engine, admin, user.UserID, known.RoleUser)
+	secret := &model.SecretM{UserID: user.UserID, Name: "deleted"}
+	if err := store.S.Secret().Create(context.Background(), secret); err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+	signed := func() int {
+		rq := httptest.NewRequest(http.MethodGet, "/v1/sessions", nil)
+		if err := auth.SignRequest(rq, secret.SecretID, secret.SecretKey); err != nil {
+			t.Fatalf("sign request: %v", err)
+		}
+		w := httptest.NewRecorder()
+		engine.ServeHTTP(w, rq)
+		return w.Code
+	}
+	if code := signed(); code != http.StatusOK {
+		t.Fatalf("signed request: got status %d", code)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/users/"+user.UserID, admin.AccessToken, &v1.DeleteUserRequest{UserID: user.UserID}, nil); code != http.StatusOK {
+		t.Fatalf("delete user: got status %d", code)
+	}
+	if roles := casbinRoles(t, user.UserID); len(roles) != 0 {
+		t.Fatalf("bindings after deleting the user: got %v", roles)
+	}
+	if count, _, err := store.S.Secret().List(context.Background(), where.F("userID", user.UserID)); err != nil || count != 0 {
+		t.Fatalf("secrets after deleting the user: got %d (%v)", count, err)
+	}
+	if code := signed(); code != http.StatusUnauthorized {
+		t.Fatalf("signed request of a deleted user: got status %d, want %d", code, http.StatusUnauthorized)
+	}
+}
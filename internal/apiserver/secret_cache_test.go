@@ -0,0 +1,98 @@
+package apiserver
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	secretv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/secret"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestSecretCacheInvalidation(t *testing.T) {
+	// The secrets are managed through the tenant of the logged-in user, as in NewServer.
+	where.RegisterTenant("userID", func(ctx context.Context) string {
+		return contextx.UserID(ctx)
+	})
+
+	// Two replicas share the database and Redis, each caches the secrets it has seen.
+	replica, rds := newTestEngine(t)
+	other, _ := newTestEngine(t, func(c *Config) { c.RedisOptions.Addr = rds.Addr() })
+
+	user := &v1.CreateUserRequest{
+		Username: "secretcache",
+		Nickname: "secretcache",
+		Password: "secretcache123",
+		Email:    "secretcache@example.com",
+		Phone:    "13800000061",
+	}
+	var created v1.CreateUserResponse
+	if code, _ := do(t, replica, "/v1/users", "", user, &created); code != http.StatusOK {
+		t.Fatalf("create user: got status %d", code)
+	}
+	secret := &model.SecretM{UserID: created.UserID, Name: "cached"}
+	if err := store.S.Secret().Create(context.Background(), secret); err != nil {
+		t.Fatalf("create secret: %v", err)
+	}
+
+	// The secrets are updated through a third replica, the others only learn about it from Redis.
+	redisOpts := genericoptions.NewRedisOptions()
+	redisOpts.Addr = rds.Addr()
+	authn, err := auth.NewAuthn(store.NewSecretSetter(store.S), nil, nil, options.NewJWTOptions(), redisOpts)
+	if err != nil {
+		t.Fatalf("create authn: %v", err)
+	}
+	secrets := secretv1.New(store.S, auth.NewAuth(authn, nil))
+	ctx := contextx.WithUserID(context.Background(), created.UserID)
+
+	send := func() int {
+		rq := httptest.NewRequest(http.MethodGet, "/v1/sessions", nil)
+		if err := auth.SignRequest(rq, secret.SecretID, secret.SecretKey); err != nil {
+			t.Fatalf("sign request: %v", err)
+		}
+		w := httptest.NewRecorder()
+		other.ServeHTTP(w, rq)
+		return w.Code
+	}
+	// eventually waits for the invalidation to be delivered to the other replica.
+	eventually := func(name string, want int) {
+		t.Helper()
+		deadline := time.Now().Add(2 * time.Second)
+		for code := send(); code != want; code = send() {
+			if time.Now().After(deadline) {
+				t.Fatalf("%s: got status %d, want %d", name, code, want)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+
+	eventually("cached secret", http.StatusOK)
+
+	disabled := int32(known.SecretStatusDisabled)
+	if _, err := secrets.Update(ctx, &v1.UpdateSecretRequest{Name: secret.Name, Status: &disabled}); err != nil {
+		t.Fatalf("disable secret: %v", err)
+	}
+	eventually("disabled secret", http.StatusUnauthorized)
+
+	enabled := int32(known.SecretStatusNormal)
+	if _, err := secrets.Update(ctx, &v1.UpdateSecretRequest{Name: secret.Name, Status: &enabled}); err != nil {
+		t.Fatalf("enable secret: %v", err)
+	}
+	eventually("enabled secret", http.StatusOK)
+
+	if _, err := secrets.Delete(ctx, &v1.DeleteSecretRequest{Name: secret.Name}); err != nil {
+		t.Fatalf("delete secret: %v", err)
+	}
+	eventually("deleted secret", http.StatusUnauthorized)
+}
@@ -5,9 +5,8 @@ import (
 	"log/slog"
 	"time"
 
-	"github.com/golang-jwt/jwt/v4"
+	"github.com/casbin/casbin/v2"
 	"github.com/moweilong/milady/pkg/authn"
-	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
 	jwtredis "github.com/moweilong/milady/pkg/authn/jwt/store/redis"
 	"github.com/moweilong/milady/pkg/authz"
 	genericoptions "github.com/moweilong/milady/pkg/options"
@@ -17,20 +16,37 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/biz"
+	sessionv1 "github.com/moweilong/art-design-pro-go/internal/apiserver/biz/v1/session"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/validation"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
 	mw "github.com/moweilong/art-design-pro-go/internal/pkg/middleware"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
 )
 
 // Config contains application-related configurations.
 type Config struct {
-	TLSOptions   *genericoptions.TLSOptions
-	HTTPOptions  *genericoptions.HTTPOptions
-	MySQLOptions *genericoptions.MySQLOptions
-	JWTOptions   *genericoptions.JWTOptions
-	RedisOptions *genericoptions.RedisOptions
+	TLSOptions            *genericoptions.TLSOptions
+	HTTPOptions           *genericoptions.HTTPOptions
+	MySQLOptions          *genericoptions.MySQLOptions
+	JWTOptions            *options.JWTOptions
+	RedisOptions          *genericoptions.RedisOptions
+	LockoutOptions        *options.LockoutOptions
+	CaptchaOptions        *options.CaptchaOptions
+	SignatureOptions      *options.SignatureOptions
+	OIDCOptions           *options.OIDCOptions
+	LDAPOptions           *options.LDAPOptions
+	PasswordPolicyOptions *options.PasswordPolicyOptions
+	PasswordHashOptions   *options.PasswordHashOptions
+	PasswordResetOptions  *options.PasswordResetOptions
+	RegistrationOptions   *options.RegistrationOptions
+	MailOptions           *options.MailOptions
+	ExtAuthzOptions       *options.ExtAuthzOptions
+	// TrustedProxies are the reverse proxies whose X-Forwarded-For headers decide the client IP.
+	TrustedProxies []string
 }
 
 // Server represents the web server.
@@ -45,7 +61,12 @@ type ServerConfig struct {
 	biz       biz.IBiz
 	val       *validation.Validator
 	retriever mw.UserRetriever
+	authn     auth.AuthnInterface
 	authz     *authz.Authz
+	// signature verifies the requests signed with a SecretID/SecretKey pair.
+	signature auth.SignatureVerifier
+	// recorder writes the requests performed under impersonation to the audit trail.
+	recorder mw.AuditRecorder
 }
 
 // NewServer initializes and returns a new Server instance.
@@ -53,11 +74,13 @@ func (cfg *Config) NewServer(ctx context.Context) (*Server, error) {
 	where.RegisterTenant("userID", func(ctx context.Context) string {
 		return contextx.UserID(ctx)
 	})
+	// The password hashes are created by the hooks of the models, which can not be injected.
+	pwdhash.Init(cfg.PasswordHashOptions)
 
 	// 初始化 token 包的签名密钥、认证 Key 及 Token 默认过期时间
 	// token.Init(cfg.JWTKey, token.WithIdentityKey(known.XUserID), token.WithExpiration(cfg.Expiration))
 	// Create the core server instance.
-	return NewServer(cfg, cfg.JWTOptions, cfg.RedisOptions)
+	return NewServer(cfg, cfg.JWTOptions, cfg.RedisOptions, cfg.LockoutOptions, cfg.CaptchaOptions, cfg.SignatureOptions, cfg.OIDCOptions, cfg.LDAPOptions, cfg.PasswordPolicyOptions, cfg.PasswordResetOptions, cfg.RegistrationOptions, cfg.MailOptions)
 }
 
 // Run starts the server and listens for termination signals.
@@ -107,53 +130,66 @@ func (r *UserRetriever) GetUser(ctx context.Context, userID string) (*model.User
 	return r.store.User().Get(ctx, where.F("userID", userID))
 }
 
+// AuditRecorder 定义一个审计日志写入器. 用来记录模拟登录期间的操作.
+type AuditRecorder struct {
+	store store.IStore
+}
+
+// Record 写入一条审计日志.
+func (r *AuditRecorder) Record(ctx context.Context, auditLog *model.AuditLogM) error {
+	return r.store.AuditLog().Create(ctx, auditLog)
+}
+
+// SessionRevoker 定义一个会话撤销器. 用来撤销刷新令牌被重复使用的会话.
+type SessionRevoker struct {
+	store    store.IStore
+	sessions auth.SessionStore
+}
+
+// RevokeSession 撤销会话并删除会话记录.
+func (r *SessionRevoker) RevokeSession(ctx context.Context, sessionID string, expiresAt time.Time) error {
+	return sessionv1.Revoke(ctx, r.store, r.sessions, &model.UserSessionM{SessionID: sessionID, ExpiresAt: expiresAt})
+}
+
 // ProvideDB provides a database instance based on the configuration.
 func ProvideDB(cfg *Config) (*gorm.DB, error) {
 	return cfg.NewDB()
 }
 
-func NewWebServer(serverConfig *ServerConfig, authn authn.Authenticator) (server.Server, error) {
-	return serverConfig.NewGinServer(authn)
+// ProvideAuthz shares the casbin enforcer of the authorization middleware with the
+// auth package, so that AuthBiz.Authorize and the middleware evaluate the same policies.
+// The policy changes made through the API are broadcast to the other replicas through Redis.
+func ProvideAuthz(a *authz.Authz, redisOpts *genericoptions.RedisOptions) (auth.AuthzInterface, error) {
+	warnInvalidPolicies(a.SyncedEnforcer)
+	return auth.NewAuthzWithEnforcer(a.SyncedEnforcer, redisOpts)
 }
 
-// NewAuthenticator creates a new JWT-based Authenticator using the provided JWT and Redis options.
-func NewAuthenticator(jwtOpts *genericoptions.JWTOptions, redisOpts *genericoptions.RedisOptions) (authn.Authenticator, error) {
-	// Create a list of options for jwtauthn.
-	opts := []jwtauthn.Option{
-		// Specify the issuer of the token
-		jwtauthn.WithIssuer("art-design-pro-go"),
-		// Specify the default expiration time for the token to be issued
-		jwtauthn.WithExpired(jwtOpts.Expired),
-		// Specify the key to be used when issuing the token
-		jwtauthn.WithSigningKey([]byte(jwtOpts.Key)),
-		// WithKeyfunc will be used by the Parse methods as a callback function to supply
-		// the key for verification.  The function receives the parsed,
-		// but unverified Token.  This allows you to use properties in the
-		// Header of the token (such as `kid`) to identify which key to use.
-		jwtauthn.WithKeyfunc(func(t *jwt.Token) (any, error) {
-			// Verify that the signing method is HMAC.
-			if _, ok := t.Method.(*jwt.SigningMethodHMAC); !ok {
-				return nil, jwtauthn.ErrTokenInvalid
-			}
-			// Return the signing key.
-			return []byte(jwtOpts.Key), nil
-		}),
+// warnInvalidPolicies 在启动时检查已存储的策略. 策略对象由 keyMatch 改为 keyMatch2 匹配后，
+// 旧的策略（例如 /v1/users*）不再匹配预期的请求，需要管理员通过策略接口改写它们.
+func warnInvalidPolicies(enforcer *casbin.SyncedEnforcer) {
+	policies, err := enforcer.GetPolicy()
+	if err != nil {
+		slog.Error("Failed to get the stored policies", "err", err)
+		return
 	}
-
-	// Set the signing method based on the provided option.
-	var method jwt.SigningMethod
-	switch jwtOpts.SigningMethod {
-	case "HS256":
-		method = jwt.SigningMethodHS256
-	case "HS384":
-		method = jwt.SigningMethodHS384
-	default:
-		method = jwt.SigningMethodHS512
+	for _, policy := range policies {
+		if len(policy) < 2 {
+			continue
+		}
+		if err := validation.ValidatePolicyObject(policy[1]); err != nil {
+			slog.Warn("Stored policy has an invalid object, rewrite it through /v1/policies", "policy", policy, "err", err)
+		}
 	}
+}
 
-	opts = append(opts, jwtauthn.WithSigningMethod(method))
+func NewWebServer(serverConfig *ServerConfig, authn authn.Authenticator) (server.Server, error) {
+	return serverConfig.NewGinServer(authn)
+}
 
-	// Create a Redis store for jwtauthn.
+// NewAuthenticator creates the Authenticator of refresh tokens using the provided JWT and Redis options.
+// Access tokens are signed and verified by auth.AuthnInterface instead.
+func NewAuthenticator(jwtOpts *options.JWTOptions, redisOpts *genericoptions.RedisOptions, keys *auth.KeySet, sessions auth.SessionStore, revoker auth.SessionRevoker) (authn.Authenticator, error) {
+	// Create a Redis store to keep the destroyed refresh tokens.
 	store := jwtredis.NewStore(&jwtredis.Config{
 		Addr:      redisOpts.Addr,
 		Username:  redisOpts.Username,
@@ -162,8 +198,8 @@ func NewAuthenticator(jwtOpts *genericoptions.JWTOptions, redisOpts *genericopti
 		KeyPrefix: "authn_",
 	})
 
-	// Create a new jwtauthn instance using the Redis store and options.
-	authn := jwtauthn.New(store, opts...)
-
-	return authn, nil
+	// The keys are selected by the kid header, see auth.KeySet. Refresh tokens
+	// of revoked login sessions are rejected through the session store, and the
+	// sessions whose rotated refresh tokens are reused are revoked through revoker.
+	return auth.NewRefreshAuthn(store, keys, sessions, revoker, jwtOpts.RefreshExpired, jwtOpts.MaxRefresh), nil
 }
@@ -0,0 +1,98 @@
+package apiserver
+
+import (
+	"net/http"
+	"testing"
+
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+func TestSessions(t *testing.T) {
+	engine, _ := newTestEngine(t)
+
+	user := createUser(t, engine, "sessionuser")
+	laptop := user.login(t, engine)
+	desktop := loginFrom(t, engine, user.Username, user.Password, firefoxOnLinux)
+
+	var list v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", laptop.AccessToken, nil, &list); code != http.StatusOK {
+		t.Fatalf("list sessions: got status %d", code)
+	}
+	if list.Total != 2 {
+		t.Fatalf("list sessions: got %d sessions, want 2", list.Total)
+	}
+	devices := map[string]*v1.Session{}
+	for _, session := range list.Sessions {
+		devices[session.Device] = session
+	}
+	current, other := devices["Chrome on macOS"], devices["Firefox on Linux"]
+	if current == nil || other == nil {
+		t.Fatalf("list sessions: unexpected devices %v", devices)
+	}
+	if !current.Current || other.Current {
+		t.Fatalf("list sessions: only the session of the request must be current, got %v and %v", current.Current, other.Current)
+	}
+	if other.UserAgent != firefoxOnLinux || other.GetIssuedAt() == nil || other.GetLastSeenAt() == nil {
+		t.Fatalf("list sessions: incomplete session %+v", other)
+	}
+
+	// Revoking a session rejects both of its tokens at once, the other session keeps working.
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/sessions/"+other.SessionID, laptop.AccessToken, nil, nil); code != http.StatusOK {
+		t.Fatalf("delete session: got status %d", code)
+	}
+	if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", desktop.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("access token of a revoked session: got status %d reason %q", code, reason)
+	}
+	if code, reason := do(t, engine, "/v1/auth/refresh-token", desktop.RefreshToken, &v1.RefreshTokenRequest{}, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("refresh token of a revoked session: got status %d reason %q", code, reason)
+	}
+	var remaining v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", laptop.AccessToken, nil, &remaining); code != http.StatusOK || remaining.Total != 1 {
+		t.Fatalf("list sessions after revoke: got status %d total %d", code, remaining.Total)
+	}
+
+	// The sessions of other users look nonexistent.
+	admin := loginAdmin(t, engine)
+	if code, reason := doRequest(t, engine, http.MethodDelete, "/v1/sessions/"+current.SessionID, admin.AccessToken, nil, nil); code != http.StatusNotFound || reason != "SessionNotFound" {
+		t.Fatalf("delete session of another user: got status %d reason %q", code, reason)
+	}
+
+	// The sessions of the users out of the data scope are not listed, only the administrator revokes them.
+	userSessions := "/v1/users/" + user.UserID + "/sessions"
+	stranger := createUser(t, engine, "sessionstranger").login(t, engine)
+	var hidden v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, userSessions, stranger.AccessToken, nil, &hidden); code != http.StatusOK || hidden.Total != 0 {
+		t.Fatalf("list sessions of a user out of the scope: got status %d total %d", code, hidden.Total)
+	}
+	if code, _ := doRequest(t, engine, http.MethodDelete, userSessions, stranger.AccessToken, nil, nil); code != http.StatusForbidden {
+		t.Fatalf("delete sessions of a user as non-admin: got status %d, want %d", code, http.StatusForbidden)
+	}
+	var listed v1.ListSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodGet, userSessions, admin.AccessToken, nil, &listed); code != http.StatusOK || listed.Total != 1 || listed.Sessions[0].Current {
+		t.Fatalf("list sessions of a user as admin: got status %d sessions %v", code, listed.Sessions)
+	}
+
+	var deleted v1.DeleteAllSessionResponse
+	if code, _ := doRequest(t, engine, http.MethodDelete, userSessions, admin.AccessToken, nil, &deleted); code != http.StatusOK || deleted.Count != 1 {
+		t.Fatalf("delete all sessions of a user: got status %d count %d", code, deleted.Count)
+	}
+	if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", laptop.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("access token after all sessions were revoked: got status %d reason %q", code, reason)
+	}
+
+	// Revoking all sessions but the current one, and logging out, end the sessions too.
+	first := user.login(t, engine)
+	second := loginFrom(t, engine, user.Username, user.Password, firefoxOnLinux)
+	if code, _ := doRequest(t, engine, http.MethodDelete, "/v1/sessions?exceptCurrent=true", first.AccessToken, nil, &deleted); code != http.StatusOK || deleted.Count != 1 {
+		t.Fatalf("delete other sessions: got status %d count %d", code, deleted.Count)
+	}
+	if code, _ := doRequest(t, engine, http.MethodGet, "/v1/sessions", second.AccessToken, nil, nil); code != http.StatusUnauthorized {
+		t.Fatalf("access token of another revoked session: got status %d", code)
+	}
+	if code, _ := do(t, engine, "/v1/auth/logout", first.AccessToken, &v1.LogoutRequest{RefreshToken: first.RefreshToken}, nil); code != http.StatusOK {
+		t.Fatalf("logout: got status %d", code)
+	}
+	if code, reason := doRequest(t, engine, http.MethodGet, "/v1/sessions", first.AccessToken, nil, nil); code != http.StatusUnauthorized || reason != "SessionRevoked" {
+		t.Fatalf("access token after logout: got status %d reason %q", code, reason)
+	}
+}
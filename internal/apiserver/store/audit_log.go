@@ -0,0 +1,63 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// AuditLogStore 定义了审计日志模块在 store 层所实现的方法.
+type AuditLogStore interface {
+	Create(ctx context.Context, obj *model.AuditLogM) error
+	Update(ctx context.Context, obj *model.AuditLogM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.AuditLogM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.AuditLogM, error)
+
+	AuditLogExpansion
+}
+
+// AuditLogExpansion 定义了审计日志操作的附加方法.
+// nolint: iface
+type AuditLogExpansion interface{}
+
+// auditLogStore 是 AuditLogStore 接口的实现.
+// Get 和 List 只返回上下文中的用户的数据范围内的用户的审计日志.
+type auditLogStore struct {
+	*genericstore.Store[model.AuditLogM]
+	ds *datastore
+}
+
+// 确保 auditLogStore 实现了 AuditLogStore 接口.
+var _ AuditLogStore = (*auditLogStore)(nil)
+
+// newAuditLogStore 创建 auditLogStore 的实例.
+func newAuditLogStore(store *datastore) *auditLogStore {
+	return &auditLogStore{
+		Store: genericstore.NewStore[model.AuditLogM](store, storelogger.NewLogger()),
+		ds:    store,
+	}
+}
+
+// Get 查询数据范围内的用户的审计日志.
+func (s *auditLogStore) Get(ctx context.Context, opts *where.Options) (*model.AuditLogM, error) {
+	opts, err := s.ds.scopedByOwner(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Store.Get(ctx, opts)
+}
+
+// List 查询数据范围内的用户的审计日志.
+func (s *auditLogStore) List(ctx context.Context, opts *where.Options) (int64, []*model.AuditLogM, error) {
+	opts, err := s.ds.scopedByOwner(ctx, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.Store.List(ctx, opts)
+}
@@ -0,0 +1,147 @@
+package store
+
+import (
+	"context"
+	"slices"
+
+	"github.com/moweilong/milady/pkg/store/where"
+	"gorm.io/gorm/clause"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+)
+
+// dataScope 是用户可以访问的数据范围，由用户的全部角色的数据权限合并而成.
+type dataScope struct {
+	// all 表示可以访问全部用户的数据
+	all bool
+	// userID 是用户本身，用户总是可以访问自己的数据
+	userID string
+	// departmentIDs 是可以访问其中用户数据的部门
+	departmentIDs []string
+}
+
+// resolveDataScope 解析上下文中的用户的数据范围.
+// 上下文中没有用户时，例如登录、注册等内部调用，不限制数据范围.
+func (store *datastore) resolveDataScope(ctx context.Context) (*dataScope, error) {
+	userID := contextx.UserID(ctx)
+	roles := contextx.Roles(ctx)
+	if userID == "" || contextx.IsAdmin(ctx) {
+		return &dataScope{all: true}, nil
+	}
+
+	scope := &dataScope{userID: userID}
+	if len(roles) == 0 {
+		return scope, nil
+	}
+
+	// 禁用的角色以及未通过角色接口创建的角色，例如由 LDAP 组映射的角色，只能访问自己的数据
+	_, roleList, err := store.Role().List(ctx, where.F("name", roles).F("status", known.RoleStatusEnabled))
+	if err != nil {
+		return nil, err
+	}
+
+	var custom []string
+	var department, descendants bool
+	for _, roleM := range roleList {
+		switch roleM.DataScope {
+		case known.DataScopeAll:
+			return &dataScope{all: true}, nil
+		case known.DataScopeCustom:
+			custom = append(custom, roleM.Name)
+		case known.DataScopeDepartment:
+			department = true
+		case known.DataScopeDepartmentAndChildren:
+			descendants = true
+		}
+	}
+
+	if len(custom) > 0 {
+		_, bindings, err := store.RoleDepartment().List(ctx, where.F("role", custom))
+		if err != nil {
+			return nil, err
+		}
+		for _, binding := range bindings {
+			scope.departmentIDs = append(scope.departmentIDs, binding.DepartmentID)
+		}
+	}
+
+	if department || descendants {
+		// 直接使用通用的 store 查询用户所属的部门，避免再次应用数据范围
+		userM, err := newUserStore(store).Store.Get(ctx, where.F("userID", userID))
+		if err != nil {
+			return nil, err
+		}
+		// 不属于任何部门的用户只能访问自己的数据
+		if userM.DepartmentID != "" {
+			departmentIDs := []string{userM.DepartmentID}
+			if descendants {
+				if departmentIDs, err = store.Department().Descendants(ctx, userM.DepartmentID); err != nil {
+					return nil, err
+				}
+			}
+			scope.departmentIDs = append(scope.departmentIDs, departmentIDs...)
+		}
+	}
+
+	slices.Sort(scope.departmentIDs)
+	scope.departmentIDs = slices.Compact(scope.departmentIDs)
+	return scope, nil
+}
+
+// condition 返回只查询范围内用户的条件.
+func (scope *dataScope) condition() clause.Expression {
+	self := clause.Eq{Column: "userId", Value: scope.userID}
+	if len(scope.departmentIDs) == 0 {
+		return self
+	}
+	return clause.Or(self, clause.IN{Column: "departmentId", Values: departmentValues(scope.departmentIDs)})
+}
+
+// ownerCondition 返回只查询范围内用户的数据的条件，用于登录会话、审计日志等通过 userId 属于用户的数据.
+func (scope *dataScope) ownerCondition() clause.Expression {
+	self := clause.Eq{Column: "userId", Value: scope.userID}
+	if len(scope.departmentIDs) == 0 {
+		return self
+	}
+	members := clause.Expr{
+		SQL:  "userId IN (SELECT userId FROM " + model.TableNameUserM + " WHERE departmentId IN ?)",
+		Vars: []any{scope.departmentIDs},
+	}
+	return clause.Or(self, members)
+}
+
+// departmentValues 将部门 ID 转换为 IN 条件的参数.
+func departmentValues(departmentIDs []string) []any {
+	values := make([]any, 0, len(departmentIDs))
+	for _, departmentID := range departmentIDs {
+		values = append(values, departmentID)
+	}
+	return values
+}
+
+// scoped 在用户的查询条件中加入上下文中的用户的数据范围.
+func (store *datastore) scoped(ctx context.Context, opts *where.Options) (*where.Options, error) {
+	return store.applyScope(ctx, opts, (*dataScope).condition)
+}
+
+// scopedByOwner 在通过 userId 属于用户的数据的查询条件中加入上下文中的用户的数据范围.
+func (store *datastore) scopedByOwner(ctx context.Context, opts *where.Options) (*where.Options, error) {
+	return store.applyScope(ctx, opts, (*dataScope).ownerCondition)
+}
+
+// applyScope 在查询条件中加入由 condition 生成的数据范围条件.
+func (store *datastore) applyScope(ctx context.Context, opts *where.Options, condition func(*dataScope) clause.Expression) (*where.Options, error) {
+	scope, err := store.resolveDataScope(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if opts == nil {
+		opts = where.NewWhere()
+	}
+	if scope.all {
+		return opts, nil
+	}
+	return opts.C(condition(scope)), nil
+}
@@ -0,0 +1,70 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// DepartmentStore 定义了部门模块在 store 层所实现的方法.
+type DepartmentStore interface {
+	Create(ctx context.Context, obj *model.DepartmentM) error
+	Update(ctx context.Context, obj *model.DepartmentM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.DepartmentM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.DepartmentM, error)
+
+	DepartmentExpansion
+}
+
+// DepartmentExpansion 定义了部门操作的附加方法.
+type DepartmentExpansion interface {
+	// Descendants 返回部门及其全部下级部门的 ID.
+	Descendants(ctx context.Context, departmentID string) ([]string, error)
+}
+
+// departmentStore 是 DepartmentStore 接口的实现.
+type departmentStore struct {
+	*genericstore.Store[model.DepartmentM]
+}
+
+// 确保 departmentStore 实现了 DepartmentStore 接口.
+var _ DepartmentStore = (*departmentStore)(nil)
+
+// newDepartmentStore 创建 departmentStore 的实例.
+func newDepartmentStore(store *datastore) *departmentStore {
+	return &departmentStore{
+		Store: genericstore.NewStore[model.DepartmentM](store, storelogger.NewLogger()),
+	}
+}
+
+// Descendants 返回部门及其全部下级部门的 ID，部门本身位于第一个.
+// 部门的数量有限，因此一次查询全部部门，在内存中遍历部门树.
+func (s *departmentStore) Descendants(ctx context.Context, departmentID string) ([]string, error) {
+	_, departments, err := s.List(ctx, where.NewWhere())
+	if err != nil {
+		return nil, err
+	}
+
+	children := make(map[string][]string, len(departments))
+	for _, department := range departments {
+		children[department.ParentID] = append(children[department.ParentID], department.DepartmentID)
+	}
+
+	// seen 避免错误的数据中存在环时无限循环
+	descendants, seen := []string{departmentID}, map[string]bool{departmentID: true}
+	for i := 0; i < len(descendants); i++ {
+		for _, child := range children[descendants[i]] {
+			if !seen[child] {
+				seen[child] = true
+				descendants = append(descendants, child)
+			}
+		}
+	}
+	return descendants, nil
+}
@@ -0,0 +1,42 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// MenuStore 定义了菜单模块在 store 层所实现的方法.
+type MenuStore interface {
+	Create(ctx context.Context, obj *model.MenuM) error
+	Update(ctx context.Context, obj *model.MenuM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.MenuM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.MenuM, error)
+
+	MenuExpansion
+}
+
+// MenuExpansion 定义了菜单操作的附加方法.
+// nolint: iface
+type MenuExpansion interface{}
+
+// menuStore 是 MenuStore 接口的实现.
+type menuStore struct {
+	*genericstore.Store[model.MenuM]
+}
+
+// 确保 menuStore 实现了 MenuStore 接口.
+var _ MenuStore = (*menuStore)(nil)
+
+// newMenuStore 创建 menuStore 的实例.
+func newMenuStore(store *datastore) *menuStore {
+	return &menuStore{
+		Store: genericstore.NewStore[model.MenuM](store, storelogger.NewLogger()),
+	}
+}
@@ -0,0 +1,42 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// RoleStore 定义了角色模块在 store 层所实现的方法.
+type RoleStore interface {
+	Create(ctx context.Context, obj *model.RoleM) error
+	Update(ctx context.Context, obj *model.RoleM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RoleM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.RoleM, error)
+
+	RoleExpansion
+}
+
+// RoleExpansion 定义了角色操作的附加方法.
+// nolint: iface
+type RoleExpansion interface{}
+
+// roleStore 是 RoleStore 接口的实现.
+type roleStore struct {
+	*genericstore.Store[model.RoleM]
+}
+
+// 确保 roleStore 实现了 RoleStore 接口.
+var _ RoleStore = (*roleStore)(nil)
+
+// newRoleStore 创建 roleStore 的实例.
+func newRoleStore(store *datastore) *roleStore {
+	return &roleStore{
+		Store: genericstore.NewStore[model.RoleM](store, storelogger.NewLogger()),
+	}
+}
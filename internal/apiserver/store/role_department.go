@@ -0,0 +1,42 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// RoleDepartmentStore 定义了角色部门关联模块在 store 层所实现的方法.
+type RoleDepartmentStore interface {
+	Create(ctx context.Context, obj *model.RoleDepartmentM) error
+	Update(ctx context.Context, obj *model.RoleDepartmentM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RoleDepartmentM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.RoleDepartmentM, error)
+
+	RoleDepartmentExpansion
+}
+
+// RoleDepartmentExpansion 定义了角色部门关联操作的附加方法.
+// nolint: iface
+type RoleDepartmentExpansion interface{}
+
+// roleDepartmentStore 是 RoleDepartmentStore 接口的实现.
+type roleDepartmentStore struct {
+	*genericstore.Store[model.RoleDepartmentM]
+}
+
+// 确保 roleDepartmentStore 实现了 RoleDepartmentStore 接口.
+var _ RoleDepartmentStore = (*roleDepartmentStore)(nil)
+
+// newRoleDepartmentStore 创建 roleDepartmentStore 的实例.
+func newRoleDepartmentStore(store *datastore) *roleDepartmentStore {
+	return &roleDepartmentStore{
+		Store: genericstore.NewStore[model.RoleDepartmentM](store, storelogger.NewLogger()),
+	}
+}
@@ -0,0 +1,42 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// RoleMenuStore 定义了角色菜单关联模块在 store 层所实现的方法.
+type RoleMenuStore interface {
+	Create(ctx context.Context, obj *model.RoleMenuM) error
+	Update(ctx context.Context, obj *model.RoleMenuM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.RoleMenuM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.RoleMenuM, error)
+
+	RoleMenuExpansion
+}
+
+// RoleMenuExpansion 定义了角色菜单关联操作的附加方法.
+// nolint: iface
+type RoleMenuExpansion interface{}
+
+// roleMenuStore 是 RoleMenuStore 接口的实现.
+type roleMenuStore struct {
+	*genericstore.Store[model.RoleMenuM]
+}
+
+// 确保 roleMenuStore 实现了 RoleMenuStore 接口.
+var _ RoleMenuStore = (*roleMenuStore)(nil)
+
+// newRoleMenuStore 创建 roleMenuStore 的实例.
+func newRoleMenuStore(store *datastore) *roleMenuStore {
+	return &roleMenuStore{
+		Store: genericstore.NewStore[model.RoleMenuM](store, storelogger.NewLogger()),
+	}
+}
@@ -16,7 +16,7 @@ import (
 var ProviderSet = wire.NewSet(NewStore, wire.Bind(new(IStore), new(*datastore)))
 
 var (
-	once sync.Once
+	mu sync.Mutex
 	// S is a global variable for convenient access to the initialized datastore
 	// instance from other packages.
 	S *datastore
@@ -30,6 +30,16 @@ type IStore interface {
 	TX(ctx context.Context, fn func(ctx context.Context) error) error
 	User() UserStore
 	Secret() SecretStore
+	UserMFA() UserMFAStore
+	UserIdentity() UserIdentityStore
+	UserSession() UserSessionStore
+	UserPasswordHistory() UserPasswordHistoryStore
+	AuditLog() AuditLogStore
+	Role() RoleStore
+	Menu() MenuStore
+	RoleMenu() RoleMenuStore
+	Department() DepartmentStore
+	RoleDepartment() RoleDepartmentStore
 }
 
 // transactionKey is the key used to store transaction context in context.Context.
@@ -47,12 +57,15 @@ type datastore struct {
 var _ IStore = (*datastore)(nil)
 
 // NewStore initializes a singleton instance of type IStore.
-// It ensures that the datastore is only created once using sync.Once.
+// It ensures that the datastore is only created once, as long as S is set.
 func NewStore(db *gorm.DB) *datastore {
+	mu.Lock()
+	defer mu.Unlock()
+
 	// Initialize the singleton datastore instance only once.
-	once.Do(func() {
+	if S == nil {
 		S = &datastore{db}
-	})
+	}
 
 	return S
 }
@@ -78,10 +91,11 @@ func (store *datastore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB
 // It returns a nil gorm.DB, indicating a fake database.
 func (ds *datastore) FakeDB(ctx context.Context) *gorm.DB { return nil }
 
-// TX starts a new transaction instance.
+// TX starts a new transaction instance. Within the transaction of ctx it starts
+// a nested one, which is rolled back to a savepoint, instead of a separate one.
 // nolint: fatcontext
 func (store *datastore) TX(ctx context.Context, fn func(ctx context.Context) error) error {
-	return store.core.WithContext(ctx).Transaction(
+	return store.DB(ctx).WithContext(ctx).Transaction(
 		func(tx *gorm.DB) error {
 			ctx = context.WithValue(ctx, transactionKey{}, tx)
 			return fn(ctx)
@@ -98,3 +112,53 @@ func (store *datastore) User() UserStore {
 func (store *datastore) Secret() SecretStore {
 	return newSecretStore(store)
 }
+
+// UserMFA 返回一个实现了 UserMFAStore 接口的实例.
+func (store *datastore) UserMFA() UserMFAStore {
+	return newUserMFAStore(store)
+}
+
+// UserIdentity 返回一个实现了 UserIdentityStore 接口的实例.
+func (store *datastore) UserIdentity() UserIdentityStore {
+	return newUserIdentityStore(store)
+}
+
+// UserSession 返回一个实现了 UserSessionStore 接口的实例.
+func (store *datastore) UserSession() UserSessionStore {
+	return newUserSessionStore(store)
+}
+
+// UserPasswordHistory 返回一个实现了 UserPasswordHistoryStore 接口的实例.
+func (store *datastore) UserPasswordHistory() UserPasswordHistoryStore {
+	return newUserPasswordHistoryStore(store)
+}
+
+// AuditLog 返回一个实现了 AuditLogStore 接口的实例.
+func (store *datastore) AuditLog() AuditLogStore {
+	return newAuditLogStore(store)
+}
+
+// Role 返回一个实现了 RoleStore 接口的实例.
+func (store *datastore) Role() RoleStore {
+	return newRoleStore(store)
+}
+
+// Menu 返回一个实现了 MenuStore 接口的实例.
+func (store *datastore) Menu() MenuStore {
+	return newMenuStore(store)
+}
+
+// RoleMenu 返回一个实现了 RoleMenuStore 接口的实例.
+func (store *datastore) RoleMenu() RoleMenuStore {
+	return newRoleMenuStore(store)
+}
+
+// Department 返回一个实现了 DepartmentStore 接口的实例.
+func (store *datastore) Department() DepartmentStore {
+	return newDepartmentStore(store)
+}
+
+// RoleDepartment 返回一个实现了 RoleDepartmentStore 接口的实例.
+func (store *datastore) RoleDepartment() RoleDepartmentStore {
+	return newRoleDepartmentStore(store)
+}
@@ -27,8 +27,10 @@ type UserStore interface {
 type UserExpansion interface{}
 
 // userStore 是 UserStore 接口的实现.
+// Get 和 List 只返回上下文中的用户的数据范围内的用户.
 type userStore struct {
 	*genericstore.Store[model.UserM]
+	ds *datastore
 }
 
 // 确保 userStore 实现了 UserStore 接口.
@@ -38,5 +40,24 @@ var _ UserStore = (*userStore)(nil)
 func newUserStore(store *datastore) *userStore {
 	return &userStore{
 		Store: genericstore.NewStore[model.UserM](store, storelogger.NewLogger()),
+		ds:    store,
 	}
 }
+
+// Get 查询数据范围内的用户，范围外的用户与不存在的用户一样返回 gorm.ErrRecordNotFound.
+func (s *userStore) Get(ctx context.Context, opts *where.Options) (*model.UserM, error) {
+	opts, err := s.ds.scoped(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Store.Get(ctx, opts)
+}
+
+// List 查询数据范围内的用户.
+func (s *userStore) List(ctx context.Context, opts *where.Options) (int64, []*model.UserM, error) {
+	opts, err := s.ds.scoped(ctx, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.Store.List(ctx, opts)
+}
@@ -0,0 +1,59 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// UserMFAStore 定义了用户多因素认证模块在 store 层所实现的方法.
+type UserMFAStore interface {
+	Create(ctx context.Context, obj *model.UserMFAM) error
+	Update(ctx context.Context, obj *model.UserMFAM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserMFAM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserMFAM, error)
+
+	UserMFAExpansion
+}
+
+// UserMFAExpansion 定义了用户多因素认证操作的附加方法.
+// nolint: iface
+type UserMFAExpansion interface {
+	// UpdateUsed 保存验证码使用后的时间步和恢复码，仅当记录仍是使用前的 lastUsedStep 和 recoveryCodes 时更新.
+	// 返回 false 表示验证码已被并发的请求使用
+	UpdateUsed(ctx context.Context, obj *model.UserMFAM, lastUsedStep int64, recoveryCodes string) (bool, error)
+}
+
+// userMFAStore 是 UserMFAStore 接口的实现.
+type userMFAStore struct {
+	*genericstore.Store[model.UserMFAM]
+	ds *datastore
+}
+
+// 确保 userMFAStore 实现了 UserMFAStore 接口.
+var _ UserMFAStore = (*userMFAStore)(nil)
+
+// newUserMFAStore 创建 userMFAStore 的实例.
+func newUserMFAStore(store *datastore) *userMFAStore {
+	return &userMFAStore{
+		Store: genericstore.NewStore[model.UserMFAM](store, storelogger.NewLogger()),
+		ds:    store,
+	}
+}
+
+// UpdateUsed 实现了 UserMFAExpansion 接口的 UpdateUsed 方法.
+func (s *userMFAStore) UpdateUsed(ctx context.Context, obj *model.UserMFAM, lastUsedStep int64, recoveryCodes string) (bool, error) {
+	result := s.ds.DB(ctx).Model(&model.UserMFAM{}).
+		Where("userId = ? AND lastUsedStep = ? AND recoveryCodes = ?", obj.UserID, lastUsedStep, recoveryCodes).
+		Updates(map[string]any{"lastUsedStep": obj.LastUsedStep, "recoveryCodes": obj.RecoveryCodes})
+	if result.Error != nil {
+		return false, result.Error
+	}
+	return result.RowsAffected == 1, nil
+}
@@ -0,0 +1,42 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// UserPasswordHistoryStore 定义了用户密码历史模块在 store 层所实现的方法.
+type UserPasswordHistoryStore interface {
+	Create(ctx context.Context, obj *model.UserPasswordHistoryM) error
+	Update(ctx context.Context, obj *model.UserPasswordHistoryM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserPasswordHistoryM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserPasswordHistoryM, error)
+
+	UserPasswordHistoryExpansion
+}
+
+// UserPasswordHistoryExpansion 定义了用户密码历史操作的附加方法.
+// nolint: iface
+type UserPasswordHistoryExpansion interface{}
+
+// userPasswordHistoryStore 是 UserPasswordHistoryStore 接口的实现.
+type userPasswordHistoryStore struct {
+	*genericstore.Store[model.UserPasswordHistoryM]
+}
+
+// 确保 userPasswordHistoryStore 实现了 UserPasswordHistoryStore 接口.
+var _ UserPasswordHistoryStore = (*userPasswordHistoryStore)(nil)
+
+// newUserPasswordHistoryStore 创建 userPasswordHistoryStore 的实例.
+func newUserPasswordHistoryStore(store *datastore) *userPasswordHistoryStore {
+	return &userPasswordHistoryStore{
+		Store: genericstore.NewStore[model.UserPasswordHistoryM](store, storelogger.NewLogger()),
+	}
+}
@@ -0,0 +1,63 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+
+	storelogger "github.com/moweilong/milady/pkg/log/logger/store"
+	genericstore "github.com/moweilong/milady/pkg/store"
+	"github.com/moweilong/milady/pkg/store/where"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+)
+
+// UserSessionStore 定义了用户登录会话模块在 store 层所实现的方法.
+type UserSessionStore interface {
+	Create(ctx context.Context, obj *model.UserSessionM) error
+	Update(ctx context.Context, obj *model.UserSessionM) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.UserSessionM, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.UserSessionM, error)
+
+	UserSessionExpansion
+}
+
+// UserSessionExpansion 定义了用户登录会话操作的附加方法.
+// nolint: iface
+type UserSessionExpansion interface{}
+
+// userSessionStore 是 UserSessionStore 接口的实现.
+// Get 和 List 只返回上下文中的用户的数据范围内的用户的会话.
+type userSessionStore struct {
+	*genericstore.Store[model.UserSessionM]
+	ds *datastore
+}
+
+// 确保 userSessionStore 实现了 UserSessionStore 接口.
+var _ UserSessionStore = (*userSessionStore)(nil)
+
+// newUserSessionStore 创建 userSessionStore 的实例.
+func newUserSessionStore(store *datastore) *userSessionStore {
+	return &userSessionStore{
+		Store: genericstore.NewStore[model.UserSessionM](store, storelogger.NewLogger()),
+		ds:    store,
+	}
+}
+
+// Get 查询数据范围内的用户的会话，范围外的会话与不存在的会话一样返回 gorm.ErrRecordNotFound.
+func (s *userSessionStore) Get(ctx context.Context, opts *where.Options) (*model.UserSessionM, error) {
+	opts, err := s.ds.scopedByOwner(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	return s.Store.Get(ctx, opts)
+}
+
+// List 查询数据范围内的用户的会话.
+func (s *userSessionStore) List(ctx context.Context, opts *where.Options) (int64, []*model.UserSessionM, error) {
+	opts, err := s.ds.scopedByOwner(ctx, opts)
+	if err != nil {
+		return 0, nil, err
+	}
+	return s.Store.List(ctx, opts)
+}
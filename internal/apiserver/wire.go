@@ -12,11 +12,20 @@ import (
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/validation"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/lockout"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
 	mw "github.com/moweilong/art-design-pro-go/internal/pkg/middleware"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdreset"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
 )
 
 // NewServer sets up and create the web server with all necessary dependencies.
-func NewServer(*Config, *genericoptions.JWTOptions, *genericoptions.RedisOptions) (*Server, error) {
+func NewServer(*Config, *options.JWTOptions, *genericoptions.RedisOptions, *options.LockoutOptions, *options.CaptchaOptions, *options.SignatureOptions, *options.OIDCOptions, *options.LDAPOptions, *options.PasswordPolicyOptions, *options.PasswordResetOptions, *options.RegistrationOptions, *options.MailOptions) (*Server, error) {
 	wire.Build(
 		NewWebServer,
 		wire.Struct(new(ServerConfig), "*"), // * 表示注入全部字段
@@ -26,12 +35,32 @@ func NewServer(*Config, *genericoptions.JWTOptions, *genericoptions.RedisOptions
 		ProvideDB,               // 提供数据库实例
 		NewAuthenticator,        // 提供认证器
 		auth.ProviderSet,
+		auth.SignatureProviderSet, // AK/SK 请求签名校验
+		lockout.ProviderSet,       // 登录失败锁定
+		captcha.ProviderSet,       // 图形验证码
+		oidc.ProviderSet,          // OIDC 单点登录
+		ldap.ProviderSet,          // LDAP / AD 认证
+		pwdpolicy.ProviderSet,     // 密码策略
+		pwdreset.ProviderSet,      // 找回密码的限流和重置令牌
+		registration.ProviderSet,  // 注册用户的邮箱验证令牌
+		mail.ProviderSet,          // 邮件发送
 		validation.ProviderSet,
 		wire.NewSet(
 			wire.Struct(new(UserRetriever), "*"),
 			wire.Bind(new(mw.UserRetriever), new(*UserRetriever)),
 		),
-		authz.ProviderSet,
+		wire.NewSet(
+			wire.Struct(new(AuditRecorder), "*"),
+			wire.Bind(new(mw.AuditRecorder), new(*AuditRecorder)),
+		),
+		wire.NewSet(
+			wire.Struct(new(SessionRevoker), "*"),
+			wire.Bind(new(auth.SessionRevoker), new(*SessionRevoker)),
+		),
+		authz.NewAuthz,
+		wire.Bind(new(mw.RoleRetriever), new(*authz.Authz)), // 校验模拟登录的目标用户是否为管理员
+		auth.AuthzOptions, // 支持路由模板和资源所有者的 casbin 模型
+		ProvideAuthz,      // auth 与授权中间件共享同一个 casbin enforcer
 	)
 	return nil, nil
 }
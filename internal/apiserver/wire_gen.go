@@ -11,45 +11,116 @@ import (
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/pkg/validation"
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/store"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/captcha"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/ldap"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/lockout"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/mail"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/oidc"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdpolicy"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdreset"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/registration"
 	"github.com/moweilong/milady/pkg/authz"
-	"github.com/moweilong/milady/pkg/options"
+	options2 "github.com/moweilong/milady/pkg/options"
 )
 
 // Injectors from wire.go:
 
 // NewServer sets up and create the web server with all necessary dependencies.
-func NewServer(config *Config, jwtOptions *options.JWTOptions, redisOptions *options.RedisOptions) (*Server, error) {
+func NewServer(config *Config, jwtOptions *options.JWTOptions, redisOptions *options2.RedisOptions, lockoutOptions *options.LockoutOptions, captchaOptions *options.CaptchaOptions, signatureOptions *options.SignatureOptions, oidcOptions *options.OIDCOptions, ldapOptions *options.LDAPOptions, passwordPolicyOptions *options.PasswordPolicyOptions, passwordResetOptions *options.PasswordResetOptions, registrationOptions *options.RegistrationOptions, mailOptions *options.MailOptions) (*Server, error) {
 	db, err := ProvideDB(config)
 	if err != nil {
 		return nil, err
 	}
 	datastore := store.NewStore(db)
-	authenticator, err := NewAuthenticator(jwtOptions, redisOptions)
+	redisActiveKeyStore, err := auth.NewActiveKeyStore(redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	keySet, err := auth.NewKeySet(jwtOptions, redisActiveKeyStore)
+	if err != nil {
+		return nil, err
+	}
+	redisSessionStore, err := auth.NewSessionStore(redisOptions, jwtOptions)
+	if err != nil {
+		return nil, err
+	}
+	sessionRevoker := &SessionRevoker{
+		store:    datastore,
+		sessions: redisSessionStore,
+	}
+	authenticator, err := NewAuthenticator(jwtOptions, redisOptions, keySet, redisSessionStore, sessionRevoker)
 	if err != nil {
 		return nil, err
 	}
 	secretSetter := store.NewSecretSetter(datastore)
-	authnImpl, err := auth.NewAuthn(secretSetter)
+	authnImpl, err := auth.NewAuthn(secretSetter, keySet, redisSessionStore, jwtOptions, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	v := auth.AuthzOptions()
+	authzAuthz, err := authz.NewAuthz(db, v...)
+	if err != nil {
+		return nil, err
+	}
+	authzInterface, err := ProvideAuthz(authzAuthz, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	authAuth := auth.NewAuth(authnImpl, authzInterface)
+	redisLockout, err := lockout.New(lockoutOptions, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	redisCaptcha, err := captcha.New(captchaOptions, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	oidcImpl, err := oidc.New(oidcOptions, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	ldapImpl, err := ldap.New(ldapOptions)
+	if err != nil {
+		return nil, err
+	}
+	policy, err := pwdpolicy.New(passwordPolicyOptions)
+	if err != nil {
+		return nil, err
+	}
+	redisReset, err := pwdreset.New(passwordResetOptions, redisOptions)
+	if err != nil {
+		return nil, err
+	}
+	redisRegistration, err := registration.New(registrationOptions, redisOptions)
 	if err != nil {
 		return nil, err
 	}
-	authAuth := auth.NewAuth(authnImpl)
-	bizBiz := biz.NewBiz(datastore, authenticator, authAuth)
-	validator := validation.New(datastore)
+	mailer, err := mail.New(mailOptions)
+	if err != nil {
+		return nil, err
+	}
+	bizBiz := biz.NewBiz(datastore, authenticator, authAuth, redisLockout, redisCaptcha, oidcImpl, ldapImpl, redisSessionStore, policy, redisReset, redisRegistration, mailer)
+	validator := validation.New(datastore, policy, authzAuthz)
 	userRetriever := &UserRetriever{
 		store: datastore,
 	}
-	v := authz.DefaultOptions()
-	authzAuthz, err := authz.NewAuthz(db, v...)
+	signatureVerifier, err := auth.NewSignatureVerifier(signatureOptions, redisOptions, authnImpl)
 	if err != nil {
 		return nil, err
 	}
+	auditRecorder := &AuditRecorder{
+		store: datastore,
+	}
 	serverConfig := &ServerConfig{
 		Config:    config,
 		biz:       bizBiz,
 		val:       validator,
 		retriever: userRetriever,
+		authn:     authnImpl,
 		authz:     authzAuthz,
+		signature: signatureVerifier,
+		recorder:  auditRecorder,
 	}
 	server, err := NewWebServer(serverConfig, authenticator)
 	if err != nil {
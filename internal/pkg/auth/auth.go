@@ -2,13 +2,18 @@ package auth
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/wire"
 	"github.com/moweilong/milady/pkg/authn"
+
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
 )
 
 // ProviderSet is a Wire provider set that creates a new instance of auth.
-var ProviderSet = wire.NewSet(NewAuth, wire.Bind(new(AuthProvider), new(*auth)), AuthnProviderSet, AuthzProviderSet)
+// The AuthzInterface dependency is left to the caller, which shares the enforcer
+// of the authorization middleware through NewAuthzWithEnforcer.
+var ProviderSet = wire.NewSet(NewAuth, wire.Bind(new(AuthProvider), new(*auth)), AuthnProviderSet)
 
 // AuthProvider is an interface that combines both the AuthnInterface and AuthzInterface interfaces.
 type AuthProvider interface {
@@ -23,13 +28,13 @@ type auth struct {
 }
 
 // NewAuth is a constructor function that creates a new instance of auth struct.
-func NewAuth(authn AuthnInterface) *auth {
-	return &auth{authn: authn}
+func NewAuth(authn AuthnInterface, authz AuthzInterface) *auth {
+	return &auth{authn: authn, authz: authz}
 }
 
 // Verify is a method that implements Verify method of AuthnInterface.
-func (a *auth) Verify(accessToken string) (string, error) {
-	return a.authn.Verify(accessToken)
+func (a *auth) Verify(ctx context.Context, accessToken string) (string, error) {
+	return a.authn.Verify(ctx, accessToken)
 }
 
 // Sign is a method that implements Sign method of AuthnInterface.
@@ -37,7 +42,112 @@ func (a *auth) Sign(ctx context.Context, userID string) (authn.IToken, error) {
 	return a.authn.Sign(ctx, userID)
 }
 
+// SignImpersonation is a method that implements SignImpersonation method of AuthnInterface.
+func (a *auth) SignImpersonation(ctx context.Context, userID, actorID string) (authn.IToken, error) {
+	return a.authn.SignImpersonation(ctx, userID, actorID)
+}
+
+// JWKS is a method that implements JWKS method of AuthnInterface.
+func (a *auth) JWKS() []*v1.JSONWebKey {
+	return a.authn.JWKS()
+}
+
+// JWTKeys is a method that implements JWTKeys method of AuthnInterface.
+func (a *auth) JWTKeys() []*v1.JWTKey {
+	return a.authn.JWTKeys()
+}
+
+// PromoteJWTKey is a method that implements PromoteJWTKey method of AuthnInterface.
+func (a *auth) PromoteJWTKey(ctx context.Context, kid string) (*v1.JWTKey, error) {
+	return a.authn.PromoteJWTKey(ctx, kid)
+}
+
+// SignMFAChallenge is a method that implements SignMFAChallenge method of AuthnInterface.
+func (a *auth) SignMFAChallenge(userID string) (string, error) {
+	return a.authn.SignMFAChallenge(userID)
+}
+
+// VerifyMFAChallenge is a method that implements VerifyMFAChallenge method of AuthnInterface.
+func (a *auth) VerifyMFAChallenge(challenge string) (string, error) {
+	return a.authn.VerifyMFAChallenge(challenge)
+}
+
+// SignPasswordChallenge is a method that implements SignPasswordChallenge method of AuthnInterface.
+func (a *auth) SignPasswordChallenge(userID string) (string, error) {
+	return a.authn.SignPasswordChallenge(userID)
+}
+
+// VerifyPasswordChallenge is a method that implements VerifyPasswordChallenge method of AuthnInterface.
+func (a *auth) VerifyPasswordChallenge(challenge string) (string, error) {
+	return a.authn.VerifyPasswordChallenge(challenge)
+}
+
+// SignPasswordResetToken is a method that implements SignPasswordResetToken method of AuthnInterface.
+func (a *auth) SignPasswordResetToken(userID, tokenID string, expired time.Duration) (string, error) {
+	return a.authn.SignPasswordResetToken(userID, tokenID, expired)
+}
+
+// VerifyPasswordResetToken is a method that implements VerifyPasswordResetToken method of AuthnInterface.
+func (a *auth) VerifyPasswordResetToken(token string) (string, string, error) {
+	return a.authn.VerifyPasswordResetToken(token)
+}
+
+// InvalidateSecrets is a method that implements InvalidateSecrets method of AuthnInterface.
+func (a *auth) InvalidateSecrets(ctx context.Context, secretIDs ...string) error {
+	return a.authn.InvalidateSecrets(ctx, secretIDs...)
+}
+
 // Authorize is a method that implements Authorize method of AuthzInterface.
 func (a *auth) Authorize(rvals ...any) (bool, error) {
 	return a.authz.Authorize(rvals...)
 }
+
+// GetRolesForUser is a method that implements GetRolesForUser method of AuthzInterface.
+func (a *auth) GetRolesForUser(user string) ([]string, error) {
+	return a.authz.GetRolesForUser(user)
+}
+
+// AddRoleForUser is a method that implements AddRoleForUser method of AuthzInterface.
+func (a *auth) AddRoleForUser(user, role string) (bool, error) {
+	return a.authz.AddRoleForUser(user, role)
+}
+
+// DeleteRoleForUser is a method that implements DeleteRoleForUser method of AuthzInterface.
+func (a *auth) DeleteRoleForUser(user, role string) (bool, error) {
+	return a.authz.DeleteRoleForUser(user, role)
+}
+
+// GetUsersForRole is a method that implements GetUsersForRole method of AuthzInterface.
+func (a *auth) GetUsersForRole(role string) ([]string, error) {
+	return a.authz.GetUsersForRole(role)
+}
+
+// DeleteRole is a method that implements DeleteRole method of AuthzInterface.
+func (a *auth) DeleteRole(role string) (bool, error) {
+	return a.authz.DeleteRole(role)
+}
+
+// DeleteUser is a method that implements DeleteUser method of AuthzInterface.
+func (a *auth) DeleteUser(user string) (bool, error) {
+	return a.authz.DeleteUser(user)
+}
+
+// GetFilteredPolicy is a method that implements GetFilteredPolicy method of AuthzInterface.
+func (a *auth) GetFilteredPolicy(fieldIndex int, fieldValues ...string) ([][]string, error) {
+	return a.authz.GetFilteredPolicy(fieldIndex, fieldValues...)
+}
+
+// AddPolicies is a method that implements AddPolicies method of AuthzInterface.
+func (a *auth) AddPolicies(rules [][]string) (bool, error) {
+	return a.authz.AddPolicies(rules)
+}
+
+// RemovePolicies is a method that implements RemovePolicies method of AuthzInterface.
+func (a *auth) RemovePolicies(rules [][]string) (bool, error) {
+	return a.authz.RemovePolicies(rules)
+}
+
+// UpdatePolicy is a method that implements UpdatePolicy method of AuthzInterface.
+func (a *auth) UpdatePolicy(oldRule, newRule []string) (bool, error) {
+	return a.authz.UpdatePolicy(oldRule, newRule)
+}
@@ -7,14 +7,17 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/google/wire"
-	lru "github.com/hashicorp/golang-lru"
 	"github.com/moweilong/milady/pkg/authn"
 	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
 	"github.com/moweilong/milady/pkg/log"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	"gorm.io/gorm"
 
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
 	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
 )
 
@@ -24,22 +27,59 @@ const (
 )
 
 // AuthnProviderSet is authn providers.
-var AuthnProviderSet = wire.NewSet(NewAuthn, wire.Bind(new(AuthnInterface), new(*authnImpl)))
+var AuthnProviderSet = wire.NewSet(
+	NewAuthn,
+	wire.Bind(new(AuthnInterface), new(*authnImpl)),
+	wire.Bind(new(SecretGetter), new(*authnImpl)),
+	NewKeySet,
+	KeyStoreProviderSet,
+	SessionStoreProviderSet,
+)
 
 var (
 	// ErrMissingKID is returned when the token format is invalid and the kid field is missing in the token header.
 	ErrMissingKID = errors.Unauthorized(reasonUnauthorized, "Invalid token format: missing kid field in header")
 	// ErrSecretDisabled is returned when the SecretID is disabled.
 	ErrSecretDisabled = errors.Unauthorized(reasonUnauthorized, "SecretID is disabled")
+	// ErrSecretDeleted is returned when the SecretID, or the user owning it, has been deleted.
+	ErrSecretDeleted = errors.Unauthorized(reasonUnauthorized, "SecretID does not exist")
 )
 
 // AuthnInterface defines the interface for authentication.
 type AuthnInterface interface {
 	// Sign is used to generate a access token. userID is the jwt identity key.
 	Sign(ctx context.Context, userID string) (authn.IToken, error)
+	// SignImpersonation generates a short-lived access token of userID used by
+	// the administrator actorID. It carries the act claim and can not be refreshed.
+	SignImpersonation(ctx context.Context, userID, actorID string) (authn.IToken, error)
 	// Verify is used to verify a access token. If the verification
 	// is successful, userID will be returned.
-	Verify(accessToken string) (string, error)
+	Verify(ctx context.Context, accessToken string) (string, error)
+	// JWKS returns the public keys which can be used to verify the tokens
+	// without holding any shared secret.
+	JWKS() []*v1.JSONWebKey
+	// JWTKeys returns the configured signing keys and their states.
+	JWTKeys() []*v1.JWTKey
+	// PromoteJWTKey makes the key identified by kid the active signing key.
+	// If kid is empty, the key following the current active key is promoted.
+	PromoteJWTKey(ctx context.Context, kid string) (*v1.JWTKey, error)
+	// SignMFAChallenge signs a short-lived challenge token for a user who
+	// passed the password check but still has to provide a second factor.
+	SignMFAChallenge(userID string) (string, error)
+	// VerifyMFAChallenge verifies a challenge token and returns its userID.
+	VerifyMFAChallenge(challenge string) (string, error)
+	// SignPasswordChallenge signs a short-lived challenge token for a user who
+	// passed the password check but has to change the expired password.
+	SignPasswordChallenge(userID string) (string, error)
+	// VerifyPasswordChallenge verifies a password change token and returns its userID.
+	VerifyPasswordChallenge(challenge string) (string, error)
+	// SignPasswordResetToken signs a password reset token identified by tokenID.
+	SignPasswordResetToken(userID, tokenID string, expired time.Duration) (string, error)
+	// VerifyPasswordResetToken verifies a password reset token and returns its userID and tokenID.
+	VerifyPasswordResetToken(token string) (string, string, error)
+	// InvalidateSecrets evicts the secrets, which have been updated or deleted,
+	// from the secret cache of every replica.
+	InvalidateSecrets(ctx context.Context, secretIDs ...string) error
 }
 
 // SecretSetter is used to set or get a temporary secret key pairs.
@@ -50,55 +90,91 @@ type TemporarySecretSetter interface {
 
 type authnImpl struct {
 	setter  TemporarySecretSetter
-	secrets *lru.Cache
+	secrets *secretCache
+	// keys signs access tokens instead of the per-user secrets when its
+	// active key is asymmetric, so that they can be verified through the JWKS.
+	keys *KeySet
+	// sessions rejects the tokens of revoked login sessions.
+	sessions SessionStore
+	// expired is the lifetime of the access tokens.
+	expired time.Duration
 }
 
 // Ensure authnImpl implements AuthnInterface.
 var _ AuthnInterface = (*authnImpl)(nil)
 
 // NewAuthn returns a new instance of authn.
-func NewAuthn(setter TemporarySecretSetter) (*authnImpl, error) {
-	l, err := lru.New(known.DefaultLRUSize)
+func NewAuthn(setter TemporarySecretSetter, keys *KeySet, sessions SessionStore, jwtOpts *options.JWTOptions, redisOpts *genericoptions.RedisOptions) (*authnImpl, error) {
+	secrets, err := newSecretCache(redisOpts, known.SecretCacheTTL)
 	if err != nil {
-		log.Errorw(err, "Failed to create LRU cache")
+		log.Errorw(err, "Failed to create secret cache")
 		return nil, err
 	}
 
-	return &authnImpl{setter: setter, secrets: l}, nil
+	return &authnImpl{setter: setter, secrets: secrets, keys: keys, sessions: sessions, expired: jwtOpts.Expired}, nil
 }
 
 // Sign signs a new access token for the given userID.
 func (a *authnImpl) Sign(ctx context.Context, userID string) (authn.IToken, error) {
-	expires := time.Now().Add(known.AccessTokenExpire).Unix()
+	claims := newClaims(userID, TokenTypeAccess, a.expired)
+	claims.SessionID = contextx.SessionID(ctx)
+
+	return a.sign(ctx, claims)
+}
+
+// SignImpersonation signs an access token of userID with actorID in the act claim.
+func (a *authnImpl) SignImpersonation(ctx context.Context, userID, actorID string) (authn.IToken, error) {
+	claims := newClaims(userID, TokenTypeAccess, known.ImpersonationTokenExpire)
+	claims.SessionID = contextx.SessionID(ctx)
+	claims.Actor = &Actor{Subject: actorID}
 
-	secret, err := a.setter.Set(ctx, userID, expires)
+	return a.sign(ctx, claims)
+}
+
+// sign signs an access token with the active server key, or with a new secret
+// of the subject if the active key is symmetric.
+func (a *authnImpl) sign(ctx context.Context, claims *Claims) (authn.IToken, error) {
+	userID := claims.Subject
+	active := a.keys.Active()
+	if active == nil {
+		return nil, ErrNoUsableKey
+	}
+	if !active.Symmetric() {
+		accessToken, err := a.keys.Sign(claims)
+		if err != nil {
+			return nil, err
+		}
+
+		return &tokenInfo{Token: accessToken, Type: bearerTokenType, ExpiresAt: claims.ExpiresAt.Unix()}, nil
+	}
+
+	secret, err := a.setter.Set(ctx, userID, claims.ExpiresAt.Unix())
 	if err != nil {
 		return nil, err
 	}
-
-	opts := []jwtauthn.Option{
-		jwtauthn.WithSigningMethod(jwt.SigningMethodHS512),
-		jwtauthn.WithIssuer("art-design-pro-go"),
-		jwtauthn.WithTokenHeader(map[string]any{"kid": secret.SecretID}),
-		jwtauthn.WithExpired(known.AccessTokenExpire),
-		jwtauthn.WithSigningKey([]byte(secret.SecretKey)),
+	// The expiration of the temporary secret has been extended, other replicas must reload it.
+	if err := a.secrets.Invalidate(ctx, secret.SecretID); err != nil {
+		log.W(ctx).Errorw(err, "Failed to invalidate secret", "secretID", secret.SecretID)
 	}
 
-	j, err := jwtauthn.New(nil, opts...).Sign(ctx, userID)
+	token := jwt.NewWithClaims(jwt.SigningMethodHS512, claims)
+	token.Header["kid"] = secret.SecretID
+
+	accessToken, err := token.SignedString([]byte(secret.SecretKey))
 	if err != nil {
 		return nil, err
 	}
 
-	return j, nil
+	return &tokenInfo{Token: accessToken, Type: bearerTokenType, ExpiresAt: claims.ExpiresAt.Unix()}, nil
 }
 
 // Verify verifies the given access token and returns the userID associated with the token.
-func (a *authnImpl) Verify(accessToken string) (string, error) {
+func (a *authnImpl) Verify(ctx context.Context, accessToken string) (string, error) {
 	var secret *model.SecretM
-	token, err := jwt.ParseWithClaims(accessToken, &jwt.RegisteredClaims{}, func(token *jwt.Token) (any, error) {
-		// Validate the alg is HMAC signature
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return "", jwtauthn.ErrUnSupportSigningMethod
+	token, err := jwt.ParseWithClaims(accessToken, &Claims{}, func(token *jwt.Token) (any, error) {
+		// Refresh tokens must not be used to access resources.
+		if err := expectTokenType(token, TokenTypeAccess); err != nil {
+			return "", err
 		}
 
 		kid, ok := token.Header["kid"].(string)
@@ -106,9 +182,22 @@ func (a *authnImpl) Verify(accessToken string) (string, error) {
 			return "", ErrMissingKID
 		}
 
+		// Tokens signed by an asymmetric server key are verified with its public key.
+		if key, ok := a.keys.lookup(kid); ok && !key.Symmetric() {
+			return a.keys.Keyfunc(token)
+		}
+
+		// Validate the alg is HMAC signature
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return "", jwtauthn.ErrUnSupportSigningMethod
+		}
+
 		var err error
 		secret, err = a.GetSecret(kid)
 		if err != nil {
+			if v1.IsSecretNotFound(err) {
+				return "", ErrSecretDeleted
+			}
 			return "", err
 		}
 
@@ -126,6 +215,9 @@ func (a *authnImpl) Verify(accessToken string) (string, error) {
 		if ve.Errors&jwt.ValidationErrorMalformed != 0 {
 			return "", jwtauthn.ErrTokenInvalid
 		}
+		if err := unwrapKeyfuncError(ve); err != nil {
+			return "", err
+		}
 		if ve.Errors&(jwt.ValidationErrorExpired|jwt.ValidationErrorNotValidYet) != 0 {
 			return "", jwtauthn.ErrTokenExpired
 		}
@@ -136,6 +228,15 @@ func (a *authnImpl) Verify(accessToken string) (string, error) {
 		return "", jwtauthn.ErrTokenInvalid
 	}
 
+	if err := a.sessions.Check(ctx, token.Claims.(*Claims).SessionID); err != nil {
+		return "", err
+	}
+
+	// Tokens signed by a server key carry the user in the subject.
+	if secret == nil {
+		return token.Claims.(*Claims).Subject, nil
+	}
+
 	if keyExpired(secret.Expires) {
 		return "", jwtauthn.ErrTokenExpired
 	}
@@ -145,22 +246,62 @@ func (a *authnImpl) Verify(accessToken string) (string, error) {
 	return secret.UserID, nil
 }
 
+// JWKS returns the public keys used to sign access tokens.
+func (a *authnImpl) JWKS() []*v1.JSONWebKey {
+	return a.keys.JWKS()
+}
+
 // GetSecret returns the secret associated with the given key.
 func (a *authnImpl) GetSecret(key string) (*model.SecretM, error) {
-	s, ok := a.secrets.Get(key)
-	if ok {
-		return s.(*model.SecretM), nil
+	if secret, ok := a.secrets.Get(key); ok {
+		return secret, nil
 	}
 
 	secret, err := a.setter.Get(context.Background(), key)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, v1.ErrorSecretNotFound(err.Error())
+			return nil, v1.ErrorSecretNotFound("%s", err.Error())
 		}
 
 		return nil, err
 	}
 
-	a.secrets.Add(key, secret)
+	a.secrets.Add(secret)
 	return secret, nil
 }
+
+// InvalidateSecrets evicts the secrets from the secret cache of every replica.
+func (a *authnImpl) InvalidateSecrets(ctx context.Context, secretIDs ...string) error {
+	return a.secrets.Invalidate(ctx, secretIDs...)
+}
+
+// JWTKeys returns the configured signing keys and their states.
+func (a *authnImpl) JWTKeys() []*v1.JWTKey {
+	keys := a.keys.Keys()
+	ret := make([]*v1.JWTKey, 0, len(keys))
+	for _, key := range keys {
+		ret = append(ret, a.jwtKey(key))
+	}
+
+	return ret
+}
+
+// PromoteJWTKey makes the key identified by kid the active signing key.
+func (a *authnImpl) PromoteJWTKey(ctx context.Context, kid string) (*v1.JWTKey, error) {
+	key, err := a.keys.Promote(ctx, kid)
+	if err != nil {
+		return nil, err
+	}
+
+	return a.jwtKey(key), nil
+}
+
+// jwtKey converts a SigningKey to its API representation.
+func (a *authnImpl) jwtKey(key *SigningKey) *v1.JWTKey {
+	ret := &v1.JWTKey{Kid: key.ID, Alg: key.Method.Alg(), State: a.keys.State(key)}
+	if !key.NotAfter.IsZero() {
+		ret.NotAfter = timestamppb.New(key.NotAfter)
+	}
+
+	return ret
+}
@@ -4,19 +4,20 @@ import (
 	"time"
 
 	"github.com/casbin/casbin/v2"
-	clog "github.com/casbin/casbin/v2/log"
-	"github.com/casbin/casbin/v2/model"
-	gormadapter "github.com/casbin/gorm-adapter/v3"
+	"github.com/casbin/casbin/v2/persist"
 	rediswatcher "github.com/casbin/redis-watcher/v2"
-	"github.com/google/wire"
+	"github.com/moweilong/milady/pkg/authz"
 	"github.com/moweilong/milady/pkg/log"
 	genericoptions "github.com/moweilong/milady/pkg/options"
 	"github.com/redis/go-redis/v9"
-	"gorm.io/gorm"
 )
 
-const (
-	rbacModel = `[request_definition]
+// aclModel is the model of the enforcer shared by the authorization middleware and AuthzInterface.
+// The objects of the policies are route templates matched by keyMatch2, e.g. /v1/users/:userID
+// or /v1/orders/*. A segment named :owner holds the userID of the owner of the resource,
+// the policy does not apply to the owner, e.g. denying role::user PUT /v1/users/:owner
+// allows the users to modify themselves only.
+const aclModel = `[request_definition]
 r = sub, obj, act
 
 [policy_definition]
@@ -26,21 +27,34 @@ p = sub, obj, act, eft
 g = _, _
 
 [policy_effect]
-# The Effect primitive indicates that if there is no matching rule whose decision
-# result is deny, and the final decision result is allow, that is, deny-override
-# More effect syntax reference: https://casbin.org/docs/syntax-for-models#policy-effect
 e = !some(where (p.eft == deny))
 
 [matchers]
-m = g(r.sub, p.sub) && r.obj == p.obj && r.act == p.act || r.sub == "root"`
-)
-
-// AuthzProviderSet defines a wire set for authorization.
-var AuthzProviderSet = wire.NewSet(NewAuthz, wire.Bind(new(AuthzInterface), new(*authzImpl)), LoggerProviderSet)
+m = g(r.sub, p.sub) && keyMatch2(r.obj, p.obj) && r.act == p.act && keyGet2(r.obj, p.obj, "owner") != r.sub`
 
 // AuthzInterface defines the interface for authorization.
 type AuthzInterface interface {
 	Authorize(rvals ...any) (bool, error)
+	// GetRolesForUser returns the roles granted directly to user.
+	GetRolesForUser(user string) ([]string, error)
+	// AddRoleForUser grants role to user. It returns false if user already has role.
+	AddRoleForUser(user, role string) (bool, error)
+	// DeleteRoleForUser revokes role from user. It returns false if user does not have role.
+	DeleteRoleForUser(user, role string) (bool, error)
+	// GetUsersForRole returns the users granted role directly.
+	GetUsersForRole(role string) ([]string, error)
+	// DeleteRole revokes role from all users and removes the policies of role.
+	DeleteRole(role string) (bool, error)
+	// DeleteUser revokes all roles from user and removes the policies of user.
+	DeleteUser(user string) (bool, error)
+	// GetFilteredPolicy returns the p policies matching the field values from fieldIndex on.
+	GetFilteredPolicy(fieldIndex int, fieldValues ...string) ([][]string, error)
+	// AddPolicies adds the p policies. It returns false if any of them exists.
+	AddPolicies(rules [][]string) (bool, error)
+	// RemovePolicies removes the p policies. It returns false if any of them does not exist.
+	RemovePolicies(rules [][]string) (bool, error)
+	// UpdatePolicy replaces the p policy oldRule with newRule. It returns false if oldRule does not exist.
+	UpdatePolicy(oldRule, newRule []string) (bool, error)
 }
 
 type authzImpl struct {
@@ -50,68 +64,100 @@ type authzImpl struct {
 // Ensure authzImpl implements AuthzInterface.
 var _ AuthzInterface = (*authzImpl)(nil)
 
-// updateCallback defines the function to be called when a policy update is detected.
-func updateCallback(rev string) {
-	log.Warnw("New revision detected", "revision", rev)
+// AuthzOptions returns the options of the enforcer shared by the authorization middleware and AuthzInterface.
+func AuthzOptions() []authz.Option {
+	return []authz.Option{
+		authz.WithAclModel(aclModel),
+		authz.WithAutoLoadPolicyTime(10 * time.Second),
+	}
 }
 
-// NewAuthz creates a new authorization instance using the provided database, Redis options, and logger.
-func NewAuthz(db *gorm.DB, redisOpts *genericoptions.RedisOptions, logger clog.Logger) (*authzImpl, error) {
-	// Initialize a Gorm adapter and use it in a Casbin enforcer
-	adapter, err := gormadapter.NewAdapterByDB(db)
+// NewAuthzWithEnforcer wraps an existing casbin enforcer, so that the caller can share
+// one set of policies between the authorization middleware and AuthzInterface.
+// The policy changes are broadcast to the enforcers of the other replicas through Redis,
+// which apply them without reloading all policies.
+func NewAuthzWithEnforcer(enforcer *casbin.SyncedEnforcer, redisOpts *genericoptions.RedisOptions) (*authzImpl, error) {
+	w, err := newWatcher(redisOpts, true)
 	if err != nil {
-		log.Errorw(err, "Failed to initialize casbin adapter")
+		log.Errorw(err, "Failed to create casbin watcher")
+		return nil, err
+	}
+	if err := enforcer.SetWatcher(w); err != nil {
 		return nil, err
 	}
+	// The enforcer applied its own changes already, the other replicas apply them incrementally.
+	if err := w.SetUpdateCallback(rediswatcher.DefaultUpdateCallback(enforcer)); err != nil {
+		return nil, err
+	}
+
+	return &authzImpl{enforcer: enforcer}, nil
+}
 
-	// Initialize the watcher using Redis as a backend.
-	w, err := rediswatcher.NewWatcher(redisOpts.Addr, rediswatcher.WatcherOptions{
+// newWatcher creates a casbin watcher using Redis as a backend.
+// If ignoreSelf is true, the watcher skips the changes published by itself.
+func newWatcher(redisOpts *genericoptions.RedisOptions, ignoreSelf bool) (persist.Watcher, error) {
+	return rediswatcher.NewWatcher(redisOpts.Addr, rediswatcher.WatcherOptions{
 		Options: redis.Options{
 			DB:       redisOpts.Database,
 			Username: redisOpts.Username,
 			Password: redisOpts.Password,
 		},
-		Channel: "/casbin",
+		Channel:    "/casbin",
+		IgnoreSelf: ignoreSelf,
 	})
-	if err != nil {
-		log.Errorw(err, "Failed to create casbin watcher")
-		return nil, err
-	}
+}
 
-	m, _ := model.NewModelFromString(rbacModel)
+// Authorize checks whether the given request values satisfy the authorization policy.
+func (a *authzImpl) Authorize(rvals ...any) (bool, error) {
+	return a.enforcer.Enforce(rvals...)
+}
 
-	// Initialize the enforcer.
-	enforcer, err := casbin.NewSyncedEnforcer(m, adapter)
-	if err != nil {
-		log.Errorw(err, "Failed to create casbin enforcer")
-		return nil, err
-	}
+// GetRolesForUser returns the roles granted directly to user.
+func (a *authzImpl) GetRolesForUser(user string) ([]string, error) {
+	return a.enforcer.GetRolesForUser(user)
+}
 
-	// Set the watcher for the enforcer.
-	_ = enforcer.SetWatcher(w)
-	// Change current casbin's logger to kafka logger
-	enforcer.SetLogger(logger)
-	// Enable casbin log messages to the kafka logger.
-	enforcer.EnableLog(true)
-
-	// By default, the watcher's callback is automatically set to the
-	// enforcer's LoadPolicy() in the SetWatcher() call.
-	// We can change it by explicitly setting a callback.
-	_ = w.SetUpdateCallback(updateCallback)
-
-	// Load the policy from DB.
-	if err := enforcer.LoadPolicy(); err != nil {
-		log.Errorw(err, "Failed to load casbin policy")
-		return nil, err
-	}
-	// Start auto-loading the policy every minute.
-	enforcer.StartAutoLoadPolicy(time.Minute)
+// AddRoleForUser grants role to user.
+func (a *authzImpl) AddRoleForUser(user, role string) (bool, error) {
+	return a.enforcer.AddRoleForUser(user, role)
+}
 
-	// Create a new authzImpl instance.
-	return &authzImpl{enforcer: enforcer}, nil
+// DeleteRoleForUser revokes role from user.
+func (a *authzImpl) DeleteRoleForUser(user, role string) (bool, error) {
+	return a.enforcer.DeleteRoleForUser(user, role)
 }
 
-// Authorize checks whether the given request values satisfy the authorization policy.
-func (a *authzImpl) Authorize(rvals ...any) (bool, error) {
-	return a.enforcer.Enforce(rvals...)
+// GetUsersForRole returns the users granted role directly.
+func (a *authzImpl) GetUsersForRole(role string) ([]string, error) {
+	return a.enforcer.GetUsersForRole(role)
+}
+
+// DeleteRole revokes role from all users and removes the policies of role.
+func (a *authzImpl) DeleteRole(role string) (bool, error) {
+	return a.enforcer.DeleteRole(role)
+}
+
+// DeleteUser revokes all roles from user and removes the policies of user.
+func (a *authzImpl) DeleteUser(user string) (bool, error) {
+	return a.enforcer.DeleteUser(user)
+}
+
+// GetFilteredPolicy returns the p policies matching the field values from fieldIndex on.
+func (a *authzImpl) GetFilteredPolicy(fieldIndex int, fieldValues ...string) ([][]string, error) {
+	return a.enforcer.GetFilteredPolicy(fieldIndex, fieldValues...)
+}
+
+// AddPolicies adds the p policies.
+func (a *authzImpl) AddPolicies(rules [][]string) (bool, error) {
+	return a.enforcer.AddPolicies(rules)
+}
+
+// RemovePolicies removes the p policies.
+func (a *authzImpl) RemovePolicies(rules [][]string) (bool, error) {
+	return a.enforcer.RemovePolicies(rules)
+}
+
+// UpdatePolicy replaces the p policy oldRule with newRule.
+func (a *authzImpl) UpdatePolicy(oldRule, newRule []string) (bool, error) {
+	return a.enforcer.UpdatePolicy(oldRule, newRule)
 }
@@ -0,0 +1,107 @@
+package auth
+
+import (
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v4"
+	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+)
+
+// SignMFAChallenge signs a challenge token for userID with the active server key.
+func (a *authnImpl) SignMFAChallenge(userID string) (string, error) {
+	return a.signChallenge(newClaims(userID, TokenTypeMFA, known.MFAChallengeExpire))
+}
+
+// VerifyMFAChallenge verifies the signature, lifetime and type of the given
+// challenge token and returns the userID it was issued for.
+func (a *authnImpl) VerifyMFAChallenge(challenge string) (string, error) {
+	// Only challenge tokens can be exchanged for tokens with a second factor.
+	claims, err := a.verifyChallenge(challenge, TokenTypeMFA)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Subject, nil
+}
+
+// SignPasswordChallenge signs a password change token for userID with the active server key.
+func (a *authnImpl) SignPasswordChallenge(userID string) (string, error) {
+	return a.signChallenge(newClaims(userID, TokenTypePasswordChange, known.PasswordChallengeExpire))
+}
+
+// VerifyPasswordChallenge verifies the signature, lifetime and type of the given
+// password change token and returns the userID it was issued for.
+func (a *authnImpl) VerifyPasswordChallenge(challenge string) (string, error) {
+	claims, err := a.verifyChallenge(challenge, TokenTypePasswordChange)
+	if err != nil {
+		return "", err
+	}
+
+	return claims.Subject, nil
+}
+
+// SignPasswordResetToken signs a password reset token for userID with the
+// active server key. tokenID is the jti claim, which makes the token single-use.
+func (a *authnImpl) SignPasswordResetToken(userID, tokenID string, expired time.Duration) (string, error) {
+	claims := newClaims(userID, TokenTypePasswordReset, expired)
+	claims.ID = tokenID
+
+	return a.signChallenge(claims)
+}
+
+// VerifyPasswordResetToken verifies the signature, lifetime and type of the
+// given password reset token and returns the userID and the tokenID it was issued for.
+func (a *authnImpl) VerifyPasswordResetToken(token string) (string, string, error) {
+	claims, err := a.verifyChallenge(token, TokenTypePasswordReset)
+	if err != nil {
+		return "", "", err
+	}
+
+	return claims.Subject, claims.ID, nil
+}
+
+// signChallenge signs a challenge token, which can not be used to access API resources.
+func (a *authnImpl) signChallenge(claims *Claims) (string, error) {
+	challenge, err := a.keys.Sign(claims)
+	if err != nil {
+		return "", jwtauthn.ErrSignTokenFailed
+	}
+
+	return challenge, nil
+}
+
+// verifyChallenge verifies a challenge token of the given type and returns its claims.
+func (a *authnImpl) verifyChallenge(challenge string, tokenType TokenType) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(challenge, &Claims{}, func(token *jwt.Token) (any, error) {
+		if err := expectTokenType(token, tokenType); err != nil {
+			return nil, err
+		}
+
+		return a.keys.Keyfunc(token)
+	})
+	if err != nil {
+		ve, ok := err.(*jwt.ValidationError)
+		if !ok {
+			return nil, errors.Unauthorized(reasonUnauthorized, err.Error())
+		}
+		if ve.Errors&jwt.ValidationErrorMalformed != 0 {
+			return nil, jwtauthn.ErrTokenInvalid
+		}
+		if err := unwrapKeyfuncError(ve); err != nil {
+			return nil, err
+		}
+		if ve.Errors&(jwt.ValidationErrorExpired|jwt.ValidationErrorNotValidYet) != 0 {
+			return nil, jwtauthn.ErrTokenExpired
+		}
+		return nil, jwtauthn.ErrTokenParseFail
+	}
+
+	if !token.Valid {
+		return nil, jwtauthn.ErrTokenInvalid
+	}
+
+	return token.Claims.(*Claims), nil
+}
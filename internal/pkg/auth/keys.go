@@ -0,0 +1,430 @@
+package auth
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v4"
+	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// keySyncInterval is how often the active key is read back from the ActiveKeyStore.
+const keySyncInterval = 10 * time.Second
+
+// KeyStateRetired marks a key whose not-after date has passed.
+const KeyStateRetired = "retired"
+
+var (
+	// ErrUnknownKID is returned when no key matches the kid field in the token header.
+	ErrUnknownKID = errors.Unauthorized(reasonUnauthorized, "Invalid token: unknown kid")
+	// ErrKeyRetired is returned when the key matching the kid field has passed its not-after date.
+	ErrKeyRetired = errors.Unauthorized(reasonUnauthorized, "Invalid token: the signing key has retired")
+	// ErrKeyNotFound is returned when promoting a kid which is not configured.
+	ErrKeyNotFound = errors.NotFound("JWTKeyNotFound", "JWT key not found")
+	// ErrKeyNotPromotable is returned when promoting a retired key, or when there is no other usable key to promote.
+	ErrKeyNotPromotable = errors.BadRequest("JWTKeyNotPromotable", "There is no usable key to promote")
+	// ErrNoUsableKey is returned when signing while every configured key has retired.
+	ErrNoUsableKey = errors.InternalServer("JWTKeyUnavailable", "There is no usable key to sign tokens")
+)
+
+// SigningKey is a key that tokens are signed and verified with.
+type SigningKey struct {
+	// ID is the `kid` header of the tokens signed by the key.
+	ID string
+	// Method is the signing method of the key.
+	Method jwt.SigningMethod
+	// NotAfter is the time after which the key retires, the zero value means never.
+	NotAfter time.Time
+
+	// signKey is []byte for HMAC, or the private key otherwise.
+	signKey any
+	// verifyKey is []byte for HMAC, or the public key otherwise.
+	verifyKey any
+}
+
+// NewHMACKey creates a SigningKey from a shared secret.
+func NewHMACKey(kid string, method string, secret []byte) *SigningKey {
+	var m jwt.SigningMethod
+	switch method {
+	case "HS256":
+		m = jwt.SigningMethodHS256
+	case "HS384":
+		m = jwt.SigningMethodHS384
+	default:
+		m = jwt.SigningMethodHS512
+	}
+
+	return &SigningKey{ID: kid, Method: m, signKey: secret, verifyKey: secret}
+}
+
+// LoadPrivateKey reads a PEM encoded private key for RS256, ES256 or EdDSA from file.
+func LoadPrivateKey(kid string, method string, file string) (*SigningKey, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &SigningKey{ID: kid}
+	switch method {
+	case "RS256":
+		priv, err := jwt.ParseRSAPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		key.Method, key.signKey, key.verifyKey = jwt.SigningMethodRS256, priv, &priv.PublicKey
+	case "ES256":
+		priv, err := jwt.ParseECPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		if priv.Curve != elliptic.P256() {
+			return nil, fmt.Errorf("ES256 requires a P-256 key, got %s", priv.Curve.Params().Name)
+		}
+		key.Method, key.signKey, key.verifyKey = jwt.SigningMethodES256, priv, &priv.PublicKey
+	case "EdDSA":
+		priv, err := jwt.ParseEdPrivateKeyFromPEM(data)
+		if err != nil {
+			return nil, err
+		}
+		edPriv, ok := priv.(ed25519.PrivateKey)
+		if !ok {
+			return nil, fmt.Errorf("EdDSA requires an Ed25519 key")
+		}
+		key.Method, key.signKey, key.verifyKey = jwt.SigningMethodEdDSA, edPriv, edPriv.Public()
+	default:
+		return nil, fmt.Errorf("unsupported asymmetric signing method %q", method)
+	}
+
+	return key, nil
+}
+
+// Retired reports whether the key has passed its not-after date at now.
+func (k *SigningKey) Retired(now time.Time) bool {
+	return !k.NotAfter.IsZero() && now.After(k.NotAfter)
+}
+
+// Symmetric reports whether the key is a shared secret, which must never be published.
+func (k *SigningKey) Symmetric() bool {
+	_, ok := k.verifyKey.([]byte)
+	return ok
+}
+
+// JWK returns the public part of the key in the JSON Web Key format.
+// It returns nil for symmetric keys.
+func (k *SigningKey) JWK() *v1.JSONWebKey {
+	jwk := &v1.JSONWebKey{Kid: k.ID, Use: "sig", Alg: k.Method.Alg()}
+	switch pub := k.verifyKey.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = encodeSegment(pub.N.Bytes())
+		jwk.E = encodeSegment(big.NewInt(int64(pub.E)).Bytes())
+	case *ecdsa.PublicKey:
+		// The uncompressed form is 0x04 || X || Y, with X and Y of the same size.
+		b, err := pub.Bytes()
+		if err != nil {
+			return nil
+		}
+		size := (len(b) - 1) / 2
+		jwk.Kty = "EC"
+		jwk.Crv = pub.Curve.Params().Name
+		jwk.X = encodeSegment(b[1 : 1+size])
+		jwk.Y = encodeSegment(b[1+size:])
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = encodeSegment(pub)
+	default:
+		return nil
+	}
+
+	return jwk
+}
+
+// KeySet holds the keys known to the server. New tokens are signed with the
+// active key, and tokens are verified with the key matching their kid until
+// that key retires. The active key can be changed at runtime with Promote,
+// and is shared between replicas through the ActiveKeyStore.
+//
+// A promotion is recorded together with the configured active key. Once the
+// configured active key changes, e.g. after a deployment with a new jwt.key-id,
+// promotions recorded by earlier deployments are ignored.
+type KeySet struct {
+	mu     sync.RWMutex
+	active *SigningKey
+	// configured is the active key of the configuration.
+	configured *SigningKey
+	// ordered keeps the configuration order, which defines the next key to promote.
+	ordered []*SigningKey
+	keys    map[string]*SigningKey
+
+	store    ActiveKeyStore
+	syncedAt time.Time
+}
+
+// NewKeySet creates the KeySet described by the JWT options.
+func NewKeySet(opts *options.JWTOptions, store ActiveKeyStore) (*KeySet, error) {
+	if len(opts.Keys) == 0 {
+		key, err := newSigningKey(opts.KeyID, opts.SigningMethod, opts.Key, opts.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewKeySetFromKeys(store, key), nil
+	}
+
+	var active *SigningKey
+	others := make([]*SigningKey, 0, len(opts.Keys))
+	for _, ko := range opts.Keys {
+		key, err := newSigningKey(ko.ID, ko.SigningMethod, ko.Secret, ko.PrivateKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		if key.NotAfter, err = ko.Expiry(); err != nil {
+			return nil, err
+		}
+
+		if ko.State == options.KeyStateActive {
+			active = key
+			continue
+		}
+		others = append(others, key)
+	}
+	if active == nil {
+		return nil, fmt.Errorf("no active jwt key")
+	}
+
+	// Keep the configuration order for Promote.
+	ks := NewKeySetFromKeys(store, active, others...)
+	ks.ordered = ks.ordered[:0]
+	for _, ko := range opts.Keys {
+		ks.ordered = append(ks.ordered, ks.keys[ko.ID])
+	}
+
+	return ks, nil
+}
+
+// newSigningKey creates a SigningKey from either a shared secret or a private key file.
+func newSigningKey(kid, method, secret, privateKeyFile string) (*SigningKey, error) {
+	switch method {
+	case "RS256", "ES256", "EdDSA":
+		key, err := LoadPrivateKey(kid, method, privateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load jwt private key %q: %w", kid, err)
+		}
+		return key, nil
+	default:
+		return NewHMACKey(kid, method, []byte(secret)), nil
+	}
+}
+
+// NewKeySetFromKeys creates a KeySet that signs with active and also verifies with others.
+// The store may be nil, in which case the active key is never shared with other replicas.
+func NewKeySetFromKeys(store ActiveKeyStore, active *SigningKey, others ...*SigningKey) *KeySet {
+	ks := &KeySet{
+		active:     active,
+		configured: active,
+		ordered:    append([]*SigningKey{active}, others...),
+		keys:       map[string]*SigningKey{active.ID: active},
+		store:      store,
+	}
+	for _, key := range others {
+		ks.keys[key.ID] = key
+	}
+
+	return ks
+}
+
+// Active returns the key that new tokens are signed with. Once the active key
+// has retired, the first usable key following it in configuration order is
+// used instead. It returns nil if every key has retired.
+func (ks *KeySet) Active() *SigningKey {
+	ks.sync(context.Background())
+
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return ks.usable(time.Now())
+}
+
+// usable returns the active key, or the first key following it which has not retired at now.
+func (ks *KeySet) usable(now time.Time) *SigningKey {
+	i := slices.Index(ks.ordered, ks.active)
+	for j := range len(ks.ordered) {
+		if key := ks.ordered[(i+j)%len(ks.ordered)]; !key.Retired(now) {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// sync picks up a key promoted by another replica. It runs at most once per
+// keySyncInterval, errors are only logged since the local active key is still usable.
+// Promotions made from another configured active key are stale and ignored.
+func (ks *KeySet) sync(ctx context.Context) {
+	if ks.store == nil {
+		return
+	}
+
+	ks.mu.RLock()
+	fresh := time.Since(ks.syncedAt) < keySyncInterval
+	ks.mu.RUnlock()
+	if fresh {
+		return
+	}
+
+	kid, configured, err := ks.store.Get(ctx)
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+	ks.syncedAt = time.Now()
+	if err != nil {
+		log.Errorw(err, "Failed to get the active jwt key")
+		return
+	}
+	if configured != ks.configured.ID {
+		return
+	}
+	if key, ok := ks.keys[kid]; ok && !key.Retired(time.Now()) {
+		ks.active = key
+	}
+}
+
+// Promote makes the key identified by kid the active key. If kid is empty,
+// the first usable key following the active one in configuration order is promoted.
+// The previous active key keeps verifying tokens until it retires.
+func (ks *KeySet) Promote(ctx context.Context, kid string) (*SigningKey, error) {
+	ks.sync(ctx)
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	now := time.Now()
+	var next *SigningKey
+	if kid != "" {
+		key, ok := ks.keys[kid]
+		if !ok {
+			return nil, ErrKeyNotFound
+		}
+		next = key
+	} else {
+		i := slices.Index(ks.ordered, ks.active)
+		for j := 1; j < len(ks.ordered); j++ {
+			if key := ks.ordered[(i+j)%len(ks.ordered)]; !key.Retired(now) {
+				next = key
+				break
+			}
+		}
+	}
+	if next == nil || next.Retired(now) {
+		return nil, ErrKeyNotPromotable
+	}
+
+	if ks.store != nil {
+		if err := ks.store.Set(ctx, next.ID, ks.configured.ID); err != nil {
+			return nil, err
+		}
+		ks.syncedAt = now
+	}
+	ks.active = next
+
+	return next, nil
+}
+
+// Keys returns all keys in configuration order.
+func (ks *KeySet) Keys() []*SigningKey {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	return slices.Clone(ks.ordered)
+}
+
+// State returns the state of the key: active, verify-only or retired.
+func (ks *KeySet) State(key *SigningKey) string {
+	switch {
+	case key == ks.Active():
+		return options.KeyStateActive
+	case key.Retired(time.Now()):
+		return KeyStateRetired
+	default:
+		return options.KeyStateVerifyOnly
+	}
+}
+
+// Sign signs the claims with the active key.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	active := ks.Active()
+	if active == nil {
+		return "", ErrNoUsableKey
+	}
+
+	token := jwt.NewWithClaims(active.Method, claims)
+	if active.ID != "" {
+		token.Header["kid"] = active.ID
+	}
+
+	return token.SignedString(active.signKey)
+}
+
+// lookup returns the key identified by kid.
+func (ks *KeySet) lookup(kid string) (*SigningKey, bool) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+	key, ok := ks.keys[kid]
+	return key, ok
+}
+
+// Keyfunc is a jwt.Keyfunc which selects the verification key by the kid field in the token header.
+func (ks *KeySet) Keyfunc(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := ks.lookup(kid)
+	if !ok {
+		return nil, ErrUnknownKID
+	}
+
+	if key.Retired(time.Now()) {
+		return nil, ErrKeyRetired
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, jwtauthn.ErrUnSupportSigningMethod
+	}
+
+	return key.verifyKey, nil
+}
+
+// JWKS returns the public keys that are not retired yet. Symmetric keys are never included.
+func (ks *KeySet) JWKS() []*v1.JSONWebKey {
+	now := time.Now()
+	jwks := make([]*v1.JSONWebKey, 0, len(ks.ordered))
+	for _, key := range ks.Keys() {
+		if key.Symmetric() || key.Retired(now) {
+			continue
+		}
+		if jwk := key.JWK(); jwk != nil {
+			jwks = append(jwks, jwk)
+		}
+	}
+	slices.SortFunc(jwks, func(a, b *v1.JSONWebKey) int { return strings.Compare(a.Kid, b.Kid) })
+
+	return jwks
+}
+
+// encodeSegment encodes bytes with the base64url encoding without padding used by JWK.
+func encodeSegment(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,58 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+)
+
+// activeKeyRedisKey is the Redis hash holding the kid of the promoted JWT key,
+// together with the kid of the configured active key it was promoted from.
+const activeKeyRedisKey = "authn_active_key"
+
+// KeyStoreProviderSet is the wire provider set of the ActiveKeyStore.
+var KeyStoreProviderSet = wire.NewSet(NewActiveKeyStore, wire.Bind(new(ActiveKeyStore), new(*redisActiveKeyStore)))
+
+// ActiveKeyStore persists the kid of the active key, so that all replicas sign new tokens with the same key.
+type ActiveKeyStore interface {
+	// Get returns the kid of the promoted key and the kid of the configured active
+	// key it was promoted from, or empty strings if no key has been promoted.
+	Get(ctx context.Context) (kid string, configured string, err error)
+	// Set records kid as the active key, promoted from the configured active key.
+	Set(ctx context.Context, kid string, configured string) error
+}
+
+// redisActiveKeyStore is a Redis backed ActiveKeyStore.
+type redisActiveKeyStore struct {
+	cli *redis.Client
+}
+
+// Ensure redisActiveKeyStore implements ActiveKeyStore.
+var _ ActiveKeyStore = (*redisActiveKeyStore)(nil)
+
+// NewActiveKeyStore creates a Redis backed ActiveKeyStore.
+func NewActiveKeyStore(redisOpts *genericoptions.RedisOptions) (*redisActiveKeyStore, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisActiveKeyStore{cli: cli}, nil
+}
+
+// Get returns the kid of the promoted key and the kid of the configured active key.
+func (s *redisActiveKeyStore) Get(ctx context.Context) (string, string, error) {
+	fields, err := s.cli.HGetAll(ctx, activeKeyRedisKey).Result()
+	if err != nil {
+		return "", "", err
+	}
+
+	return fields["kid"], fields["configured"], nil
+}
+
+// Set records kid as the active key, promoted from the configured active key.
+func (s *redisActiveKeyStore) Set(ctx context.Context, kid string, configured string) error {
+	return s.cli.HSet(ctx, activeKeyRedisKey, "kid", kid, "configured", configured).Err()
+}
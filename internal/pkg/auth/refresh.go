@@ -0,0 +1,190 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/moweilong/milady/pkg/authn"
+	jwtauthn "github.com/moweilong/milady/pkg/authn/jwt"
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// refreshReuseGrace is how long a rotated refresh token can still be used.
+const refreshReuseGrace = 10 * time.Second
+
+// refreshAuthn signs and verifies refresh tokens. Destroyed refresh tokens are
+// kept in the store until they expire, so that they can not be used again.
+//
+// The refresh tokens of a session form a family: each refresh destroys the
+// presented token and issues its successor, until maxRefresh has passed since
+// the login. A destroyed token which is presented again has been copied by
+// someone else than its legitimate holder, which already went on with its
+// successor, so the whole session is revoked.
+//
+// Clients refreshing concurrently, e.g. from two browser tabs, present the same
+// token shortly after each other. A destroyed token is therefore still accepted
+// once within refreshReuseGrace after it was first destroyed. Any further use
+// revokes the session, so that a stolen token replayed quickly is detected too.
+type refreshAuthn struct {
+	store      jwtauthn.Storer
+	keys       *KeySet
+	sessions   SessionStore
+	revoker    SessionRevoker
+	expired    time.Duration
+	maxRefresh time.Duration
+}
+
+// Ensure refreshAuthn implements authn.Authenticator.
+var _ authn.Authenticator = (*refreshAuthn)(nil)
+
+// NewRefreshAuthn returns an authn.Authenticator which only accepts refresh tokens.
+// Refresh tokens live for expired, but never beyond maxRefresh after the login.
+func NewRefreshAuthn(store jwtauthn.Storer, keys *KeySet, sessions SessionStore, revoker SessionRevoker, expired, maxRefresh time.Duration) *refreshAuthn {
+	return &refreshAuthn{store: store, keys: keys, sessions: sessions, revoker: revoker, expired: expired, maxRefresh: maxRefresh}
+}
+
+// Sign signs a new refresh token for the given userID. When rotating the
+// refresh token in ctx, the new token inherits the login time of the session.
+func (a *refreshAuthn) Sign(ctx context.Context, userID string) (authn.IToken, error) {
+	claims := newClaims(userID, TokenTypeRefresh, a.expired)
+	claims.SessionID = contextx.SessionID(ctx)
+	claims.AuthTime = claims.IssuedAt
+	if previous, err := ParseUnverified(contextx.RefreshToken(ctx)); err == nil {
+		claims.AuthTime = jwt.NewNumericDate(previous.authTime())
+	}
+	if end := claims.AuthTime.Add(a.maxRefresh); claims.ExpiresAt.After(end) {
+		claims.ExpiresAt = jwt.NewNumericDate(end)
+	}
+
+	refreshToken, err := a.keys.Sign(claims)
+	if err != nil {
+		return nil, jwtauthn.ErrSignTokenFailed
+	}
+
+	return &tokenInfo{Token: refreshToken, Type: bearerTokenType, ExpiresAt: claims.ExpiresAt.Unix()}, nil
+}
+
+// Destroy revokes the given refresh token. Only the first destruction opens the
+// grace window, so that using the token within it does not extend the window.
+func (a *refreshAuthn) Destroy(ctx context.Context, refreshToken string) error {
+	claims, err := a.parse(refreshToken)
+	if err != nil {
+		return err
+	}
+
+	revoked, err := a.store.Check(ctx, refreshToken)
+	if err != nil {
+		return err
+	}
+	expiration := time.Until(claims.ExpiresAt.Time)
+	if !revoked {
+		if err := a.store.Set(ctx, graceKey(refreshToken), min(refreshReuseGrace, expiration)); err != nil {
+			return err
+		}
+	}
+
+	return a.store.Set(ctx, refreshToken, expiration)
+}
+
+// ParseClaims verifies the given refresh token and returns its claims.
+func (a *refreshAuthn) ParseClaims(ctx context.Context, refreshToken string) (*jwt.RegisteredClaims, error) {
+	if refreshToken == "" {
+		return nil, jwtauthn.ErrTokenInvalid
+	}
+
+	claims, err := a.parse(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tokens of revoked sessions, e.g. after logging out, are not reused but just invalid.
+	if err := a.sessions.Check(ctx, claims.SessionID); err != nil {
+		return nil, err
+	}
+
+	revoked, err := a.store.Check(ctx, refreshToken)
+	if err != nil {
+		return nil, err
+	}
+	if revoked {
+		// Refresh tokens issued before sessions were introduced have no family to revoke.
+		if claims.SessionID == "" {
+			return nil, jwtauthn.ErrTokenInvalid
+		}
+		// A concurrent refresh rotates the token again, the session is kept. Deleting
+		// the grace window atomically lets only one concurrent refresh through.
+		concurrent, err := a.store.Delete(ctx, graceKey(refreshToken))
+		if err != nil {
+			return nil, err
+		}
+		if concurrent {
+			return &claims.RegisteredClaims, nil
+		}
+		return nil, a.revokeFamily(ctx, claims)
+	}
+
+	return &claims.RegisteredClaims, nil
+}
+
+// revokeFamily revokes the session of a refresh token which was presented again
+// after it had been rotated, and returns the error reported to the client.
+func (a *refreshAuthn) revokeFamily(ctx context.Context, claims *Claims) error {
+	log.W(ctx).Warnw("Rotated refresh token reused, revoking its session", "userID", claims.Subject, "sessionID", claims.SessionID)
+
+	// No token of the session outlives its maximum lifetime.
+	if err := a.revoker.RevokeSession(ctx, claims.SessionID, claims.authTime().Add(a.maxRefresh)); err != nil {
+		log.W(ctx).Errorw(err, "Failed to revoke session of reused refresh token", "sessionID", claims.SessionID)
+		return err
+	}
+
+	return v1.ErrorRefreshTokenReused("refresh token of session %s has already been used", claims.SessionID)
+}
+
+// Release releases the underlying token store.
+func (a *refreshAuthn) Release() error {
+	return a.store.Close()
+}
+
+// parse verifies the signature, lifetime and type of the given refresh token.
+func (a *refreshAuthn) parse(refreshToken string) (*Claims, error) {
+	token, err := jwt.ParseWithClaims(refreshToken, &Claims{}, func(token *jwt.Token) (any, error) {
+		// Access tokens must not be used to obtain new tokens.
+		if err := expectTokenType(token, TokenTypeRefresh); err != nil {
+			return nil, err
+		}
+
+		return a.keys.Keyfunc(token)
+	})
+	if err != nil {
+		ve, ok := err.(*jwt.ValidationError)
+		if !ok {
+			return nil, errors.Unauthorized(reasonUnauthorized, err.Error())
+		}
+		if ve.Errors&jwt.ValidationErrorMalformed != 0 {
+			return nil, jwtauthn.ErrTokenInvalid
+		}
+		if err := unwrapKeyfuncError(ve); err != nil {
+			return nil, err
+		}
+		if ve.Errors&(jwt.ValidationErrorExpired|jwt.ValidationErrorNotValidYet) != 0 {
+			return nil, jwtauthn.ErrTokenExpired
+		}
+		return nil, jwtauthn.ErrTokenParseFail
+	}
+
+	if !token.Valid {
+		return nil, jwtauthn.ErrTokenInvalid
+	}
+
+	return token.Claims.(*Claims), nil
+}
+
+// graceKey returns the key marking the grace window of a destroyed refresh token.
+func graceKey(refreshToken string) string {
+	return "grace_" + refreshToken
+}
@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/moweilong/milady/pkg/log"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+)
+
+// secretChannel is the Redis channel the IDs of updated and deleted secrets are published on.
+const secretChannel = "/secrets"
+
+var (
+	secretCacheMetricsOnce sync.Once
+	// secretCacheRequests counts the lookups of the secret cache, by result.
+	secretCacheRequests metric.Int64Counter
+	// secretCacheInvalidations counts the secrets evicted because they changed.
+	secretCacheInvalidations metric.Int64Counter
+)
+
+// secretCacheEntry is a cached secret and the time it must be reloaded at.
+type secretCacheEntry struct {
+	secret    *model.SecretM
+	expiresAt time.Time
+}
+
+// secretCache caches the secrets used to verify access tokens and signed requests.
+// A secret which is updated or deleted on any replica is evicted from all of
+// them through Redis pub/sub. Entries also expire after a TTL, which bounds how
+// long a replica keeps a stale secret if an invalidation is lost.
+type secretCache struct {
+	entries *lru.Cache
+	ttl     time.Duration
+	cli     *redis.Client
+}
+
+// newSecretCache creates a secretCache and subscribes it to the invalidations of other replicas.
+func newSecretCache(redisOpts *genericoptions.RedisOptions, ttl time.Duration) (*secretCache, error) {
+	entries, err := lru.New(known.DefaultLRUSize)
+	if err != nil {
+		return nil, err
+	}
+
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the subscription to be confirmed, so that no invalidation
+	// published after the cache is created is missed.
+	ctx := context.Background()
+	sub := cli.Subscribe(ctx, secretChannel)
+	if _, err := sub.Receive(ctx); err != nil {
+		_ = sub.Close()
+		return nil, err
+	}
+
+	secretCacheMetricsOnce.Do(func() {
+		meter := otel.Meter("art-apiserver.auth")
+		secretCacheRequests, _ = meter.Int64Counter("art_design_pro_go_apiserver_secret_cache_requests_total",
+			metric.WithDescription("Total number of secret cache lookups, by result"))
+		secretCacheInvalidations, _ = meter.Int64Counter("art_design_pro_go_apiserver_secret_cache_invalidations_total",
+			metric.WithDescription("Total number of secrets evicted from the secret cache because they changed"))
+	})
+
+	c := &secretCache{entries: entries, ttl: ttl, cli: cli}
+	go c.watch(sub)
+
+	return c, nil
+}
+
+// Get returns the cached secret identified by secretID, if it has not expired.
+func (c *secretCache) Get(secretID string) (*model.SecretM, bool) {
+	value, ok := c.entries.Get(secretID)
+	if ok && time.Now().After(value.(*secretCacheEntry).expiresAt) {
+		c.entries.Remove(secretID)
+		ok = false
+	}
+
+	result := "miss"
+	if ok {
+		result = "hit"
+	}
+	secretCacheRequests.Add(context.Background(), 1, metric.WithAttributes(attribute.String("result", result)))
+
+	if !ok {
+		return nil, false
+	}
+	return value.(*secretCacheEntry).secret, true
+}
+
+// Add caches secret until the TTL has passed.
+func (c *secretCache) Add(secret *model.SecretM) {
+	c.entries.Add(secret.SecretID, &secretCacheEntry{secret: secret, expiresAt: time.Now().Add(c.ttl)})
+}
+
+// Invalidate evicts the secrets from the cache of every replica.
+func (c *secretCache) Invalidate(ctx context.Context, secretIDs ...string) error {
+	for _, secretID := range secretIDs {
+		c.remove(secretID)
+		if err := c.cli.Publish(ctx, secretChannel, secretID).Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watch evicts the secrets published by other replicas until the subscription is closed.
+// The invalidations published by this replica are received too, evicting them again is harmless.
+func (c *secretCache) watch(sub *redis.PubSub) {
+	for msg := range sub.Channel() {
+		log.Debugw("Secret invalidated", "secretID", msg.Payload)
+		c.remove(msg.Payload)
+	}
+}
+
+// remove evicts secretID from the local cache.
+func (c *secretCache) remove(secretID string) {
+	if c.entries.Remove(secretID) {
+		secretCacheInvalidations.Add(context.Background(), 1)
+	}
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+const (
+	// sessionRevokedKeyPrefix is the prefix of the Redis keys marking revoked sessions.
+	sessionRevokedKeyPrefix = "session_revoked_"
+	// sessionSeenKeyPrefix is the prefix of the Redis keys holding the last time a session was used.
+	sessionSeenKeyPrefix = "session_seen_"
+)
+
+// SessionStoreProviderSet is the wire provider set of the SessionStore.
+var SessionStoreProviderSet = wire.NewSet(NewSessionStore, wire.Bind(new(SessionStore), new(*redisSessionStore)))
+
+// SessionStore tracks the login sessions that tokens are bound to. Revocations
+// are kept in Redis, so that a revoked session is rejected by all replicas at once.
+type SessionStore interface {
+	// Check returns an error if the session has been revoked, and records that it was just used.
+	Check(ctx context.Context, sessionID string) error
+	// Revoke rejects the tokens of the session until ttl has passed, at which
+	// point all of them have expired anyway.
+	Revoke(ctx context.Context, sessionID string, ttl time.Duration) error
+	// LastSeen returns the last time each of the sessions was used, sessions
+	// that have not been used since they were issued are omitted.
+	LastSeen(ctx context.Context, sessionIDs ...string) (map[string]time.Time, error)
+}
+
+// SessionRevoker ends login sessions, rejecting all of their tokens and deleting their records.
+type SessionRevoker interface {
+	// RevokeSession ends the session, whose tokens all expire before expiresAt.
+	RevokeSession(ctx context.Context, sessionID string, expiresAt time.Time) error
+}
+
+// redisSessionStore is a Redis backed SessionStore.
+type redisSessionStore struct {
+	cli *redis.Client
+	// seenTTL is how long the last-seen time of a session is kept, it outlives every token of the session.
+	seenTTL time.Duration
+}
+
+// Ensure redisSessionStore implements SessionStore.
+var _ SessionStore = (*redisSessionStore)(nil)
+
+// NewSessionStore creates a Redis backed SessionStore.
+func NewSessionStore(redisOpts *genericoptions.RedisOptions, jwtOpts *options.JWTOptions) (*redisSessionStore, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisSessionStore{cli: cli, seenTTL: max(jwtOpts.Expired, jwtOpts.MaxRefresh)}, nil
+}
+
+// Check returns v1.ErrorSessionRevoked if the session has been revoked.
+// Tokens issued before sessions were introduced carry no session and are always accepted.
+func (s *redisSessionStore) Check(ctx context.Context, sessionID string) error {
+	if sessionID == "" {
+		return nil
+	}
+
+	pipe := s.cli.Pipeline()
+	revoked := pipe.Exists(ctx, sessionRevokedKeyPrefix+sessionID)
+	pipe.Set(ctx, sessionSeenKeyPrefix+sessionID, time.Now().Unix(), s.seenTTL)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return err
+	}
+	if revoked.Val() > 0 {
+		return v1.ErrorSessionRevoked("session %s has been revoked", sessionID)
+	}
+
+	return nil
+}
+
+// Revoke marks the session as revoked for ttl.
+func (s *redisSessionStore) Revoke(ctx context.Context, sessionID string, ttl time.Duration) error {
+	// Make sure the marker outlives a token that expires right now, despite clock skew.
+	ttl = max(ttl, time.Minute)
+
+	pipe := s.cli.TxPipeline()
+	pipe.Set(ctx, sessionRevokedKeyPrefix+sessionID, 1, ttl)
+	pipe.Del(ctx, sessionSeenKeyPrefix+sessionID)
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// LastSeen returns the last time each of the sessions was used.
+func (s *redisSessionStore) LastSeen(ctx context.Context, sessionIDs ...string) (map[string]time.Time, error) {
+	seen := make(map[string]time.Time, len(sessionIDs))
+	if len(sessionIDs) == 0 {
+		return seen, nil
+	}
+
+	keys := make([]string, 0, len(sessionIDs))
+	for _, sessionID := range sessionIDs {
+		keys = append(keys, sessionSeenKeyPrefix+sessionID)
+	}
+
+	values, err := s.cli.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, value := range values {
+		str, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if unix, err := strconv.ParseInt(str, 10, 64); err == nil {
+			seen[sessionIDs[i]] = time.Unix(unix, 0)
+		}
+	}
+
+	return seen, nil
+}
@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+const (
+	// SignatureAlgorithm is the authorization scheme of the requests signed with a secret.
+	SignatureAlgorithm = "ART-HMAC-SHA256"
+
+	// reasonInvalidSignature holds the error reason of ErrInvalidSignature.
+	reasonInvalidSignature string = "InvalidSignature"
+	// reasonReplayedRequest holds the error reason of ErrReplayedRequest.
+	reasonReplayedRequest string = "ReplayedRequest"
+
+	// nonceKeyPrefix is prepended to the Redis keys of the used nonces.
+	nonceKeyPrefix = "signature_nonce_"
+	// maxNonceLength limits the size of the nonces kept in Redis.
+	maxNonceLength = 64
+)
+
+var (
+	// ErrMalformedSignature is returned when the signature authorization header can not be parsed.
+	ErrMalformedSignature = errors.Unauthorized(reasonInvalidSignature, "Invalid signature format: "+
+		"expected `"+SignatureAlgorithm+" Credential=<SecretID>, Timestamp=<unix>, Nonce=<nonce>, Signature=<hex>`")
+	// ErrInvalidSignature is returned when the signature does not match the request.
+	ErrInvalidSignature = errors.Unauthorized(reasonInvalidSignature, "Signature does not match the request")
+	// ErrRequestExpired is returned when the timestamp of a request is outside the allowed clock skew.
+	ErrRequestExpired = errors.Unauthorized(reasonInvalidSignature, "Request timestamp is outside the allowed clock skew")
+	// ErrReplayedRequest is returned when the nonce of a request has already been used.
+	ErrReplayedRequest = errors.Unauthorized(reasonReplayedRequest, "Request nonce has already been used")
+	// ErrSecretExpired is returned when the SecretID has expired.
+	ErrSecretExpired = errors.Unauthorized(reasonUnauthorized, "SecretID has expired")
+)
+
+// SignatureProviderSet is the wire provider set of the request signature verifier.
+// The SecretGetter is provided by AuthnProviderSet.
+var SignatureProviderSet = wire.NewSet(NewSignatureVerifier, wire.Bind(new(SignatureVerifier), new(*signatureVerifier)))
+
+// SignatureVerifier verifies the requests signed with a SecretID/SecretKey pair.
+type SignatureVerifier interface {
+	// VerifyRequest verifies the signature of the request and returns the
+	// userID owning the secret the request was signed with.
+	VerifyRequest(rq *http.Request) (string, error)
+}
+
+// SecretGetter returns the secret identified by a SecretID.
+type SecretGetter interface {
+	GetSecret(secretID string) (*model.SecretM, error)
+}
+
+// signatureVerifier is a SignatureVerifier which remembers nonces in Redis.
+type signatureVerifier struct {
+	cli     *redis.Client
+	secrets SecretGetter
+	opts    *options.SignatureOptions
+}
+
+// Ensure signatureVerifier implements SignatureVerifier.
+var _ SignatureVerifier = (*signatureVerifier)(nil)
+
+// NewSignatureVerifier creates a SignatureVerifier.
+func NewSignatureVerifier(opts *options.SignatureOptions, redisOpts *genericoptions.RedisOptions, secrets SecretGetter) (*signatureVerifier, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &signatureVerifier{cli: cli, secrets: secrets, opts: opts}, nil
+}
+
+// signature holds the parameters of a signature authorization header.
+type signature struct {
+	SecretID  string
+	Timestamp string
+	Nonce     string
+	Signature string
+}
+
+// VerifyRequest verifies the signature of the request. The nonce is only
+// recorded once the signature is known to be valid, so that it can not be
+// burnt by a forged request.
+func (v *signatureVerifier) VerifyRequest(rq *http.Request) (string, error) {
+	sig, err := parseSignature(rq.Header.Get("Authorization"))
+	if err != nil {
+		return "", err
+	}
+
+	ts, err := strconv.ParseInt(sig.Timestamp, 10, 64)
+	if err != nil {
+		return "", ErrMalformedSignature
+	}
+	if skew := time.Since(time.Unix(ts, 0)); skew > v.opts.ClockSkew || skew < -v.opts.ClockSkew {
+		return "", ErrRequestExpired
+	}
+
+	secret, err := v.secrets.GetSecret(sig.SecretID)
+	if err != nil {
+		// Unknown SecretIDs are not told apart from bad signatures.
+		if v1.IsSecretNotFound(err) {
+			return "", ErrInvalidSignature
+		}
+		return "", err
+	}
+	// The temporary secret signs the access tokens, it is never handed out to clients.
+	if secret.Name == known.TemporaryKeyName {
+		return "", ErrInvalidSignature
+	}
+	if secret.Status == known.SecretStatusDisabled {
+		return "", ErrSecretDisabled
+	}
+	if keyExpired(secret.Expires) {
+		return "", ErrSecretExpired
+	}
+
+	body, err := readBody(rq, v.opts.MaxBodySize)
+	if err != nil {
+		var tooLarge *http.MaxBytesError
+		if errors.As(err, &tooLarge) {
+			return "", errno.ErrRequestTooLarge
+		}
+		return "", err
+	}
+	want := computeSignature(secret.SecretKey, StringToSign(rq, body, sig.Timestamp, sig.Nonce))
+	if !hmac.Equal([]byte(want), []byte(strings.ToLower(sig.Signature))) {
+		return "", ErrInvalidSignature
+	}
+
+	// A request is accepted at most ClockSkew after and before its timestamp,
+	// its nonce only needs to be remembered for that long.
+	ok, err := v.cli.SetNX(rq.Context(), nonceKeyPrefix+sig.SecretID+"_"+sig.Nonce, 1, 2*v.opts.ClockSkew).Result()
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return "", ErrReplayedRequest
+	}
+
+	return secret.UserID, nil
+}
+
+// SignRequest signs the request with the given secret at the current time and
+// with a random nonce. It is meant for Go clients and scripts.
+func SignRequest(rq *http.Request, secretID, secretKey string) error {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return err
+	}
+	nonce := hex.EncodeToString(b)
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	body, err := readBody(rq, 0)
+	if err != nil {
+		return err
+	}
+
+	rq.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s, Timestamp=%s, Nonce=%s, Signature=%s",
+		SignatureAlgorithm, secretID, timestamp, nonce, computeSignature(secretKey, StringToSign(rq, body, timestamp, nonce))))
+	return nil
+}
+
+// StringToSign returns the canonical form of the request which is signed:
+//
+//	METHOD
+//	escaped path
+//	query sorted by key and value, encoded as by url.Values.Encode
+//	hex encoded SHA-256 of the body
+//	timestamp
+//	nonce
+func StringToSign(rq *http.Request, body []byte, timestamp, nonce string) string {
+	query := rq.URL.Query()
+	for _, values := range query {
+		sort.Strings(values)
+	}
+	bodyHash := sha256.Sum256(body)
+
+	return strings.Join([]string{
+		strings.ToUpper(rq.Method),
+		rq.URL.EscapedPath(),
+		query.Encode(),
+		hex.EncodeToString(bodyHash[:]),
+		timestamp,
+		nonce,
+	}, "\n")
+}
+
+// computeSignature returns the hex encoded HMAC-SHA256 of stringToSign.
+func computeSignature(secretKey, stringToSign string) string {
+	mac := hmac.New(sha256.New, []byte(secretKey))
+	mac.Write([]byte(stringToSign))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// parseSignature parses an authorization header of the SignatureAlgorithm scheme.
+func parseSignature(header string) (*signature, error) {
+	params, ok := strings.CutPrefix(header, SignatureAlgorithm+" ")
+	if !ok {
+		return nil, ErrMalformedSignature
+	}
+
+	sig := &signature{}
+	for _, param := range strings.Split(params, ",") {
+		key, value, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return nil, ErrMalformedSignature
+		}
+		switch key {
+		case "Credential":
+			sig.SecretID = value
+		case "Timestamp":
+			sig.Timestamp = value
+		case "Nonce":
+			sig.Nonce = value
+		case "Signature":
+			sig.Signature = value
+		default:
+			return nil, ErrMalformedSignature
+		}
+	}
+	if sig.SecretID == "" || sig.Timestamp == "" || sig.Signature == "" || sig.Nonce == "" || len(sig.Nonce) > maxNonceLength {
+		return nil, ErrMalformedSignature
+	}
+
+	return sig, nil
+}
+
+// readBody reads the body of the request and puts it back, so that it can
+// still be bound by the handlers. Bodies larger than limit bytes are refused
+// with an *http.MaxBytesError before being read in full, limit <= 0 means no limit.
+func readBody(rq *http.Request, limit int64) ([]byte, error) {
+	if rq.Body == nil || rq.Body == http.NoBody {
+		return nil, nil
+	}
+
+	if limit > 0 {
+		rq.Body = http.MaxBytesReader(nil, rq.Body, limit)
+	}
+	body, err := io.ReadAll(rq.Body)
+	_ = rq.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	rq.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
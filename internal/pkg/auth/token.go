@@ -0,0 +1,170 @@
+package auth
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+)
+
+const (
+	// reasonWrongTokenType holds the error reason of ErrWrongTokenType.
+	reasonWrongTokenType string = "WrongTokenType"
+
+	// issuer is the issuer of all tokens signed by this package.
+	issuer string = "art-design-pro-go"
+
+	// bearerTokenType is the token type returned to the client.
+	bearerTokenType string = "Bearer"
+)
+
+// TokenType identifies what a token can be used for.
+type TokenType string
+
+const (
+	// TokenTypeAccess is used to access API resources.
+	TokenTypeAccess TokenType = "access"
+	// TokenTypeRefresh is only used to obtain a new pair of tokens.
+	TokenTypeRefresh TokenType = "refresh"
+	// TokenTypeMFA is a short-lived challenge token, which proves that the
+	// password was verified and can only be exchanged for tokens with a valid code.
+	TokenTypeMFA TokenType = "mfa_required"
+	// TokenTypePasswordChange is a short-lived challenge token, which proves that
+	// the expired password was verified and can only be used to set a new one.
+	TokenTypePasswordChange TokenType = "password_change_required"
+	// TokenTypePasswordReset is mailed to users who forgot their password, it
+	// can only be used once to set a new password.
+	TokenTypePasswordReset TokenType = "password_reset"
+)
+
+// ErrWrongTokenType is returned when a refresh token is used as an access token, or vice versa.
+var ErrWrongTokenType = errors.Unauthorized(reasonWrongTokenType, "Token type does not match the requested operation")
+
+// Claims is the set of claims carried by the tokens signed by this package.
+type Claims struct {
+	jwt.RegisteredClaims
+
+	// TokenType distinguishes access tokens, refresh tokens and MFA challenge tokens.
+	TokenType TokenType `json:"token_type"`
+	// SessionID binds access and refresh tokens to the login session they were issued for.
+	SessionID string `json:"sid,omitempty"`
+	// AuthTime is when the user logged in. It is carried over by the refresh
+	// tokens of the session, whose lifetime is counted from it.
+	AuthTime *jwt.NumericDate `json:"auth_time,omitempty"`
+	// Actor identifies the administrator acting as the subject, see RFC 8693.
+	// It is only set on the access tokens of impersonations.
+	Actor *Actor `json:"act,omitempty"`
+}
+
+// Actor is the party which really uses a token issued for another subject.
+type Actor struct {
+	// Subject is the userID of the actor.
+	Subject string `json:"sub"`
+}
+
+// ActorID returns the userID of the actor, or an empty string if the token is not impersonated.
+func (c *Claims) ActorID() string {
+	if c.Actor == nil {
+		return ""
+	}
+
+	return c.Actor.Subject
+}
+
+// authTime returns when the session of the token started. Tokens issued before
+// it was recorded count from their own issue time.
+func (c *Claims) authTime() time.Time {
+	if c.AuthTime != nil {
+		return c.AuthTime.Time
+	}
+	if c.IssuedAt != nil {
+		return c.IssuedAt.Time
+	}
+
+	return time.Now()
+}
+
+// newClaims creates the claims of a token of the given type issued now for userID.
+// The random jti keeps the tokens unique, even if they are issued within the same second.
+func newClaims(userID string, tokenType TokenType, expired time.Duration) *Claims {
+	now := time.Now()
+	return &Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        uuid.New().String(),
+			Issuer:    issuer,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expired)),
+			NotBefore: jwt.NewNumericDate(now),
+			Subject:   userID,
+		},
+		TokenType: tokenType,
+	}
+}
+
+// ParseUnverified decodes the claims of a token without verifying it. It must
+// only be used on tokens that have already been verified.
+func ParseUnverified(token string) (*Claims, error) {
+	claims := &Claims{}
+	if _, _, err := jwt.NewParser().ParseUnverified(token, claims); err != nil {
+		return nil, err
+	}
+
+	return claims, nil
+}
+
+// expectTokenType returns a check that rejects tokens whose type is not want.
+// It is meant to be called from a jwt.Keyfunc, where the claims are already
+// decoded but the signature is not verified yet, so that a token of the wrong
+// type is reported as such instead of as a bad signature.
+func expectTokenType(token *jwt.Token, want TokenType) error {
+	claims, ok := token.Claims.(*Claims)
+	if !ok || claims.TokenType != want {
+		return ErrWrongTokenType
+	}
+
+	return nil
+}
+
+// unwrapKeyfuncError returns the error produced by a jwt.Keyfunc, if any, so
+// that callers see e.g. ErrWrongTokenType rather than a generic validation error.
+func unwrapKeyfuncError(ve *jwt.ValidationError) error {
+	if ve.Errors&jwt.ValidationErrorUnverifiable == 0 || ve.Inner == nil {
+		return nil
+	}
+
+	if se := new(errors.Error); errors.As(ve.Inner, &se) {
+		return se
+	}
+
+	return nil
+}
+
+// tokenInfo contains token information.
+type tokenInfo struct {
+	// Token string.
+	Token string `json:"token"`
+
+	// Token type.
+	Type string `json:"type"`
+
+	// Token expiration time
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+func (t *tokenInfo) GetToken() string {
+	return t.Token
+}
+
+func (t *tokenInfo) GetTokenType() string {
+	return t.Type
+}
+
+func (t *tokenInfo) GetExpiresAt() int64 {
+	return t.ExpiresAt
+}
+
+func (t *tokenInfo) EncodeToJSON() ([]byte, error) {
+	return json.Marshal(t)
+}
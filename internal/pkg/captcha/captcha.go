@@ -0,0 +1,138 @@
+// Package captcha generates digit image captchas and verifies their answers.
+// Answers are kept in Redis until they expire or are verified once.
+package captcha
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the captcha.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(Captcha), new(*redisCaptcha)))
+
+// keyPrefix is prepended to the captcha IDs to build the Redis keys.
+const keyPrefix = "captcha_"
+
+// Captcha generates and verifies captchas, and tells when they are enforced.
+type Captcha interface {
+	// Generate creates a new captcha and returns its ID and its image as a base64 data URI.
+	Generate(ctx context.Context) (id string, image string, err error)
+	// Verify reports whether answer is the answer of the captcha. A captcha can
+	// only be verified once, whatever the result.
+	Verify(ctx context.Context, id, answer string) (bool, error)
+	// LoginRequired reports whether the captcha is enforced on a login after the given number of failures.
+	LoginRequired(failures int64) bool
+	// RegisterRequired reports whether the captcha is enforced on registration.
+	RegisterRequired() bool
+	// Options returns the captcha options.
+	Options() *options.CaptchaOptions
+}
+
+// redisCaptcha is a Redis backed Captcha.
+type redisCaptcha struct {
+	cli  *redis.Client
+	opts *options.CaptchaOptions
+}
+
+// Ensure redisCaptcha implements Captcha.
+var _ Captcha = (*redisCaptcha)(nil)
+
+// New creates a Redis backed Captcha.
+func New(opts *options.CaptchaOptions, redisOpts *genericoptions.RedisOptions) (*redisCaptcha, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisCaptcha{cli: cli, opts: opts}, nil
+}
+
+// Generate creates a new captcha.
+func (c *redisCaptcha) Generate(ctx context.Context) (string, string, error) {
+	id, err := randomID()
+	if err != nil {
+		return "", "", err
+	}
+
+	answer, err := randomDigits(c.opts.Length)
+	if err != nil {
+		return "", "", err
+	}
+
+	image, err := render(answer, c.opts.Width, c.opts.Height)
+	if err != nil {
+		return "", "", err
+	}
+
+	if err := c.cli.Set(ctx, keyPrefix+id, answer, c.opts.Expiration).Err(); err != nil {
+		return "", "", err
+	}
+
+	return id, image, nil
+}
+
+// Verify reports whether answer is the answer of the captcha, and deletes the captcha.
+func (c *redisCaptcha) Verify(ctx context.Context, id, answer string) (bool, error) {
+	if id == "" || answer == "" {
+		return false, nil
+	}
+
+	want, err := c.cli.GetDel(ctx, keyPrefix+id).Result()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return strings.TrimSpace(answer) == want, nil
+}
+
+// LoginRequired reports whether the captcha is enforced on a login after the given number of failures.
+func (c *redisCaptcha) LoginRequired(failures int64) bool {
+	return c.opts.EnableLogin && failures >= int64(c.opts.LoginFailures)
+}
+
+// RegisterRequired reports whether the captcha is enforced on registration.
+func (c *redisCaptcha) RegisterRequired() bool {
+	return c.opts.EnableRegister
+}
+
+// Options returns the captcha options.
+func (c *redisCaptcha) Options() *options.CaptchaOptions {
+	return c.opts
+}
+
+// randomID returns a random captcha ID.
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
+
+// randomDigits returns n random decimal digits.
+func randomDigits(n int) (string, error) {
+	digits := make([]byte, n)
+	for i := range digits {
+		d, err := rand.Int(rand.Reader, big.NewInt(10))
+		if err != nil {
+			return "", err
+		}
+		digits[i] = byte('0' + d.Int64())
+	}
+
+	return string(digits), nil
+}
@@ -0,0 +1,131 @@
+package captcha
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/png"
+	mathrand "math/rand/v2"
+)
+
+// glyphWidth and glyphHeight are the size of the digit bitmaps.
+const (
+	glyphWidth  = 5
+	glyphHeight = 7
+)
+
+// glyphs are 5x7 bitmaps of the digits 0-9, one string per row.
+var glyphs = [10][glyphHeight]string{
+	{".###.", "#...#", "#..##", "#.#.#", "##..#", "#...#", ".###."},
+	{"..#..", ".##..", "..#..", "..#..", "..#..", "..#..", ".###."},
+	{".###.", "#...#", "....#", "...#.", "..#..", ".#...", "#####"},
+	{"#####", "...#.", "..#..", "...#.", "....#", "#...#", ".###."},
+	{"...#.", "..##.", ".#.#.", "#..#.", "#####", "...#.", "...#."},
+	{"#####", "#....", "####.", "....#", "....#", "#...#", ".###."},
+	{"..##.", ".#...", "#....", "####.", "#...#", "#...#", ".###."},
+	{"#####", "....#", "...#.", "..#..", ".#...", ".#...", ".#..."},
+	{".###.", "#...#", "#...#", ".###.", "#...#", "#...#", ".###."},
+	{".###.", "#...#", "#...#", ".####", "....#", "...#.", ".##.."},
+}
+
+// render draws the digits of answer with random jitter, shear and noise, and
+// returns the PNG image as a base64 data URI.
+func render(answer string, width, height int) (string, error) {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	background := color.RGBA{R: 240, G: 243, B: 248, A: 255}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			img.Set(x, y, background)
+		}
+	}
+
+	// Each digit gets an equal share of the width, and is scaled to fit in it.
+	cell := width / len(answer)
+	scale := max(1, min(cell/(glyphWidth+2), height/(glyphHeight+3)))
+	for i, ch := range answer {
+		ink := randomColor(20, 140)
+		shear := mathrand.Float64()*0.6 - 0.3
+		x0 := i*cell + (cell-glyphWidth*scale)/2 + mathrand.IntN(scale+1) - scale/2
+		y0 := (height-glyphHeight*scale)/2 + mathrand.IntN(scale*2+1) - scale
+		for gy, row := range glyphs[ch-'0'] {
+			for gx, bit := range row {
+				if bit != '#' {
+					continue
+				}
+				dx := int(shear * float64(glyphHeight/2-gy) * float64(scale))
+				fill(img, x0+gx*scale+dx, y0+gy*scale, scale, ink)
+			}
+		}
+	}
+
+	// Noise lines across the digits and scattered dots.
+	for range 4 {
+		line(img, mathrand.IntN(width/4), mathrand.IntN(height), width-1-mathrand.IntN(width/4), mathrand.IntN(height), randomColor(80, 200))
+	}
+	for range width * height / 20 {
+		img.Set(mathrand.IntN(width), mathrand.IntN(height), randomColor(60, 220))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return "", err
+	}
+
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// fill paints a size x size square at (x, y).
+func fill(img *image.RGBA, x, y, size int, c color.Color) {
+	for dy := 0; dy < size; dy++ {
+		for dx := 0; dx < size; dx++ {
+			img.Set(x+dx, y+dy, c)
+		}
+	}
+}
+
+// line draws a line from (x0, y0) to (x1, y1) with Bresenham's algorithm.
+func line(img *image.RGBA, x0, y0, x1, y1 int, c color.Color) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	sx, sy := sign(x1-x0), sign(y1-y0)
+	e := dx + dy
+	for {
+		img.Set(x0, y0, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 >= dy {
+			e += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+// randomColor returns an opaque color whose channels are in [lo, hi).
+func randomColor(lo, hi int) color.RGBA {
+	channel := func() uint8 { return uint8(lo + mathrand.IntN(hi-lo)) }
+	return color.RGBA{R: channel(), G: channel(), B: channel(), A: 255}
+}
+
+func abs(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+func sign(v int) int {
+	switch {
+	case v > 0:
+		return 1
+	case v < 0:
+		return -1
+	default:
+		return 0
+	}
+}
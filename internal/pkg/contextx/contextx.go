@@ -2,9 +2,12 @@ package contextx
 
 import (
 	"context"
+	"slices"
 
 	"github.com/golang-jwt/jwt/v4"
+
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/known"
 )
 
 // Define keys for the context.
@@ -17,10 +20,24 @@ type (
 	userMKey  struct{}
 	// accessTokenKey defines the context key for the access token.
 	accessTokenKey struct{}
+	// refreshTokenKey defines the context key for the refresh token.
+	refreshTokenKey struct{}
 	// requestIDKey defines the context key for the request ID.
 	requestIDKey struct{}
 	// traceIDKey is the key for storing trace ID in context
 	traceIDKey struct{}
+	// clientIPKey defines the context key for the client IP.
+	clientIPKey struct{}
+	// userAgentKey defines the context key for the user agent.
+	userAgentKey struct{}
+	// sessionIDKey defines the context key for the login session ID.
+	sessionIDKey struct{}
+	// actorIDKey defines the context key for the administrator impersonating the user.
+	actorIDKey struct{}
+	// rolesKey defines the context key for the roles of the user.
+	rolesKey struct{}
+	// oidcBindingKey defines the context key for the value binding an OIDC login to the browser.
+	oidcBindingKey struct{}
 )
 
 // WithClaims put claims info into context.
@@ -61,6 +78,17 @@ func AccessToken(ctx context.Context) string {
 	return accessToken
 }
 
+// WithRefreshToken stores the refresh token into the context.
+func WithRefreshToken(ctx context.Context, refreshToken string) context.Context {
+	return context.WithValue(ctx, refreshTokenKey{}, refreshToken)
+}
+
+// RefreshToken retrieves the refresh token from the context.
+func RefreshToken(ctx context.Context) string {
+	refreshToken, _ := ctx.Value(refreshTokenKey{}).(string)
+	return refreshToken
+}
+
 // WithRequestID stores the request ID into the context.
 func WithRequestID(ctx context.Context, requestID string) context.Context {
 	return context.WithValue(ctx, requestIDKey{}, requestID)
@@ -83,6 +111,91 @@ func TraceID(ctx context.Context) string {
 	return traceID
 }
 
+// WithClientIP stores the client IP into the context.
+func WithClientIP(ctx context.Context, clientIP string) context.Context {
+	return context.WithValue(ctx, clientIPKey{}, clientIP)
+}
+
+// ClientIP retrieves the client IP from the context.
+func ClientIP(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPKey{}).(string)
+	return clientIP
+}
+
+// WithUserAgent stores the user agent of the client into the context.
+func WithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey{}, userAgent)
+}
+
+// UserAgent retrieves the user agent of the client from the context.
+func UserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey{}).(string)
+	return userAgent
+}
+
+// WithSessionID stores the login session ID into the context. Tokens signed
+// with the context are bound to the session.
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID retrieves the login session ID from the context.
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey{}).(string)
+	return sessionID
+}
+
+// WithActorID stores the ID of the administrator impersonating the user into the context.
+// The user ID stays the effective user, whose permissions apply to the request.
+func WithActorID(ctx context.Context, actorID string) context.Context {
+	return context.WithValue(ctx, actorIDKey{}, actorID)
+}
+
+// ActorID retrieves the ID of the user who really performs the request: the
+// administrator impersonating the user, or the user itself.
+func ActorID(ctx context.Context) string {
+	if actorID, _ := ctx.Value(actorIDKey{}).(string); actorID != "" {
+		return actorID
+	}
+	return UserID(ctx)
+}
+
+// Impersonated reports whether the request is performed by an administrator acting as the user.
+func Impersonated(ctx context.Context) bool {
+	actorID, _ := ctx.Value(actorIDKey{}).(string)
+	return actorID != ""
+}
+
+// WithRoles stores the roles of the user into the context. The store layer
+// restricts the queries to the data scopes of the roles.
+func WithRoles(ctx context.Context, roles []string) context.Context {
+	return context.WithValue(ctx, rolesKey{}, roles)
+}
+
+// Roles retrieves the roles of the user from the context.
+func Roles(ctx context.Context) []string {
+	roles, _ := ctx.Value(rolesKey{}).([]string)
+	return roles
+}
+
+// IsAdmin reports whether the user in the context is an administrator: the
+// builtin administrator, or a user holding role::admin, directly or through another role.
+func IsAdmin(ctx context.Context) bool {
+	return UserID(ctx) == known.AdminUserID || slices.Contains(Roles(ctx), known.RoleAdmin)
+}
+
+// WithOIDCBinding stores the value binding an OIDC login to the browser
+// that started it into the context.
+func WithOIDCBinding(ctx context.Context, binding string) context.Context {
+	return context.WithValue(ctx, oidcBindingKey{}, binding)
+}
+
+// OIDCBinding retrieves the value binding an OIDC login to the browser from the context.
+func OIDCBinding(ctx context.Context) string {
+	binding, _ := ctx.Value(oidcBindingKey{}).(string)
+	return binding
+}
+
 // WithUserM put *UserM into context.
 func WithUserM(ctx context.Context, user *model.UserM) context.Context {
 	return context.WithValue(ctx, userMKey{}, user)
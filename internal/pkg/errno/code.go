@@ -40,6 +40,9 @@ var (
 	// ErrTokenInvalid indicates that the JWT token format was invalid.
 	ErrTokenInvalid = &errorsx.ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.TokenInvalid", Message: "Token was invalid."}
 
+	// ErrRequestTooLarge indicates that the request body exceeds the size limit.
+	ErrRequestTooLarge = &errorsx.ErrorX{Code: http.StatusRequestEntityTooLarge, Reason: "InvalidArgument.RequestTooLarge", Message: "Request body is too large."}
+
 	// ErrDBRead indicates a database read failure.
 	ErrDBRead = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.DBRead", Message: "Database read failure."}
 
@@ -51,4 +54,13 @@ var (
 
 	// ErrRemoveRole indicates an error occurred while removing a role.
 	ErrRemoveRole = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.RemoveRole", Message: "Error occurred while removing the role."}
+
+	// ErrAddPolicy indicates an error occurred while adding a policy.
+	ErrAddPolicy = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.AddPolicy", Message: "Error occurred while adding the policy."}
+
+	// ErrUpdatePolicy indicates an error occurred while updating a policy.
+	ErrUpdatePolicy = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.UpdatePolicy", Message: "Error occurred while updating the policy."}
+
+	// ErrRemovePolicy indicates an error occurred while removing a policy.
+	ErrRemovePolicy = &errorsx.ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.RemovePolicy", Message: "Error occurred while removing the policy."}
 )
@@ -0,0 +1,15 @@
+package errno
+
+import (
+	"net/http"
+
+	"github.com/moweilong/milady/pkg/errorsx"
+)
+
+var (
+	// ErrPolicyAlreadyExists indicates that the policy already exists.
+	ErrPolicyAlreadyExists = &errorsx.ErrorX{Code: http.StatusConflict, Reason: "AlreadyExist.PolicyAlreadyExists", Message: "Policy already exists."}
+
+	// ErrPolicyNotFound indicates that the specified policy was not found.
+	ErrPolicyNotFound = &errorsx.ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PolicyNotFound", Message: "Policy not found."}
+)
@@ -4,10 +4,15 @@ import "time"
 
 const (
 	DefaultLRUSize = 1000
-	// AccessTokenExpire is the expiration time for the access token.
-	AccessTokenExpire = time.Hour * 2
-	// RefreshTokenExpire is the expiration time for the refresh token.
-	RefreshTokenExpire = time.Hour * 24
+	// SecretCacheTTL is how long a secret is cached before it is reloaded, even
+	// if no invalidation of it has been received.
+	SecretCacheTTL = time.Minute * 5
+	// MFAChallengeExpire is the expiration time for the MFA challenge token.
+	MFAChallengeExpire = time.Minute * 5
+	// PasswordChallengeExpire is the expiration time for the token used to change an expired password.
+	PasswordChallengeExpire = time.Minute * 10
+	// ImpersonationTokenExpire is the expiration time for the access token of an impersonation.
+	ImpersonationTokenExpire = time.Minute * 15
 )
 
 const (
@@ -19,6 +19,12 @@ const (
 
 	// XUsername defines the context key that represents the requesting username.
 	XUsername = "x-username"
+
+	// XSessionID defines the header that represents the login session of the requesting user.
+	XSessionID = "x-session-id"
+
+	// XActorID defines the header that represents the administrator impersonating the requesting user.
+	XActorID = "x-actor-id"
 )
 
 // Define other constants.
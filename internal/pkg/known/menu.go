@@ -0,0 +1,8 @@
+package known
+
+// Define menu types. Directories contain directories and menus, menus contain buttons.
+const (
+	MenuTypeDirectory = iota + 1 // A directory groups the menus in the sidebar.
+	MenuTypeMenu                 // A menu is a page of the frontend.
+	MenuTypeButton               // A button is an operation on a page, which is shown by its permission mark.
+)
@@ -6,3 +6,22 @@ const (
 	// Role for administrators.
 	RoleAdmin = "role::admin"
 )
+
+// BuiltinRoles are the roles the system relies on, they can be neither deleted nor disabled.
+var BuiltinRoles = []string{RoleUser, RoleAdmin}
+
+// Define data scopes of roles, which decide the users whose data the members of a role can access.
+// The users can always access their own data, the data scopes of the roles of a user are merged.
+const (
+	DataScopeAll                   = iota + 1 // The data of all users.
+	DataScopeCustom                           // The data of the users in the departments bound to the role.
+	DataScopeDepartment                       // The data of the users in the department of the user.
+	DataScopeDepartmentAndChildren            // The data of the users in the department of the user and its descendants.
+	DataScopeSelf                             // Only the data of the user.
+)
+
+// Define policy effects. A deny policy overrides the allow policies.
+const (
+	PolicyEffectAllow = "allow"
+	PolicyEffectDeny  = "deny"
+)
@@ -4,7 +4,7 @@ package known
 const (
 	// User has submitted registration information, the account is in a pending.
 	// The user needs to complete email/phone number verification steps to transition to an active state.
-	// The OneX project does not currently use this.
+	// Self-registered users start in this state when email verification is enabled.
 	UserStatusRegistered = "registered"
 	// The user has registered and been verified, and can use the system normally.
 	// Most user operations are performed in this state.
@@ -38,3 +38,15 @@ const (
 	SecretStatusDisabled = iota // Status used for disabling a secret.
 	SecretStatusNormal          // Status used for enabling a secret.
 )
+
+// Define role status.
+const (
+	RoleStatusDisabled = iota // Status used for disabling a role, a disabled role can not be assigned.
+	RoleStatusEnabled         // Status used for enabling a role.
+)
+
+// Define department status.
+const (
+	DepartmentStatusDisabled = iota // Status used for disabling a department, users can not join a disabled department.
+	DepartmentStatusEnabled         // Status used for enabling a department.
+)
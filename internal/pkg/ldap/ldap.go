@@ -0,0 +1,239 @@
+// Package ldap authenticates users against a LDAP directory or an Active
+// Directory. A service account searches the user entry by the login name,
+// and the password is checked by binding as the user.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	goldap "github.com/go-ldap/ldap/v3"
+	"github.com/google/wire"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the LDAP authentication.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(LDAP), new(*ldapImpl)))
+
+var (
+	// ErrUserNotFound is returned when no entry of the directory matches the username.
+	ErrUserNotFound = errors.New("ldap user not found")
+	// ErrInvalidCredentials is returned when the password does not match.
+	ErrInvalidCredentials = errors.New("invalid ldap credentials")
+)
+
+// Entry is a user authenticated by the directory.
+type Entry struct {
+	// DN is the distinguished name of the user entry.
+	DN string
+	// ID uniquely identifies the user, see LDAPOptions.IDAttribute.
+	ID       string
+	Username string
+	Email    string
+	Name     string
+	// Groups are the DNs of the groups the user is a member of.
+	Groups []string
+	// Roles are the casbin roles mapped from Groups.
+	Roles []string
+}
+
+// LDAP authenticates users against a directory.
+type LDAP interface {
+	// Enabled reports whether the LDAP authentication is configured.
+	Enabled() bool
+	// Options returns the options of the LDAP authentication.
+	Options() *options.LDAPOptions
+	// Authenticate checks the password of username and returns its entry.
+	Authenticate(ctx context.Context, username, password string) (*Entry, error)
+	// ManagedRoles returns the roles which are granted by the group mapping.
+	ManagedRoles() []string
+}
+
+// ldapImpl connects to the directory for every authentication, since logins
+// are rare enough not to justify a connection pool.
+type ldapImpl struct {
+	opts *options.LDAPOptions
+}
+
+// Ensure ldapImpl implements LDAP.
+var _ LDAP = (*ldapImpl)(nil)
+
+// New creates a LDAP authentication.
+func New(opts *options.LDAPOptions) (*ldapImpl, error) {
+	return &ldapImpl{opts: opts}, nil
+}
+
+// Enabled reports whether the LDAP authentication is configured.
+func (l *ldapImpl) Enabled() bool {
+	return l.opts.Enabled
+}
+
+// Options returns the options of the LDAP authentication.
+func (l *ldapImpl) Options() *options.LDAPOptions {
+	return l.opts
+}
+
+// ManagedRoles returns the roles of the group mapping, sorted and without duplicates.
+func (l *ldapImpl) ManagedRoles() []string {
+	roles := make([]string, 0, len(l.opts.GroupRoles))
+	for _, gr := range l.opts.GroupRoles {
+		roles = append(roles, gr.Role)
+	}
+	slices.Sort(roles)
+
+	return slices.Compact(roles)
+}
+
+// Authenticate checks the password of username and returns its entry.
+func (l *ldapImpl) Authenticate(ctx context.Context, username, password string) (*Entry, error) {
+	// An empty password makes an unauthenticated bind, which succeeds on most directories.
+	if password == "" {
+		return nil, ErrInvalidCredentials
+	}
+
+	conn, err := l.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := l.bindService(conn); err != nil {
+		return nil, err
+	}
+
+	attributes := []string{l.opts.UsernameAttribute, l.opts.EmailAttribute, l.opts.NameAttribute}
+	if l.opts.IDAttribute != "" {
+		attributes = append(attributes, l.opts.IDAttribute)
+	}
+	if l.opts.GroupBaseDN == "" {
+		attributes = append(attributes, l.opts.GroupAttribute)
+	}
+	result, err := conn.Search(goldap.NewSearchRequest(
+		l.opts.BaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 2, int(l.opts.Timeout.Seconds()), false,
+		fmt.Sprintf(l.opts.UserFilter, goldap.EscapeFilter(username)), attributes, nil,
+	))
+	if err != nil && !goldap.IsErrorWithCode(err, goldap.LDAPResultSizeLimitExceeded) {
+		return nil, fmt.Errorf("search ldap user: %w", err)
+	}
+	switch {
+	case len(result.Entries) == 0:
+		return nil, ErrUserNotFound
+	case len(result.Entries) > 1:
+		return nil, fmt.Errorf("ldap user filter matches more than one entry for %q", username)
+	}
+	userEntry := result.Entries[0]
+
+	if err := conn.Bind(userEntry.DN, password); err != nil {
+		if goldap.IsErrorWithCode(err, goldap.LDAPResultInvalidCredentials) {
+			return nil, ErrInvalidCredentials
+		}
+		return nil, fmt.Errorf("bind ldap user: %w", err)
+	}
+
+	entry := &Entry{
+		DN:       userEntry.DN,
+		ID:       userEntry.DN,
+		Username: userEntry.GetAttributeValue(l.opts.UsernameAttribute),
+		Email:    userEntry.GetAttributeValue(l.opts.EmailAttribute),
+		Name:     userEntry.GetAttributeValue(l.opts.NameAttribute),
+	}
+	if l.opts.IDAttribute != "" {
+		// Binary identifiers such as the objectGUID of Active Directory are hex encoded.
+		id := userEntry.GetRawAttributeValue(l.opts.IDAttribute)
+		if len(id) == 0 {
+			return nil, fmt.Errorf("ldap user %s has no %s", userEntry.DN, l.opts.IDAttribute)
+		}
+		entry.ID = string(id)
+		if !utf8.Valid(id) {
+			entry.ID = hex.EncodeToString(id)
+		}
+	}
+
+	if l.opts.GroupBaseDN == "" {
+		entry.Groups = userEntry.GetAttributeValues(l.opts.GroupAttribute)
+	} else {
+		// The user may not be allowed to read the groups, search them as the service account again.
+		if err := l.bindService(conn); err != nil {
+			return nil, err
+		}
+		if entry.Groups, err = l.searchGroups(conn, userEntry.DN); err != nil {
+			return nil, err
+		}
+	}
+	entry.Roles = l.roles(entry.Groups)
+
+	return entry, nil
+}
+
+// dial connects to the directory.
+func (l *ldapImpl) dial() (*goldap.Conn, error) {
+	tlsConfig := &tls.Config{InsecureSkipVerify: l.opts.InsecureSkipVerify} //nolint:gosec
+	conn, err := goldap.DialURL(l.opts.URL,
+		goldap.DialWithDialer(&net.Dialer{Timeout: l.opts.Timeout}),
+		goldap.DialWithTLSConfig(tlsConfig),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("connect to ldap: %w", err)
+	}
+	conn.SetTimeout(l.opts.Timeout)
+
+	if l.opts.StartTLS {
+		if err := conn.StartTLS(tlsConfig); err != nil {
+			conn.Close()
+			return nil, fmt.Errorf("start ldap tls: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+// bindService binds as the service account, if there is one.
+func (l *ldapImpl) bindService(conn *goldap.Conn) error {
+	if l.opts.BindDN == "" {
+		return nil
+	}
+	if err := conn.Bind(l.opts.BindDN, l.opts.BindPassword); err != nil {
+		return fmt.Errorf("bind ldap service account: %w", err)
+	}
+
+	return nil
+}
+
+// searchGroups returns the DNs of the groups userDN is a member of.
+func (l *ldapImpl) searchGroups(conn *goldap.Conn, userDN string) ([]string, error) {
+	result, err := conn.Search(goldap.NewSearchRequest(
+		l.opts.GroupBaseDN, goldap.ScopeWholeSubtree, goldap.NeverDerefAliases, 0, int(l.opts.Timeout.Seconds()), false,
+		fmt.Sprintf(l.opts.GroupFilter, goldap.EscapeFilter(userDN)), []string{"dn"}, nil,
+	))
+	if err != nil {
+		return nil, fmt.Errorf("search ldap groups: %w", err)
+	}
+
+	groups := make([]string, 0, len(result.Entries))
+	for _, entry := range result.Entries {
+		groups = append(groups, entry.DN)
+	}
+
+	return groups, nil
+}
+
+// roles maps groups to casbin roles. DNs are compared case-insensitively.
+func (l *ldapImpl) roles(groups []string) []string {
+	var roles []string
+	for _, gr := range l.opts.GroupRoles {
+		if slices.ContainsFunc(groups, func(g string) bool { return strings.EqualFold(g, gr.Group) }) {
+			roles = append(roles, gr.Role)
+		}
+	}
+	slices.Sort(roles)
+
+	return slices.Compact(roles)
+}
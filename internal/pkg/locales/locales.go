@@ -28,6 +28,28 @@ const (
 	InvalidCaptcha     = "login.invalid.captcha"
 	LoginFailed        = "login.failed"
 	UserLocked         = "login.user.locked"
+	InvalidMFACode     = "mfa.invalid.code"
+	MFAAlreadyEnabled  = "mfa.already.enabled"
+	MFANotEnabled      = "mfa.not.enabled"
 	KeepLeastOntAction = "action.keep.least.one.action"
-	DeleteYourself     = "user.delete.yourself"
+
+	PasswordTooShort      = "password.too.short"
+	PasswordMissingLetter = "password.missing.letter"
+	PasswordMissingUpper  = "password.missing.upper"
+	PasswordMissingLower  = "password.missing.lower"
+	PasswordMissingDigit  = "password.missing.digit"
+	PasswordMissingSymbol = "password.missing.symbol"
+	PasswordTooCommon     = "password.too.common"
+	PasswordReused        = "password.reused"
+
+	PasswordResetSubject      = "password.reset.subject"
+	PasswordResetBody         = "password.reset.body"
+	InvalidPasswordResetToken = "password.reset.token.invalid"
+
+	EmailVerifySubject            = "email.verify.subject"
+	EmailVerifyBody               = "email.verify.body"
+	InvalidEmailVerificationToken = "email.verify.token.invalid"
+	UserNotActivated              = "login.user.not.activated"
+	UserDisabled                  = "login.user.disabled"
+	DeleteYourself                = "user.delete.yourself"
 )
@@ -0,0 +1,200 @@
+// Package lockout protects the login from brute-force attacks. Failed logins
+// are counted per username and per client IP in Redis sliding windows, and the
+// username or IP is locked once the configured threshold is reached.
+package lockout
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the lockout.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(Lockout), new(*redisLockout)))
+
+// keyPrefix is prepended to all Redis keys written by this package.
+const keyPrefix = "lockout_"
+
+// Lockout tracks failed logins and decides whether a login is allowed.
+type Lockout interface {
+	// Locked reports whether the username or the client IP is currently locked.
+	Locked(ctx context.Context, username, ip string) (bool, error)
+	// Failures returns the number of failed logins within the window of the
+	// username or of the client IP, whichever is larger.
+	Failures(ctx context.Context, username, ip string) (int64, error)
+	// Fail records a failed login, and locks the username or the client IP when
+	// its number of failures within the window reaches the threshold. It reports
+	// whether the username has been locked permanently, which the caller records
+	// on the user as well.
+	Fail(ctx context.Context, username, ip string) (bool, error)
+	// Succeed clears the failures of the username after a successful login.
+	Succeed(ctx context.Context, username string) error
+	// Unlock removes the lock and the failure history of the username.
+	Unlock(ctx context.Context, username string) error
+}
+
+// redisLockout is a Redis backed Lockout.
+type redisLockout struct {
+	cli  *redis.Client
+	opts *options.LockoutOptions
+}
+
+// Ensure redisLockout implements Lockout.
+var _ Lockout = (*redisLockout)(nil)
+
+// New creates a Redis backed Lockout.
+func New(opts *options.LockoutOptions, redisOpts *genericoptions.RedisOptions) (*redisLockout, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisLockout{cli: cli, opts: opts}, nil
+}
+
+// Locked reports whether the username or the client IP is currently locked.
+func (l *redisLockout) Locked(ctx context.Context, username, ip string) (bool, error) {
+	keys := []string{lockKey("user", username)}
+	if ip != "" {
+		keys = append(keys, lockKey("ip", ip))
+	}
+
+	n, err := l.cli.Exists(ctx, keys...).Result()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// Failures returns the larger number of failed logins within the window of username and ip.
+func (l *redisLockout) Failures(ctx context.Context, username, ip string) (int64, error) {
+	since := strconv.FormatInt(time.Now().Add(-l.opts.Window).UnixNano(), 10)
+
+	pipe := l.cli.Pipeline()
+	counts := []*redis.IntCmd{pipe.ZCount(ctx, failKey("user", username), since, "+inf")}
+	if ip != "" {
+		counts = append(counts, pipe.ZCount(ctx, failKey("ip", ip), since, "+inf"))
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	var failures int64
+	for _, count := range counts {
+		failures = max(failures, count.Val())
+	}
+
+	return failures, nil
+}
+
+// Fail records a failed login of username from ip.
+// Failures are recorded even when locking is disabled, since they are also
+// used to decide when to enforce the captcha.
+func (l *redisLockout) Fail(ctx context.Context, username, ip string) (bool, error) {
+	count, err := l.record(ctx, failKey("user", username))
+	if err != nil {
+		return false, err
+	}
+	var permanent bool
+	if l.opts.MaxUserFailures > 0 && count >= int64(l.opts.MaxUserFailures) {
+		if err := l.lockUser(ctx, username); err != nil {
+			return false, err
+		}
+		permanent = l.opts.Permanent
+	}
+
+	if ip != "" {
+		count, err := l.record(ctx, failKey("ip", ip))
+		if err != nil {
+			return permanent, err
+		}
+		// A client IP is never locked permanently, since it may be shared by many users.
+		if l.opts.MaxIPFailures > 0 && count >= int64(l.opts.MaxIPFailures) {
+			pipe := l.cli.TxPipeline()
+			pipe.Set(ctx, lockKey("ip", ip), 1, l.opts.LockDuration)
+			pipe.Del(ctx, failKey("ip", ip))
+			if _, err := pipe.Exec(ctx); err != nil {
+				return permanent, err
+			}
+		}
+	}
+
+	return permanent, nil
+}
+
+// Succeed clears the failures of username. The lock level is kept, so that
+// an attacker who guesses right now and then still faces the backoff.
+func (l *redisLockout) Succeed(ctx context.Context, username string) error {
+	return l.cli.Del(ctx, failKey("user", username)).Err()
+}
+
+// Unlock removes the lock, the failures and the lock level of username.
+func (l *redisLockout) Unlock(ctx context.Context, username string) error {
+	return l.cli.Del(ctx, lockKey("user", username), failKey("user", username), levelKey(username)).Err()
+}
+
+// record adds a failure to the sliding window stored at key and returns the
+// number of failures within the window.
+func (l *redisLockout) record(ctx context.Context, key string) (int64, error) {
+	now := time.Now()
+
+	pipe := l.cli.TxPipeline()
+	pipe.ZRemRangeByScore(ctx, key, "-inf", strconv.FormatInt(now.Add(-l.opts.Window).UnixNano(), 10))
+	pipe.ZAdd(ctx, key, redis.Z{Score: float64(now.UnixNano()), Member: now.UnixNano()})
+	count := pipe.ZCard(ctx, key)
+	pipe.Expire(ctx, key, l.opts.Window)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, err
+	}
+
+	return count.Val(), nil
+}
+
+// lockUser locks username. Temporary locks double in duration every time the
+// user is locked again, up to MaxLockDuration.
+func (l *redisLockout) lockUser(ctx context.Context, username string) error {
+	var expiration time.Duration
+	if !l.opts.Permanent {
+		level, err := l.cli.Incr(ctx, levelKey(username)).Result()
+		if err != nil {
+			return err
+		}
+		expiration = backoff(l.opts.LockDuration, l.opts.MaxLockDuration, level)
+	}
+
+	pipe := l.cli.TxPipeline()
+	// A zero expiration keeps the lock until an administrator unlocks the user.
+	pipe.Set(ctx, lockKey("user", username), 1, expiration)
+	pipe.Del(ctx, failKey("user", username))
+	if !l.opts.Permanent {
+		// Forget the lock level once the user has behaved for a full maximum lock duration.
+		pipe.Expire(ctx, levelKey(username), expiration+l.opts.MaxLockDuration)
+	}
+	_, err := pipe.Exec(ctx)
+
+	return err
+}
+
+// backoff returns the duration of the level-th lock.
+func backoff(base, limit time.Duration, level int64) time.Duration {
+	d := base
+	for i := int64(1); i < level && d < limit; i++ {
+		d *= 2
+	}
+
+	return min(d, limit)
+}
+
+func failKey(kind, id string) string { return fmt.Sprintf("%sfail_%s_%s", keyPrefix, kind, id) }
+
+func lockKey(kind, id string) string { return fmt.Sprintf("%slock_%s_%s", keyPrefix, kind, id) }
+
+func levelKey(username string) string { return fmt.Sprintf("%slevel_user_%s", keyPrefix, username) }
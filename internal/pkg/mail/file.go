@@ -0,0 +1,35 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// fileMailer writes every mail to an .eml file in a directory, where it can be
+// opened with a mail client during development.
+type fileMailer struct {
+	opts *options.MailOptions
+}
+
+// Send writes msg to a new file in the directory.
+func (m *fileMailer) Send(ctx context.Context, msg *Message) error {
+	data, err := encode(m.opts.From, msg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(m.opts.Dir, 0o755); err != nil {
+		return err
+	}
+
+	// The timestamp keeps the files in the order they were sent.
+	name := fmt.Sprintf("%s-%s.eml", time.Now().Format("20060102T150405.000000000"), uuid.New().String()[:8])
+	return os.WriteFile(filepath.Join(m.opts.Dir, name), data, 0o600)
+}
@@ -0,0 +1,100 @@
+// Package mail sends the mails of the server, such as the password reset and
+// email verification links.
+// Mails are sent through an SMTP server, or written to files or kept in memory
+// during development and tests.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/google/wire"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the mailer.
+var ProviderSet = wire.NewSet(New)
+
+// Message is a plain text mail.
+type Message struct {
+	// To is the address of the recipient.
+	To string
+	// Subject is the subject of the mail.
+	Subject string
+	// Body is the plain text content of the mail.
+	Body string
+}
+
+// Mailer sends mails.
+type Mailer interface {
+	// Send delivers msg to its recipient.
+	Send(ctx context.Context, msg *Message) error
+}
+
+// New creates the Mailer of the configured driver.
+func New(opts *options.MailOptions) (Mailer, error) {
+	switch opts.Driver {
+	case options.MailDriverSMTP:
+		return &smtpMailer{opts: opts}, nil
+	case options.MailDriverFile:
+		return &fileMailer{opts: opts}, nil
+	case options.MailDriverMemory:
+		return &MemoryMailer{}, nil
+	}
+
+	return nil, fmt.Errorf("unknown mail driver %q", opts.Driver)
+}
+
+// TokenLink appends token to a page of the frontend as the token query
+// parameter, which also works with the hash mode of the frontend router.
+func TokenLink(page, token string) string {
+	sep := "?"
+	if strings.Contains(page, "?") {
+		sep = "&"
+	}
+
+	return page + sep + "token=" + url.QueryEscape(token)
+}
+
+// encode formats msg as an RFC 5322 message sent by from.
+func encode(from string, msg *Message) ([]byte, error) {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "From: %s\r\n", (&mail.Address{Address: from}).String())
+	fmt.Fprintf(&buf, "To: %s\r\n", (&mail.Address{Address: msg.To}).String())
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", msg.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "Message-ID: <%s@%s>\r\n", uuid.New().String(), domain(from))
+	buf.WriteString("MIME-Version: 1.0\r\n")
+	buf.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	buf.WriteString("Content-Transfer-Encoding: quoted-printable\r\n\r\n")
+
+	w := quotedprintable.NewWriter(&buf)
+	if _, err := w.Write([]byte(msg.Body)); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// domain returns the domain of an address.
+func domain(address string) string {
+	for i := len(address) - 1; i >= 0; i-- {
+		if address[i] == '@' {
+			return address[i+1:]
+		}
+	}
+
+	return "localhost"
+}
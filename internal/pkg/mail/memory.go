@@ -0,0 +1,32 @@
+package mail
+
+import (
+	"context"
+	"sync"
+)
+
+// MemoryMailer keeps the sent mails in memory, so that tests can inspect them.
+type MemoryMailer struct {
+	mu       sync.Mutex
+	messages []*Message
+}
+
+// Ensure MemoryMailer implements Mailer.
+var _ Mailer = (*MemoryMailer)(nil)
+
+// Send records msg.
+func (m *MemoryMailer) Send(ctx context.Context, msg *Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the mails sent so far, oldest first.
+func (m *MemoryMailer) Messages() []*Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]*Message(nil), m.messages...)
+}
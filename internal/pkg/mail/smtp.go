@@ -0,0 +1,88 @@
+package mail
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// smtpMailer sends mails through an SMTP server.
+type smtpMailer struct {
+	opts *options.MailOptions
+}
+
+// Send delivers msg through the SMTP server.
+func (m *smtpMailer) Send(ctx context.Context, msg *Message) error {
+	data, err := encode(m.opts.From, msg)
+	if err != nil {
+		return err
+	}
+
+	conn, err := m.dial(ctx)
+	if err != nil {
+		return err
+	}
+	// The deadline bounds the whole conversation, not only the connection.
+	_ = conn.SetDeadline(time.Now().Add(m.opts.Timeout))
+
+	c, err := smtp.NewClient(conn, m.opts.Host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+
+	if !m.opts.TLS {
+		if ok, _ := c.Extension("STARTTLS"); ok {
+			if err := c.StartTLS(m.tlsConfig()); err != nil {
+				return err
+			}
+		}
+	}
+	if m.opts.Username != "" {
+		// PlainAuth refuses to send the password over an unencrypted connection, except to localhost.
+		if err := c.Auth(smtp.PlainAuth("", m.opts.Username, m.opts.Password, m.opts.Host)); err != nil {
+			return err
+		}
+	}
+
+	if err := c.Mail(m.opts.From); err != nil {
+		return err
+	}
+	if err := c.Rcpt(msg.To); err != nil {
+		return err
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+
+	return c.Quit()
+}
+
+// dial connects to the SMTP server, over TLS if configured.
+func (m *smtpMailer) dial(ctx context.Context) (net.Conn, error) {
+	addr := net.JoinHostPort(m.opts.Host, strconv.Itoa(m.opts.Port))
+	dialer := &net.Dialer{Timeout: m.opts.Timeout}
+	if m.opts.TLS {
+		return (&tls.Dialer{NetDialer: dialer, Config: m.tlsConfig()}).DialContext(ctx, "tcp", addr)
+	}
+
+	return dialer.DialContext(ctx, "tcp", addr)
+}
+
+// tlsConfig returns the TLS configuration of the connection to the SMTP server.
+func (m *smtpMailer) tlsConfig() *tls.Config {
+	return &tls.Config{ServerName: m.opts.Host, InsecureSkipVerify: m.opts.InsecureSkipVerify} //nolint:gosec
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/log"
+
+	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+)
+
+// AuditRecorder 用于写入审计日志的接口.
+type AuditRecorder interface {
+	// Record 写入一条审计日志
+	Record(ctx context.Context, auditLog *model.AuditLogM) error
+}
+
+// AuditMiddleware 包装认证中间件 authn，记录管理员模拟登录期间执行的每一个请求，
+// 包括被授权中间件拒绝的请求。authn 会调用 c.Next 执行后续的处理函数，
+// 因此 authn 返回时请求已经处理完成，可以记录响应的状态码
+func AuditMiddleware(recorder AuditRecorder, authn gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		authn(c)
+
+		// 认证失败时上下文中没有用户，普通用户的请求不需要审计
+		ctx := c.Request.Context()
+		if !contextx.Impersonated(ctx) {
+			return
+		}
+
+		auditLog := &model.AuditLogM{
+			UserID:     contextx.UserID(ctx),
+			ActorID:    contextx.ActorID(ctx),
+			Action:     c.Request.Method + " " + c.FullPath(),
+			Path:       c.Request.URL.Path,
+			StatusCode: int32(c.Writer.Status()),
+			IP:         contextx.ClientIP(ctx),
+			SessionID:  contextx.SessionID(ctx),
+		}
+		if err := recorder.Record(ctx, auditLog); err != nil {
+			log.W(ctx).Errorw(err, "Failed to record audit log", "userID", auditLog.UserID, "actorID", auditLog.ActorID, "action", auditLog.Action)
+		}
+	}
+}
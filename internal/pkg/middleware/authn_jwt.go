@@ -9,6 +9,7 @@ import (
 	"github.com/go-kratos/kratos/v2/errors"
 
 	"github.com/moweilong/art-design-pro-go/internal/apiserver/model"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
 	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
 	"github.com/moweilong/milady/pkg/authn"
@@ -37,49 +38,72 @@ type UserRetriever interface {
 	GetUser(ctx context.Context, userID string) (*model.UserM, error)
 }
 
-// AuthnJWT 是Gin框架的JWT认证中间件
+// AccessTokenVerifier 用于校验访问令牌的接口.
+type AccessTokenVerifier interface {
+	// Verify 校验访问令牌，成功时返回令牌所属的用户ID
+	Verify(ctx context.Context, accessToken string) (string, error)
+}
+
+// AuthnMiddleware 是Gin框架的JWT认证中间件，只接受访问令牌
 // 功能与Kratos版本的Server中间件相同，但适配Gin框架
-func AuthnMiddleware(a authn.Authenticator, retriever UserRetriever) gin.HandlerFunc {
+func AuthnMiddleware(v AccessTokenVerifier, retriever UserRetriever) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		// 从请求头获取Authorization
-		authHeader := c.GetHeader(authorizationKey)
-		if authHeader == "" {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"code":    401,
-				"message": "JWT token is missing",
-			})
+		accessToken, ok := bearerToken(c)
+		if !ok {
 			return
 		}
 
-		// 解析Bearer Token
-		auths := strings.SplitN(authHeader, " ", 2)
-		if len(auths) != 2 || !strings.EqualFold(auths[0], bearerWord) {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
-				"code":    401,
-				"message": "Invalid authorization format",
-			})
+		// 校验访问令牌。刷新令牌会被拒绝
+		userID, err := v.Verify(c.Request.Context(), accessToken)
+		if err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
 			return
 		}
 
-		accessToken := auths[1]
-		ctx := c.Request.Context()
-
-		// 解析JWT声明
-		claims, err := a.ParseClaims(ctx, accessToken)
+		user, err := retriever.GetUser(c, userID)
 		if err != nil {
-			// 处理错误情况
-			statusCode := http.StatusUnauthorized
-			message := "Invalid token"
+			core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error()))
+			c.Abort()
+			return
+		}
 
-			// 根据错误类型提供更具体的错误信息
-			if se, ok := err.(*errors.Error); ok {
-				message = se.Message
+		// 将信息注入上下文
+		// 1. 注入到Gin上下文
+		c.Set("userID", user.UserID)
+		c.Set("accessToken", accessToken)
+
+		// 2. 同时更新请求上下文，保持与原有逻辑一致
+		ctx := contextx.WithUserID(c.Request.Context(), user.UserID)
+		ctx = contextx.WithAccessToken(ctx, accessToken)
+		// 记录令牌所属的登录会话，以及模拟登录时实际操作的管理员
+		if claims, err := auth.ParseUnverified(accessToken); err == nil {
+			ctx = contextx.WithSessionID(ctx, claims.SessionID)
+			if actorID := claims.ActorID(); actorID != "" {
+				c.Set("actorID", actorID)
+				ctx = contextx.WithActorID(ctx, actorID)
 			}
+		}
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
 
-			c.AbortWithStatusJSON(statusCode, gin.H{
-				"code":    statusCode,
-				"message": message,
-			})
+// RefreshAuthnMiddleware 是刷新令牌接口使用的认证中间件，只接受刷新令牌
+func RefreshAuthnMiddleware(a authn.Authenticator, retriever UserRetriever) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		refreshToken, ok := bearerToken(c)
+		if !ok {
+			return
+		}
+
+		// 解析JWT声明。访问令牌会被拒绝
+		ctx := c.Request.Context()
+		claims, err := a.ParseClaims(ctx, refreshToken)
+		if err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
 			return
 		}
 
@@ -91,23 +115,55 @@ func AuthnMiddleware(a authn.Authenticator, retriever UserRetriever) gin.Handler
 		}
 
 		// 将信息注入上下文
-		// 1. 注入到Gin上下文
 		c.Set("userID", user.UserID)
-		c.Set("accessToken", accessToken)
 		c.Set("claims", claims)
 
-		// 2. 同时更新请求上下文，保持与原有逻辑一致
 		newCtx := contextx.WithClaims(ctx, claims)
 		newCtx = contextx.WithUserID(newCtx, user.UserID)
-		newCtx = contextx.WithAccessToken(newCtx, accessToken)
+		newCtx = contextx.WithRefreshToken(newCtx, refreshToken)
+		newCtx = contextx.WithSessionID(newCtx, sessionID(refreshToken))
 		c.Request = c.Request.WithContext(newCtx)
 
 		c.Next()
 	}
 }
 
+// bearerToken 从请求头中获取Bearer Token，获取失败时终止请求并返回false
+func bearerToken(c *gin.Context) (string, bool) {
+	// 从请求头获取Authorization
+	authHeader := c.GetHeader(authorizationKey)
+	if authHeader == "" {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":    401,
+			"message": "JWT token is missing",
+		})
+		return "", false
+	}
+
+	// 解析Bearer Token
+	auths := strings.SplitN(authHeader, " ", 2)
+	if len(auths) != 2 || !strings.EqualFold(auths[0], bearerWord) {
+		c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{
+			"code":    401,
+			"message": "Invalid authorization format",
+		})
+		return "", false
+	}
+
+	return auths[1], true
+}
+
+// sessionID 返回已校验令牌所属的登录会话ID
+func sessionID(token string) string {
+	claims, err := auth.ParseUnverified(token)
+	if err != nil {
+		return ""
+	}
+	return claims.SessionID
+}
+
 // AuthnJWTSkip 允许跳过某些路径的JWT认证
-func AuthnJWTSkip(a authn.Authenticator, retriever UserRetriever, skipPaths ...string) gin.HandlerFunc {
+func AuthnJWTSkip(v AccessTokenVerifier, retriever UserRetriever, skipPaths ...string) gin.HandlerFunc {
 	// 创建跳过路径的映射
 	skipPathMap := make(map[string]struct{})
 	for _, path := range skipPaths {
@@ -122,7 +178,7 @@ func AuthnJWTSkip(a authn.Authenticator, retriever UserRetriever, skipPaths ...s
 		}
 
 		// 否则执行认证中间件
-		authnJWT := AuthnMiddleware(a, retriever)
+		authnJWT := AuthnMiddleware(v, retriever)
 		authnJWT(c)
 	}
 }
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/auth"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+)
+
+// RequestVerifier 用于校验 AK/SK 请求签名的接口.
+type RequestVerifier interface {
+	// VerifyRequest 校验请求签名，成功时返回密钥所属的用户ID
+	VerifyRequest(rq *http.Request) (string, error)
+}
+
+// SignatureAuthnMiddleware 是 AK/SK 请求签名认证中间件，供机器客户端和脚本使用
+// 使用 auth.SignatureAlgorithm 签名的请求由 v 校验，其余请求交给 next 处理（通常为 JWT 认证中间件）
+func SignatureAuthnMiddleware(v RequestVerifier, retriever UserRetriever, next gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !strings.HasPrefix(c.GetHeader(authorizationKey), auth.SignatureAlgorithm+" ") {
+			next(c)
+			return
+		}
+
+		// 校验签名、时间戳和 nonce，并通过 SecretID 解析出用户
+		userID, err := v.VerifyRequest(c.Request)
+		if err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
+			return
+		}
+
+		user, err := retriever.GetUser(c, userID)
+		if err != nil {
+			core.WriteResponse(c, nil, errno.ErrUnauthenticated.WithMessage("%s", err.Error()))
+			c.Abort()
+			return
+		}
+
+		// 将信息注入上下文，签名请求没有访问令牌
+		c.Set("userID", user.UserID)
+		c.Request = c.Request.WithContext(contextx.WithUserID(c.Request.Context(), user.UserID))
+
+		c.Next()
+	}
+}
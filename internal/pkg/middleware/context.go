@@ -15,6 +15,10 @@ func Context() gin.HandlerFunc {
 
 		// 将 traceID 存储到新的 context 中，并更新请求的 context
 		ctx := contextx.WithTraceID(c.Request.Context(), traceID)
+		// 记录客户端 IP，用于登录失败次数统计等
+		ctx = contextx.WithClientIP(ctx, c.ClientIP())
+		// 记录客户端 User-Agent，用于登录会话的设备信息
+		ctx = contextx.WithUserAgent(ctx, c.Request.UserAgent())
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
@@ -0,0 +1,28 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/i18n"
+	"golang.org/x/text/language"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+)
+
+// I18n 是一个 Gin 中间件，根据请求头 Accept-Language 选择语言，并将翻译器注入到请求的 context 中.
+func I18n() gin.HandlerFunc {
+	// 语言文件只加载一次，每个请求只切换语言
+	translator := i18n.New(i18n.WithFS(locales.Locales), i18n.WithLanguage(language.English))
+
+	return func(c *gin.Context) {
+		tags, _, _ := language.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+		lang := language.Und
+		if len(tags) > 0 {
+			lang = tags[0]
+		}
+
+		ctx := i18n.WithContext(c.Request.Context(), translator.Select(lang))
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,32 @@
+package middleware
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/moweilong/milady/pkg/core"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/contextx"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/errno"
+)
+
+// RoleRetriever 用于获取用户角色的接口.
+type RoleRetriever interface {
+	// GetImplicitRolesForUser 获取用户的全部角色，包括通过其他角色间接获得的角色
+	GetImplicitRolesForUser(name string, domain ...string) ([]string, error)
+}
+
+// RoleMiddleware 将用户的角色注入上下文，store 层根据角色的数据权限过滤查询结果.
+// 必须位于认证中间件之后
+func RoleMiddleware(retriever RoleRetriever) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+		roles, err := retriever.GetImplicitRolesForUser(contextx.UserID(ctx))
+		if err != nil {
+			core.WriteResponse(c, nil, errno.ErrInternal.WithMessage("%s", err.Error()))
+			c.Abort()
+			return
+		}
+
+		c.Request = c.Request.WithContext(contextx.WithRoles(ctx, roles))
+		c.Next()
+	}
+}
@@ -0,0 +1,198 @@
+// Package oidc implements the OpenID Connect authorization code flow with PKCE
+// against the configured identity providers. The state of a pending login is
+// kept in Redis between the redirect to the provider and the callback, together
+// with a value held by the browser that started it, so that a callback can not
+// be replayed in another browser to log it in as someone else.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+	"golang.org/x/oauth2"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the OIDC single sign-on.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(OIDC), new(*oidcImpl)))
+
+const (
+	// keyPrefix is prepended to the login states to build the Redis keys.
+	keyPrefix = "oidc_state_"
+	// httpTimeout bounds the requests sent to the identity providers.
+	httpTimeout = 10 * time.Second
+)
+
+var (
+	// ErrProviderNotFound is returned when no identity provider has the requested name.
+	ErrProviderNotFound = errors.New("oidc provider not found")
+	// ErrInvalidState is returned when the state of a callback is unknown,
+	// expired, already used, belongs to another provider or was issued to another browser.
+	ErrInvalidState = errors.New("invalid or expired oidc state")
+)
+
+// Identity is the user authenticated by an identity provider.
+type Identity struct {
+	// Provider is the name of the identity provider.
+	Provider string
+	// Subject identifies the user at the identity provider, it never changes.
+	Subject string
+	// Username is the value of the configured username claim, it may be empty.
+	Username string
+	Email    string
+	Name     string
+}
+
+// OIDC runs the authorization code flow against the configured identity providers.
+type OIDC interface {
+	// Providers returns the configured identity providers.
+	Providers() []options.OIDCProviderOptions
+	// AuthCodeURL starts a login and returns the URL of the identity provider the browser is redirected to.
+	// binding is a secret held by the browser, the callback must present it again.
+	AuthCodeURL(ctx context.Context, provider, binding string) (string, error)
+	// Exchange completes the login started with state, exchanges code for an ID
+	// token and returns the identity it asserts. A state can only be used once,
+	// and only with the binding it was started with.
+	Exchange(ctx context.Context, provider, code, state, binding string) (*Identity, error)
+}
+
+// oidcImpl is a Redis backed OIDC.
+type oidcImpl struct {
+	cli       *redis.Client
+	opts      *options.OIDCOptions
+	providers map[string]*provider
+}
+
+// Ensure oidcImpl implements OIDC.
+var _ OIDC = (*oidcImpl)(nil)
+
+// loginState is what is remembered of a login between the redirect and the callback.
+type loginState struct {
+	Provider string `json:"provider"`
+	Verifier string `json:"verifier"`
+	Nonce    string `json:"nonce"`
+	// Binding is the hash of the value held by the browser that started the login.
+	Binding string `json:"binding"`
+}
+
+// New creates a Redis backed OIDC. The provider metadata is discovered on first use,
+// so that an unavailable identity provider does not prevent the server from starting.
+func New(opts *options.OIDCOptions, redisOpts *genericoptions.RedisOptions) (*oidcImpl, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	client := &http.Client{Timeout: httpTimeout}
+	providers := make(map[string]*provider, len(opts.Providers))
+	for _, p := range opts.Providers {
+		providers[p.Name] = newProvider(p, client)
+	}
+
+	return &oidcImpl{cli: cli, opts: opts, providers: providers}, nil
+}
+
+// Providers returns the configured identity providers.
+func (o *oidcImpl) Providers() []options.OIDCProviderOptions {
+	return o.opts.Providers
+}
+
+// AuthCodeURL starts a login at the named provider.
+func (o *oidcImpl) AuthCodeURL(ctx context.Context, name, binding string) (string, error) {
+	p, ok := o.providers[name]
+	if !ok {
+		return "", ErrProviderNotFound
+	}
+	if binding == "" {
+		return "", errors.New("oidc login is not bound to a browser")
+	}
+
+	cfg, err := p.config(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	state, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	nonce, err := randomString()
+	if err != nil {
+		return "", err
+	}
+	ls := loginState{Provider: name, Verifier: oauth2.GenerateVerifier(), Nonce: nonce, Binding: hashBinding(binding)}
+	data, _ := json.Marshal(&ls)
+	if err := o.cli.Set(ctx, keyPrefix+state, data, o.opts.StateExpiration).Err(); err != nil {
+		return "", err
+	}
+
+	return cfg.AuthCodeURL(state, oauth2.S256ChallengeOption(ls.Verifier), oauth2.SetAuthURLParam("nonce", nonce)), nil
+}
+
+// Exchange completes a login at the named provider.
+func (o *oidcImpl) Exchange(ctx context.Context, name, code, state, binding string) (*Identity, error) {
+	p, ok := o.providers[name]
+	if !ok {
+		return nil, ErrProviderNotFound
+	}
+
+	data, err := o.cli.GetDel(ctx, keyPrefix+state).Bytes()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, ErrInvalidState
+		}
+		return nil, err
+	}
+	var ls loginState
+	if err := json.Unmarshal(data, &ls); err != nil || ls.Provider != name {
+		return nil, ErrInvalidState
+	}
+	// The state is consumed even if the binding does not match, a leaked callback URL can not be retried.
+	if binding == "" || subtle.ConstantTimeCompare([]byte(ls.Binding), []byte(hashBinding(binding))) != 1 {
+		return nil, ErrInvalidState
+	}
+
+	cfg, err := p.config(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := cfg.Exchange(context.WithValue(ctx, oauth2.HTTPClient, p.client), code, oauth2.VerifierOption(ls.Verifier))
+	if err != nil {
+		return nil, fmt.Errorf("exchange authorization code: %w", err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || rawIDToken == "" {
+		return nil, errors.New("token response does not contain an id_token")
+	}
+
+	return p.verify(ctx, rawIDToken, ls.Nonce)
+}
+
+// hashBinding hashes the value held by the browser, so that it is not stored in Redis.
+func hashBinding(binding string) string {
+	sum := sha256.Sum256([]byte(binding))
+	return hex.EncodeToString(sum[:])
+}
+
+// randomString returns a random hex string suitable for states and nonces.
+func randomString() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,205 @@
+package oidc
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+const (
+	// defaultUsernameClaim is used when the provider does not configure a username claim.
+	defaultUsernameClaim = "preferred_username"
+	// keysRefreshInterval limits how often the keys are fetched again for an unknown kid.
+	keysRefreshInterval = time.Minute
+)
+
+// signingMethods lists the algorithms accepted for ID tokens. The none and
+// HMAC algorithms are refused, ID tokens must be signed with the provider keys.
+var signingMethods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512", "EdDSA"}
+
+// metadata is the subset of the provider metadata used by the authorization code flow.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// provider is an identity provider whose metadata and keys are fetched lazily.
+type provider struct {
+	opts   options.OIDCProviderOptions
+	client *http.Client
+
+	mu        sync.Mutex
+	meta      *metadata
+	keys      map[string]any
+	fetchedAt time.Time
+}
+
+func newProvider(opts options.OIDCProviderOptions, client *http.Client) *provider {
+	return &provider{opts: opts, client: client}
+}
+
+// config returns the OAuth2 configuration of the provider, discovering its metadata if needed.
+func (p *provider) config(ctx context.Context) (*oauth2.Config, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &oauth2.Config{
+		ClientID:     p.opts.ClientID,
+		ClientSecret: p.opts.ClientSecret,
+		RedirectURL:  p.opts.RedirectURL,
+		Scopes:       append([]string{"openid"}, p.opts.Scopes...),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  meta.AuthorizationEndpoint,
+			TokenURL: meta.TokenEndpoint,
+		},
+	}, nil
+}
+
+// metadata discovers the provider metadata once. Failures are not cached, the
+// discovery is retried by the next login.
+func (p *provider) metadata(ctx context.Context) (*metadata, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.meta != nil {
+		return p.meta, nil
+	}
+
+	var meta metadata
+	if err := p.get(ctx, strings.TrimSuffix(p.opts.Issuer, "/")+"/.well-known/openid-configuration", &meta); err != nil {
+		return nil, fmt.Errorf("discover oidc provider %s: %w", p.opts.Name, err)
+	}
+	// The issuer of the metadata must be the configured one, see OpenID Connect Discovery 1.0 section 4.3.
+	if meta.Issuer != p.opts.Issuer {
+		return nil, fmt.Errorf("oidc provider %s: issuer %q does not match the configured issuer %q", p.opts.Name, meta.Issuer, p.opts.Issuer)
+	}
+	if meta.AuthorizationEndpoint == "" || meta.TokenEndpoint == "" || meta.JWKSURI == "" {
+		return nil, fmt.Errorf("oidc provider %s: incomplete metadata", p.opts.Name)
+	}
+
+	p.meta = &meta
+	return p.meta, nil
+}
+
+// key returns the public key identified by kid, fetching the keys of the
+// provider again when kid is unknown, since the provider may have rotated them.
+func (p *provider) key(ctx context.Context, kid string) (any, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	if time.Since(p.fetchedAt) < keysRefreshInterval {
+		return nil, fmt.Errorf("unknown key id %q", kid)
+	}
+
+	var set jsonWebKeySet
+	if err := p.get(ctx, meta.JWKSURI, &set); err != nil {
+		return nil, fmt.Errorf("fetch keys of oidc provider %s: %w", p.opts.Name, err)
+	}
+	p.keys, p.fetchedAt = set.publicKeys(), time.Now()
+
+	if key, ok := p.lookup(kid); ok {
+		return key, nil
+	}
+	return nil, fmt.Errorf("unknown key id %q", kid)
+}
+
+// lookup returns the key identified by kid. Tokens without a kid can only be
+// verified when the provider publishes a single key.
+func (p *provider) lookup(kid string) (any, bool) {
+	if kid == "" && len(p.keys) == 1 {
+		for _, key := range p.keys {
+			return key, true
+		}
+	}
+
+	key, ok := p.keys[kid]
+	return key, ok
+}
+
+// verify validates the signature, issuer, audience, lifetime and nonce of the
+// ID token, and returns the identity it asserts.
+func (p *provider) verify(ctx context.Context, rawIDToken, nonce string) (*Identity, error) {
+	meta, err := p.metadata(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := jwt.MapClaims{}
+	parser := &jwt.Parser{ValidMethods: signingMethods}
+	if _, err := parser.ParseWithClaims(rawIDToken, claims, func(token *jwt.Token) (any, error) {
+		kid, _ := token.Header["kid"].(string)
+		return p.key(ctx, kid)
+	}); err != nil {
+		return nil, fmt.Errorf("invalid id_token: %w", err)
+	}
+
+	if !claims.VerifyIssuer(meta.Issuer, true) {
+		return nil, errors.New("invalid id_token: unexpected issuer")
+	}
+	if !claims.VerifyAudience(p.opts.ClientID, true) {
+		return nil, errors.New("invalid id_token: unexpected audience")
+	}
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.New("invalid id_token: missing expiration")
+	}
+	if got, _ := claims["nonce"].(string); got != nonce {
+		return nil, errors.New("invalid id_token: unexpected nonce")
+	}
+
+	identity := &Identity{Provider: p.opts.Name}
+	identity.Subject, _ = claims["sub"].(string)
+	if identity.Subject == "" {
+		return nil, errors.New("invalid id_token: missing subject")
+	}
+	usernameClaim := p.opts.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = defaultUsernameClaim
+	}
+	identity.Username, _ = claims[usernameClaim].(string)
+	identity.Email, _ = claims["email"].(string)
+	identity.Name, _ = claims["name"].(string)
+
+	return identity, nil
+}
+
+// get fetches a JSON document of the provider.
+func (p *provider) get(ctx context.Context, url string, v any) error {
+	rq, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := p.client.Do(rq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %s", url, resp.Status)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
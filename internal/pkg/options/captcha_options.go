@@ -0,0 +1,76 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*CaptchaOptions)(nil)
+
+// CaptchaOptions contains the options of the image captcha on login and registration.
+type CaptchaOptions struct {
+	// EnableLogin enforces the captcha on login.
+	EnableLogin bool `json:"enable-login" mapstructure:"enable-login"`
+	// LoginFailures is the number of failed logins of a username or client IP
+	// after which the captcha is enforced. Zero enforces it on every login.
+	LoginFailures int `json:"login-failures" mapstructure:"login-failures"`
+	// EnableRegister enforces the captcha when a user registers.
+	EnableRegister bool `json:"enable-register" mapstructure:"enable-register"`
+	// Length is the number of digits of the captcha.
+	Length int `json:"length" mapstructure:"length"`
+	// Width and Height are the size of the captcha image in pixels.
+	Width  int `json:"width" mapstructure:"width"`
+	Height int `json:"height" mapstructure:"height"`
+	// Expiration is how long a captcha can be answered.
+	Expiration time.Duration `json:"expiration" mapstructure:"expiration"`
+}
+
+// NewCaptchaOptions creates a CaptchaOptions with default values.
+func NewCaptchaOptions() *CaptchaOptions {
+	return &CaptchaOptions{
+		LoginFailures: 3,
+		Length:        4,
+		Width:         120,
+		Height:        40,
+		Expiration:    5 * time.Minute,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *CaptchaOptions) Validate() []error {
+	var errs []error
+	if o.LoginFailures < 0 {
+		errs = append(errs, fmt.Errorf("--captcha.login-failures can not be negative"))
+	}
+	if o.Length < 1 || o.Length > 8 {
+		errs = append(errs, fmt.Errorf("--captcha.length must be between 1 and 8"))
+	}
+	if o.Width < o.Length*10 || o.Height < 20 {
+		errs = append(errs, fmt.Errorf("--captcha.width must be at least 10 pixels per digit and --captcha.height at least 20 pixels"))
+	}
+	if o.Expiration <= 0 {
+		errs = append(errs, fmt.Errorf("--captcha.expiration must be greater than 0"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the captcha to the specified FlagSet.
+func (o *CaptchaOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.BoolVar(&o.EnableLogin, "captcha.enable-login", o.EnableLogin, "Enforce the captcha on login.")
+	fs.IntVar(&o.LoginFailures, "captcha.login-failures", o.LoginFailures, ""+
+		"Number of failed logins after which the captcha is enforced on login, 0 enforces it on every login.")
+	fs.BoolVar(&o.EnableRegister, "captcha.enable-register", o.EnableRegister, "Enforce the captcha when a user registers.")
+	fs.IntVar(&o.Length, "captcha.length", o.Length, "Number of digits of the captcha.")
+	fs.IntVar(&o.Width, "captcha.width", o.Width, "Width of the captcha image in pixels.")
+	fs.IntVar(&o.Height, "captcha.height", o.Height, "Height of the captcha image in pixels.")
+	fs.DurationVar(&o.Expiration, "captcha.expiration", o.Expiration, "How long a captcha can be answered.")
+}
@@ -0,0 +1,53 @@
+package options
+
+import (
+	"fmt"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*ExtAuthzOptions)(nil)
+
+// ExtAuthzOptions contains the options of the gRPC server which implements the
+// Envoy ext_authz Check API. The forward-auth endpoint of the REST API, used by
+// nginx auth_request and Traefik, is always available.
+type ExtAuthzOptions struct {
+	// Enabled starts the gRPC server alongside the HTTP server.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Addr is the address the gRPC server listens on.
+	Addr string `json:"addr" mapstructure:"addr"`
+}
+
+// NewExtAuthzOptions creates a ExtAuthzOptions with default values.
+func NewExtAuthzOptions() *ExtAuthzOptions {
+	return &ExtAuthzOptions{
+		Enabled: false,
+		Addr:    "0.0.0.0:5556",
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *ExtAuthzOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if err := genericoptions.ValidateAddress(o.Addr); err != nil {
+		errs = append(errs, fmt.Errorf("--ext-authz.addr: %w", err))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the Envoy ext_authz server to the specified FlagSet.
+func (o *ExtAuthzOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.BoolVar(&o.Enabled, "ext-authz.enabled", o.Enabled, "Start the gRPC server of the Envoy ext_authz Check API.")
+	fs.StringVar(&o.Addr, "ext-authz.addr", o.Addr, "The address the gRPC server of the Envoy ext_authz Check API listens on.")
+}
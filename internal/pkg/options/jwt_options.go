@@ -0,0 +1,193 @@
+package options
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*JWTOptions)(nil)
+
+// asymmetricSigningMethods lists the signing methods which use a PEM encoded private key.
+var asymmetricSigningMethods = []string{"RS256", "ES256", "EdDSA"}
+
+// symmetricSigningMethods lists the signing methods which use a shared secret.
+var symmetricSigningMethods = []string{"HS256", "HS384", "HS512"}
+
+// JWTOptions extends genericoptions.JWTOptions with the keys used for asymmetric
+// signing and the lifetime of the refresh tokens. Expired is the lifetime of the
+// access tokens, and MaxRefresh the absolute lifetime of a login session, after
+// which its refresh tokens can no longer be rotated and the user must log in again.
+type JWTOptions struct {
+	genericoptions.JWTOptions `json:",inline" mapstructure:",squash"`
+
+	// RefreshExpired is the lifetime of a refresh token. Each refresh rotates it,
+	// so that an active session lasts until MaxRefresh has passed since the login.
+	RefreshExpired time.Duration `json:"refresh-expired" mapstructure:"refresh-expired"`
+
+	// KeyID is the `kid` header of the signed tokens, it is also the key id published in the JWKS.
+	KeyID string `json:"key-id" mapstructure:"key-id"`
+	// PrivateKeyFile is the PEM encoded private key used by RS256, ES256 and EdDSA.
+	PrivateKeyFile string `json:"private-key-file" mapstructure:"private-key-file"`
+	// Keys lists the keys used for key rotation. When set, it takes precedence
+	// over Key, KeyID, PrivateKeyFile and SigningMethod.
+	Keys []JWTKeyOptions `json:"keys" mapstructure:"keys"`
+}
+
+// JWTKeyOptions describes one of the keys that tokens are signed or verified with.
+type JWTKeyOptions struct {
+	// ID is the `kid` header of the tokens signed by the key.
+	ID string `json:"kid" mapstructure:"kid"`
+	// State is either active or verify-only. Exactly one key must be active.
+	State string `json:"state" mapstructure:"state"`
+	// SigningMethod is one of HS256, HS384, HS512, RS256, ES256 and EdDSA.
+	SigningMethod string `json:"signing-method" mapstructure:"signing-method"`
+	// Secret is the shared secret of the HMAC signing methods.
+	Secret string `json:"secret" mapstructure:"secret"`
+	// PrivateKeyFile is the PEM encoded private key used by RS256, ES256 and EdDSA.
+	PrivateKeyFile string `json:"private-key-file" mapstructure:"private-key-file"`
+	// NotAfter is the RFC 3339 time after which the key retires, empty means never.
+	NotAfter string `json:"not-after" mapstructure:"not-after"`
+}
+
+const (
+	// KeyStateActive marks the key that new tokens are signed with.
+	KeyStateActive = "active"
+	// KeyStateVerifyOnly marks a key that is only used to verify tokens.
+	KeyStateVerifyOnly = "verify-only"
+)
+
+// IsAsymmetric reports whether the key is a private key instead of a shared secret.
+func (k *JWTKeyOptions) IsAsymmetric() bool {
+	return slices.Contains(asymmetricSigningMethods, k.SigningMethod)
+}
+
+// Expiry returns the parsed NotAfter, or the zero time if the key never retires.
+func (k *JWTKeyOptions) Expiry() (time.Time, error) {
+	if k.NotAfter == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, k.NotAfter)
+}
+
+// Validate checks the key options, the key is referred to by its position in the list.
+func (k *JWTKeyOptions) Validate(i int) []error {
+	var errs []error
+	if k.ID == "" {
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].kid is required", i))
+	}
+	if k.State != KeyStateActive && k.State != KeyStateVerifyOnly {
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].state must be %s or %s", i, KeyStateActive, KeyStateVerifyOnly))
+	}
+	switch {
+	case k.IsAsymmetric() && k.PrivateKeyFile == "":
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].private-key-file is required by %s", i, k.SigningMethod))
+	case !k.IsAsymmetric() && !slices.Contains(symmetricSigningMethods, k.SigningMethod):
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].signing-method %q is not supported", i, k.SigningMethod))
+	case !k.IsAsymmetric() && len(k.Secret) < 6:
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].secret must be at least 6 characters", i))
+	}
+	if _, err := k.Expiry(); err != nil {
+		errs = append(errs, fmt.Errorf("jwt.keys[%d].not-after must be a RFC 3339 time: %w", i, err))
+	}
+
+	return errs
+}
+
+// NewJWTOptions creates a JWTOptions object with default parameters.
+func NewJWTOptions() *JWTOptions {
+	opts := &JWTOptions{JWTOptions: *genericoptions.NewJWTOptions(), RefreshExpired: 24 * time.Hour}
+	opts.MaxRefresh = 7 * 24 * time.Hour
+
+	return opts
+}
+
+// IsAsymmetric reports whether tokens are signed with a private key instead of a shared secret.
+func (s *JWTOptions) IsAsymmetric() bool {
+	return slices.Contains(asymmetricSigningMethods, s.SigningMethod)
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (s *JWTOptions) Validate() []error {
+	errs := s.validateLifetimes()
+	if len(s.Keys) != 0 {
+		return append(errs, s.validateKeys()...)
+	}
+
+	if !s.IsAsymmetric() {
+		return append(errs, s.JWTOptions.Validate()...)
+	}
+
+	if s.KeyID == "" {
+		errs = append(errs, fmt.Errorf("--jwt.key-id is required when --jwt.signing-method is %s", s.SigningMethod))
+	}
+	if s.PrivateKeyFile == "" {
+		errs = append(errs, fmt.Errorf("--jwt.private-key-file is required when --jwt.signing-method is %s", s.SigningMethod))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to features for a specific api server to the
+// specified FlagSet.
+func (s *JWTOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	s.JWTOptions.AddFlags(fs, prefixes...)
+	// Before refresh tokens got a lifetime of their own, --jwt.expired was the lifetime of both tokens.
+	if f := fs.Lookup("jwt.expired"); f != nil {
+		f.Usage = "Lifetime of an access token. It used to be the lifetime of the refresh tokens too, " +
+			"which is now set by --jwt.refresh-expired."
+	}
+	fs.DurationVar(&s.RefreshExpired, "jwt.refresh-expired", s.RefreshExpired, ""+
+		"Lifetime of a refresh token, each refresh issues a new one until --jwt.max-refresh has passed since the login.")
+	fs.StringVar(&s.KeyID, "jwt.key-id", s.KeyID, "Key id (kid) of the tokens signed by the server.")
+	fs.StringVar(&s.PrivateKeyFile, "jwt.private-key-file", s.PrivateKeyFile, ""+
+		"PEM encoded private key used to sign tokens when the signing method is RS256, ES256 or EdDSA.")
+}
+
+// validateLifetimes validates the lifetimes of the tokens and sessions.
+func (s *JWTOptions) validateLifetimes() []error {
+	var errs []error
+	if s.Expired <= 0 {
+		errs = append(errs, fmt.Errorf("--jwt.expired must be greater than 0"))
+	}
+	if s.RefreshExpired <= 0 {
+		errs = append(errs, fmt.Errorf("--jwt.refresh-expired must be greater than 0"))
+	}
+	if s.MaxRefresh < s.Expired {
+		errs = append(errs, fmt.Errorf("--jwt.max-refresh can not be shorter than --jwt.expired"))
+	}
+
+	return errs
+}
+
+// validateKeys validates the key list used for key rotation.
+func (s *JWTOptions) validateKeys() []error {
+	var errs []error
+	ids := make(map[string]bool, len(s.Keys))
+	active := 0
+	for i := range s.Keys {
+		key := &s.Keys[i]
+		errs = append(errs, key.Validate(i)...)
+		if ids[key.ID] {
+			errs = append(errs, fmt.Errorf("jwt.keys[%d].kid %q is duplicated", i, key.ID))
+		}
+		ids[key.ID] = true
+		if key.State == KeyStateActive {
+			active++
+		}
+	}
+	if active != 1 {
+		errs = append(errs, fmt.Errorf("exactly one of jwt.keys must be %s, got %d", KeyStateActive, active))
+	}
+
+	return errs
+}
@@ -0,0 +1,133 @@
+package options
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*LDAPOptions)(nil)
+
+// LDAPOptions contains the options of the LDAP / Active Directory authentication.
+type LDAPOptions struct {
+	// Enabled makes the login check passwords against the directory before the local users.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// URL is the address of the directory, for example ldap://ldap.example.com:389 or ldaps://ldap.example.com:636.
+	URL string `json:"url" mapstructure:"url"`
+	// StartTLS upgrades a ldap:// connection to TLS.
+	StartTLS bool `json:"start-tls" mapstructure:"start-tls"`
+	// InsecureSkipVerify disables the verification of the directory certificate.
+	InsecureSkipVerify bool `json:"insecure-skip-verify" mapstructure:"insecure-skip-verify"`
+	// Timeout limits the connection and every request to the directory.
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+	// BindDN and BindPassword are the service account users are searched with.
+	// Users are searched anonymously if BindDN is empty.
+	BindDN       string `json:"bind-dn" mapstructure:"bind-dn"`
+	BindPassword string `json:"bind-password" mapstructure:"bind-password"`
+	// BaseDN is where users are searched.
+	BaseDN string `json:"base-dn" mapstructure:"base-dn"`
+	// UserFilter finds the user by the login name, %s is replaced by the escaped
+	// username. Use (sAMAccountName=%s) for Active Directory.
+	UserFilter string `json:"user-filter" mapstructure:"user-filter"`
+	// IDAttribute uniquely identifies users across renames, for example entryUUID
+	// or objectGUID. The DN is used if empty.
+	IDAttribute string `json:"id-attribute" mapstructure:"id-attribute"`
+	// UsernameAttribute, EmailAttribute and NameAttribute fill the provisioned users.
+	UsernameAttribute string `json:"username-attribute" mapstructure:"username-attribute"`
+	EmailAttribute    string `json:"email-attribute" mapstructure:"email-attribute"`
+	NameAttribute     string `json:"name-attribute" mapstructure:"name-attribute"`
+	// GroupBaseDN enables searching the groups of a user with GroupFilter, where %s
+	// is replaced by the escaped user DN. Otherwise the groups are read from the
+	// GroupAttribute of the user entry.
+	GroupBaseDN    string `json:"group-base-dn" mapstructure:"group-base-dn"`
+	GroupFilter    string `json:"group-filter" mapstructure:"group-filter"`
+	GroupAttribute string `json:"group-attribute" mapstructure:"group-attribute"`
+	// GroupRoles maps groups to casbin roles. The mapped roles are granted and
+	// revoked on every login to follow the group membership.
+	GroupRoles []LDAPGroupRole `json:"group-roles" mapstructure:"group-roles"`
+	// AutoProvision creates the local user on the first login. Otherwise the
+	// directory user must match an existing local user by username.
+	AutoProvision bool `json:"auto-provision" mapstructure:"auto-provision"`
+}
+
+// LDAPGroupRole grants Role to the members of Group.
+// It is a list rather than a map, since configuration keys are lowercased and split at dots.
+type LDAPGroupRole struct {
+	// Group is the DN of the group, compared case-insensitively.
+	Group string `json:"group" mapstructure:"group"`
+	Role  string `json:"role" mapstructure:"role"`
+}
+
+// NewLDAPOptions creates a LDAPOptions with default values.
+func NewLDAPOptions() *LDAPOptions {
+	return &LDAPOptions{
+		Timeout:           5 * time.Second,
+		UserFilter:        "(uid=%s)",
+		UsernameAttribute: "uid",
+		EmailAttribute:    "mail",
+		NameAttribute:     "cn",
+		GroupFilter:       "(member=%s)",
+		GroupAttribute:    "memberOf",
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *LDAPOptions) Validate() []error {
+	if !o.Enabled {
+		return nil
+	}
+
+	var errs []error
+	if o.URL == "" {
+		errs = append(errs, fmt.Errorf("--ldap.url is required when ldap is enabled"))
+	}
+	if o.BaseDN == "" {
+		errs = append(errs, fmt.Errorf("--ldap.base-dn is required when ldap is enabled"))
+	}
+	if strings.Count(o.UserFilter, "%s") != 1 {
+		errs = append(errs, fmt.Errorf("--ldap.user-filter must contain %%s exactly once"))
+	}
+	if o.GroupBaseDN != "" && strings.Count(o.GroupFilter, "%s") != 1 {
+		errs = append(errs, fmt.Errorf("--ldap.group-filter must contain %%s exactly once"))
+	}
+	for _, gr := range o.GroupRoles {
+		if gr.Group == "" || gr.Role == "" {
+			errs = append(errs, fmt.Errorf("ldap group-roles: group and role are required"))
+		}
+	}
+	if o.Timeout <= 0 {
+		errs = append(errs, fmt.Errorf("--ldap.timeout must be greater than 0"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the LDAP authentication to the specified FlagSet.
+// The group to role mapping can only be set in the configuration file.
+func (o *LDAPOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.BoolVar(&o.Enabled, "ldap.enabled", o.Enabled, "Authenticate users against the LDAP directory before the local users.")
+	fs.StringVar(&o.URL, "ldap.url", o.URL, "Address of the LDAP directory, e.g. ldap://127.0.0.1:389 or ldaps://127.0.0.1:636.")
+	fs.BoolVar(&o.StartTLS, "ldap.start-tls", o.StartTLS, "Upgrade the ldap:// connection to TLS.")
+	fs.BoolVar(&o.InsecureSkipVerify, "ldap.insecure-skip-verify", o.InsecureSkipVerify, "Do not verify the certificate of the LDAP directory.")
+	fs.DurationVar(&o.Timeout, "ldap.timeout", o.Timeout, "Timeout of the connection and the requests to the LDAP directory.")
+	fs.StringVar(&o.BindDN, "ldap.bind-dn", o.BindDN, "DN of the service account users are searched with.")
+	fs.StringVar(&o.BindPassword, "ldap.bind-password", o.BindPassword, "Password of the service account.")
+	fs.StringVar(&o.BaseDN, "ldap.base-dn", o.BaseDN, "Base DN users are searched in.")
+	fs.StringVar(&o.UserFilter, "ldap.user-filter", o.UserFilter, "Filter finding a user by the login name, %s is replaced by the username.")
+	fs.StringVar(&o.IDAttribute, "ldap.id-attribute", o.IDAttribute, "Attribute uniquely identifying users, the DN is used if empty.")
+	fs.StringVar(&o.UsernameAttribute, "ldap.username-attribute", o.UsernameAttribute, "Attribute the username of provisioned users is read from.")
+	fs.StringVar(&o.EmailAttribute, "ldap.email-attribute", o.EmailAttribute, "Attribute the email of provisioned users is read from.")
+	fs.StringVar(&o.NameAttribute, "ldap.name-attribute", o.NameAttribute, "Attribute the nickname of provisioned users is read from.")
+	fs.StringVar(&o.GroupBaseDN, "ldap.group-base-dn", o.GroupBaseDN, "Base DN groups are searched in, the group attribute of the user is used if empty.")
+	fs.StringVar(&o.GroupFilter, "ldap.group-filter", o.GroupFilter, "Filter finding the groups of a user, %s is replaced by the user DN.")
+	fs.StringVar(&o.GroupAttribute, "ldap.group-attribute", o.GroupAttribute, "Attribute of the user entry listing its groups.")
+	fs.BoolVar(&o.AutoProvision, "ldap.auto-provision", o.AutoProvision, "Create the local user on the first login.")
+}
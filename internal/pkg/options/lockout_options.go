@@ -0,0 +1,79 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*LockoutOptions)(nil)
+
+// LockoutOptions contains the options of the brute-force protection on login.
+type LockoutOptions struct {
+	// MaxUserFailures is the number of failed logins of a username within Window
+	// after which the account is locked. Zero disables the per-username limit.
+	MaxUserFailures int `json:"max-user-failures" mapstructure:"max-user-failures"`
+	// MaxIPFailures is the number of failed logins from a client IP within Window
+	// after which the IP is blocked from logging in. Zero disables the per-IP limit.
+	MaxIPFailures int `json:"max-ip-failures" mapstructure:"max-ip-failures"`
+	// Window is the length of the sliding window failed logins are counted in.
+	Window time.Duration `json:"window" mapstructure:"window"`
+	// LockDuration is how long the first lock lasts. Every following lock lasts
+	// twice as long as the previous one, up to MaxLockDuration.
+	LockDuration time.Duration `json:"lock-duration" mapstructure:"lock-duration"`
+	// MaxLockDuration caps the backoff of LockDuration.
+	MaxLockDuration time.Duration `json:"max-lock-duration" mapstructure:"max-lock-duration"`
+	// Permanent locks the account until an administrator unlocks it, instead of temporarily.
+	Permanent bool `json:"permanent" mapstructure:"permanent"`
+}
+
+// NewLockoutOptions creates a LockoutOptions with default values.
+func NewLockoutOptions() *LockoutOptions {
+	return &LockoutOptions{
+		MaxUserFailures: 5,
+		MaxIPFailures:   20,
+		Window:          15 * time.Minute,
+		LockDuration:    5 * time.Minute,
+		MaxLockDuration: 24 * time.Hour,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *LockoutOptions) Validate() []error {
+	var errs []error
+	if o.MaxUserFailures < 0 || o.MaxIPFailures < 0 {
+		errs = append(errs, fmt.Errorf("--lockout.max-user-failures and --lockout.max-ip-failures can not be negative"))
+	}
+	if o.Window <= 0 {
+		errs = append(errs, fmt.Errorf("--lockout.window must be greater than 0"))
+	}
+	if o.LockDuration <= 0 {
+		errs = append(errs, fmt.Errorf("--lockout.lock-duration must be greater than 0"))
+	}
+	if o.MaxLockDuration < o.LockDuration {
+		errs = append(errs, fmt.Errorf("--lockout.max-lock-duration must not be less than --lockout.lock-duration"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the login lockout to the specified FlagSet.
+func (o *LockoutOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.IntVar(&o.MaxUserFailures, "lockout.max-user-failures", o.MaxUserFailures, ""+
+		"Number of failed logins of a username within the window after which the account is locked, 0 disables it.")
+	fs.IntVar(&o.MaxIPFailures, "lockout.max-ip-failures", o.MaxIPFailures, ""+
+		"Number of failed logins from a client IP within the window after which the IP is blocked, 0 disables it.")
+	fs.DurationVar(&o.Window, "lockout.window", o.Window, "Sliding window failed logins are counted in.")
+	fs.DurationVar(&o.LockDuration, "lockout.lock-duration", o.LockDuration, ""+
+		"Duration of the first lock, it doubles on every following lock.")
+	fs.DurationVar(&o.MaxLockDuration, "lockout.max-lock-duration", o.MaxLockDuration, "Maximum duration of a lock.")
+	fs.BoolVar(&o.Permanent, "lockout.permanent", o.Permanent, ""+
+		"Lock accounts until an administrator unlocks them instead of temporarily.")
+}
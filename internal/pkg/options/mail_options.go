@@ -0,0 +1,97 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*MailOptions)(nil)
+
+// Mail drivers.
+const (
+	// MailDriverSMTP sends mails through an SMTP server.
+	MailDriverSMTP = "smtp"
+	// MailDriverFile writes mails to files in a directory, for development.
+	MailDriverFile = "file"
+	// MailDriverMemory keeps mails in memory, for tests.
+	MailDriverMemory = "memory"
+)
+
+// MailOptions contains the options of the outgoing mails.
+type MailOptions struct {
+	// Driver is one of smtp, file and memory.
+	Driver string `json:"driver" mapstructure:"driver"`
+	// From is the sender address of the mails.
+	From string `json:"from" mapstructure:"from"`
+	// Host and Port are the address of the SMTP server.
+	Host string `json:"host" mapstructure:"host"`
+	Port int    `json:"port" mapstructure:"port"`
+	// Username and Password authenticate at the SMTP server, no authentication is used if Username is empty.
+	Username string `json:"username" mapstructure:"username"`
+	Password string `json:"password" mapstructure:"password"`
+	// TLS connects to the SMTP server over TLS, usually on port 465. Otherwise
+	// the connection is upgraded with STARTTLS if the server supports it.
+	TLS bool `json:"tls" mapstructure:"tls"`
+	// InsecureSkipVerify disables the verification of the SMTP server certificate.
+	InsecureSkipVerify bool `json:"insecure-skip-verify" mapstructure:"insecure-skip-verify"`
+	// Timeout limits the connection to the SMTP server.
+	Timeout time.Duration `json:"timeout" mapstructure:"timeout"`
+	// Dir is the directory the file driver writes the mails to.
+	Dir string `json:"dir" mapstructure:"dir"`
+}
+
+// NewMailOptions creates a MailOptions with default values.
+func NewMailOptions() *MailOptions {
+	return &MailOptions{
+		Driver:  MailDriverFile,
+		From:    "noreply@example.com",
+		Port:    587,
+		Timeout: 10 * time.Second,
+		Dir:     "_output/mails",
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *MailOptions) Validate() []error {
+	var errs []error
+	switch o.Driver {
+	case MailDriverSMTP:
+		if o.Host == "" || o.Port <= 0 {
+			errs = append(errs, fmt.Errorf("--mail.host and --mail.port are required by the smtp driver"))
+		}
+	case MailDriverFile:
+		if o.Dir == "" {
+			errs = append(errs, fmt.Errorf("--mail.dir is required by the file driver"))
+		}
+	case MailDriverMemory:
+	default:
+		errs = append(errs, fmt.Errorf("--mail.driver must be one of %s, %s and %s", MailDriverSMTP, MailDriverFile, MailDriverMemory))
+	}
+	if o.From == "" {
+		errs = append(errs, fmt.Errorf("--mail.from can not be empty"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the outgoing mails to the specified FlagSet.
+func (o *MailOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.Driver, "mail.driver", o.Driver, "Driver of the outgoing mails, one of smtp, file and memory.")
+	fs.StringVar(&o.From, "mail.from", o.From, "Sender address of the mails.")
+	fs.StringVar(&o.Host, "mail.host", o.Host, "Host of the SMTP server.")
+	fs.IntVar(&o.Port, "mail.port", o.Port, "Port of the SMTP server.")
+	fs.StringVar(&o.Username, "mail.username", o.Username, "Username of the SMTP server, no authentication is used if empty.")
+	fs.StringVar(&o.Password, "mail.password", o.Password, "Password of the SMTP server.")
+	fs.BoolVar(&o.TLS, "mail.tls", o.TLS, "Connect to the SMTP server over TLS instead of STARTTLS.")
+	fs.BoolVar(&o.InsecureSkipVerify, "mail.insecure-skip-verify", o.InsecureSkipVerify, "Skip the verification of the SMTP server certificate.")
+	fs.DurationVar(&o.Timeout, "mail.timeout", o.Timeout, "Timeout of the connection to the SMTP server.")
+	fs.StringVar(&o.Dir, "mail.dir", o.Dir, "Directory the file driver writes the mails to.")
+}
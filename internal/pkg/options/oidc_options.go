@@ -0,0 +1,80 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*OIDCOptions)(nil)
+
+// OIDCOptions contains the options of the OIDC single sign-on.
+type OIDCOptions struct {
+	// StateExpiration is how long a login may take between the redirect to the
+	// identity provider and the callback.
+	StateExpiration time.Duration `json:"state-expiration" mapstructure:"state-expiration"`
+	// Providers lists the identity providers users can log in with.
+	Providers []OIDCProviderOptions `json:"providers" mapstructure:"providers"`
+}
+
+// OIDCProviderOptions describes an OpenID Connect identity provider.
+type OIDCProviderOptions struct {
+	// Name identifies the provider in the login and callback URLs.
+	Name string `json:"name" mapstructure:"name"`
+	// DisplayName is shown on the login button, Name is used if empty.
+	DisplayName string `json:"display-name" mapstructure:"display-name"`
+	// Issuer is the issuer URL, the provider metadata is discovered from
+	// <Issuer>/.well-known/openid-configuration.
+	Issuer string `json:"issuer" mapstructure:"issuer"`
+	// ClientID and ClientSecret are the credentials of art-apiserver at the provider.
+	ClientID     string `json:"client-id" mapstructure:"client-id"`
+	ClientSecret string `json:"client-secret" mapstructure:"client-secret"`
+	// RedirectURL is the callback URL registered at the provider.
+	RedirectURL string `json:"redirect-url" mapstructure:"redirect-url"`
+	// Scopes are requested in addition to openid.
+	Scopes []string `json:"scopes" mapstructure:"scopes"`
+	// UsernameClaim is the ID token claim the username of provisioned users is derived from.
+	UsernameClaim string `json:"username-claim" mapstructure:"username-claim"`
+}
+
+// NewOIDCOptions creates an OIDCOptions with default values.
+func NewOIDCOptions() *OIDCOptions {
+	return &OIDCOptions{
+		StateExpiration: 10 * time.Minute,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *OIDCOptions) Validate() []error {
+	var errs []error
+	if o.StateExpiration <= 0 {
+		errs = append(errs, fmt.Errorf("--oidc.state-expiration must be greater than 0"))
+	}
+
+	names := make(map[string]bool, len(o.Providers))
+	for _, p := range o.Providers {
+		if p.Name == "" || p.Issuer == "" || p.ClientID == "" || p.RedirectURL == "" {
+			errs = append(errs, fmt.Errorf("oidc provider %q: name, issuer, client-id and redirect-url are required", p.Name))
+		}
+		if names[p.Name] {
+			errs = append(errs, fmt.Errorf("oidc provider %q is defined more than once", p.Name))
+		}
+		names[p.Name] = true
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the OIDC single sign-on to the specified FlagSet.
+// The providers can only be set in the configuration file.
+func (o *OIDCOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.DurationVar(&o.StateExpiration, "oidc.state-expiration", o.StateExpiration, ""+
+		"Maximum duration between the redirect to the identity provider and the callback.")
+}
@@ -0,0 +1,99 @@
+package options
+
+import (
+	"fmt"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var _ genericoptions.IOptions = (*PasswordHashOptions)(nil)
+
+const (
+	// PasswordHashArgon2id hashes passwords with argon2id.
+	PasswordHashArgon2id = "argon2id"
+	// PasswordHashBcrypt hashes passwords with bcrypt.
+	PasswordHashBcrypt = "bcrypt"
+)
+
+// PasswordHashOptions contains the algorithm and the parameters new password hashes are created with.
+// Hashes created with other algorithms or parameters still verify, and are
+// replaced by a hash with the current ones the next time their user logs in.
+type PasswordHashOptions struct {
+	// Algorithm is either argon2id or bcrypt.
+	Algorithm string `json:"algorithm" mapstructure:"algorithm"`
+	// Memory is the memory used by argon2id, in KiB.
+	Memory uint32 `json:"memory" mapstructure:"memory"`
+	// Iterations is the number of passes of argon2id over the memory.
+	Iterations uint32 `json:"iterations" mapstructure:"iterations"`
+	// Parallelism is the number of threads used by argon2id.
+	Parallelism uint8 `json:"parallelism" mapstructure:"parallelism"`
+	// SaltLength is the length of the random salt of argon2id, in bytes.
+	SaltLength uint32 `json:"salt-length" mapstructure:"salt-length"`
+	// KeyLength is the length of the argon2id hash, in bytes.
+	KeyLength uint32 `json:"key-length" mapstructure:"key-length"`
+	// BcryptCost is the cost of bcrypt.
+	BcryptCost int `json:"bcrypt-cost" mapstructure:"bcrypt-cost"`
+}
+
+// NewPasswordHashOptions creates a PasswordHashOptions with default values,
+// which follow the recommendations of OWASP for argon2id.
+func NewPasswordHashOptions() *PasswordHashOptions {
+	return &PasswordHashOptions{
+		Algorithm:   PasswordHashArgon2id,
+		Memory:      64 * 1024,
+		Iterations:  3,
+		Parallelism: 2,
+		SaltLength:  16,
+		KeyLength:   32,
+		BcryptCost:  bcrypt.DefaultCost,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *PasswordHashOptions) Validate() []error {
+	var errs []error
+	switch o.Algorithm {
+	case PasswordHashArgon2id:
+		if o.Memory < 8*uint32(o.Parallelism) {
+			errs = append(errs, fmt.Errorf("--password-hash.memory must be at least 8 KiB per thread"))
+		}
+		if o.Iterations < 1 {
+			errs = append(errs, fmt.Errorf("--password-hash.iterations must be greater than 0"))
+		}
+		if o.Parallelism < 1 {
+			errs = append(errs, fmt.Errorf("--password-hash.parallelism must be greater than 0"))
+		}
+		if o.SaltLength < 8 {
+			errs = append(errs, fmt.Errorf("--password-hash.salt-length must be at least 8"))
+		}
+		if o.KeyLength < 16 {
+			errs = append(errs, fmt.Errorf("--password-hash.key-length must be at least 16"))
+		}
+	case PasswordHashBcrypt:
+		if o.BcryptCost < bcrypt.MinCost || o.BcryptCost > bcrypt.MaxCost {
+			errs = append(errs, fmt.Errorf("--password-hash.bcrypt-cost must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("--password-hash.algorithm must be %s or %s", PasswordHashArgon2id, PasswordHashBcrypt))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the password hashing to the specified FlagSet.
+func (o *PasswordHashOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.Algorithm, "password-hash.algorithm", o.Algorithm, "Algorithm of new password hashes, argon2id or bcrypt.")
+	fs.Uint32Var(&o.Memory, "password-hash.memory", o.Memory, "Memory used by argon2id, in KiB.")
+	fs.Uint32Var(&o.Iterations, "password-hash.iterations", o.Iterations, "Number of passes of argon2id over the memory.")
+	fs.Uint8Var(&o.Parallelism, "password-hash.parallelism", o.Parallelism, "Number of threads used by argon2id.")
+	fs.Uint32Var(&o.SaltLength, "password-hash.salt-length", o.SaltLength, "Length of the argon2id salt, in bytes.")
+	fs.Uint32Var(&o.KeyLength, "password-hash.key-length", o.KeyLength, "Length of the argon2id hash, in bytes.")
+	fs.IntVar(&o.BcryptCost, "password-hash.bcrypt-cost", o.BcryptCost, "Cost of bcrypt.")
+}
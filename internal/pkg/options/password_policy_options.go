@@ -0,0 +1,85 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*PasswordPolicyOptions)(nil)
+
+// PasswordPolicyOptions contains the rules new passwords must follow.
+type PasswordPolicyOptions struct {
+	// MinLength is the minimum number of characters of a password.
+	MinLength int `json:"min-length" mapstructure:"min-length"`
+	// RequireLetter requires at least one letter, of either case.
+	RequireLetter bool `json:"require-letter" mapstructure:"require-letter"`
+	// RequireUpper requires at least one upper case letter.
+	RequireUpper bool `json:"require-upper" mapstructure:"require-upper"`
+	// RequireLower requires at least one lower case letter.
+	RequireLower bool `json:"require-lower" mapstructure:"require-lower"`
+	// RequireDigit requires at least one digit.
+	RequireDigit bool `json:"require-digit" mapstructure:"require-digit"`
+	// RequireSymbol requires at least one character which is neither a letter nor a digit.
+	RequireSymbol bool `json:"require-symbol" mapstructure:"require-symbol"`
+	// Blocklist rejects the common passwords shipped with the server.
+	Blocklist bool `json:"blocklist" mapstructure:"blocklist"`
+	// BlocklistFile is a file of additional rejected passwords, one per line.
+	BlocklistFile string `json:"blocklist-file" mapstructure:"blocklist-file"`
+	// HistorySize is the number of recent passwords of a user, including the
+	// current one, which can not be reused. The current password is rejected even if it is 0.
+	HistorySize int `json:"history-size" mapstructure:"history-size"`
+	// MaxAge is how long a password is valid, after which it must be changed at
+	// the next login. Zero means passwords never expire.
+	MaxAge time.Duration `json:"max-age" mapstructure:"max-age"`
+}
+
+// NewPasswordPolicyOptions creates a PasswordPolicyOptions with default values.
+func NewPasswordPolicyOptions() *PasswordPolicyOptions {
+	return &PasswordPolicyOptions{
+		MinLength:     6,
+		RequireLetter: true,
+		RequireDigit:  true,
+		Blocklist:     true,
+		HistorySize:   5,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *PasswordPolicyOptions) Validate() []error {
+	var errs []error
+	if o.MinLength < 1 {
+		errs = append(errs, fmt.Errorf("--password-policy.min-length must be greater than 0"))
+	}
+	if o.HistorySize < 0 {
+		errs = append(errs, fmt.Errorf("--password-policy.history-size can not be negative"))
+	}
+	if o.MaxAge < 0 {
+		errs = append(errs, fmt.Errorf("--password-policy.max-age can not be negative"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the password policy to the specified FlagSet.
+func (o *PasswordPolicyOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.IntVar(&o.MinLength, "password-policy.min-length", o.MinLength, "Minimum number of characters of a password.")
+	fs.BoolVar(&o.RequireLetter, "password-policy.require-letter", o.RequireLetter, "Require at least one letter.")
+	fs.BoolVar(&o.RequireUpper, "password-policy.require-upper", o.RequireUpper, "Require at least one upper case letter.")
+	fs.BoolVar(&o.RequireLower, "password-policy.require-lower", o.RequireLower, "Require at least one lower case letter.")
+	fs.BoolVar(&o.RequireDigit, "password-policy.require-digit", o.RequireDigit, "Require at least one digit.")
+	fs.BoolVar(&o.RequireSymbol, "password-policy.require-symbol", o.RequireSymbol, "Require at least one symbol.")
+	fs.BoolVar(&o.Blocklist, "password-policy.blocklist", o.Blocklist, "Reject the common passwords shipped with the server.")
+	fs.StringVar(&o.BlocklistFile, "password-policy.blocklist-file", o.BlocklistFile, "File of additional rejected passwords, one per line.")
+	fs.IntVar(&o.HistorySize, "password-policy.history-size", o.HistorySize, ""+
+		"Number of recent passwords, including the current one, which can not be reused.")
+	fs.DurationVar(&o.MaxAge, "password-policy.max-age", o.MaxAge, ""+
+		"How long a password is valid before it must be changed at login, 0 means passwords never expire.")
+}
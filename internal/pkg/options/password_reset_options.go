@@ -0,0 +1,76 @@
+package options
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*PasswordResetOptions)(nil)
+
+// PasswordResetOptions contains the options of the self-service password reset.
+type PasswordResetOptions struct {
+	// URL is the page of the frontend where the new password is entered. The
+	// reset token is appended to it as the token query parameter, which also
+	// works with the hash mode of the frontend router.
+	URL string `json:"url" mapstructure:"url"`
+	// Expiration is how long a reset token can be used.
+	Expiration time.Duration `json:"expiration" mapstructure:"expiration"`
+	// MaxUserRequests is the number of reset requests for an account within
+	// Window, after which further requests are refused. Zero disables the limit.
+	MaxUserRequests int `json:"max-user-requests" mapstructure:"max-user-requests"`
+	// MaxIPRequests is the number of reset requests from a client IP within
+	// Window, after which further requests are refused. Zero disables the limit.
+	MaxIPRequests int `json:"max-ip-requests" mapstructure:"max-ip-requests"`
+	// Window is the length of the window reset requests are counted in.
+	Window time.Duration `json:"window" mapstructure:"window"`
+}
+
+// NewPasswordResetOptions creates a PasswordResetOptions with default values.
+func NewPasswordResetOptions() *PasswordResetOptions {
+	return &PasswordResetOptions{
+		URL:             "http://127.0.0.1:3006/#/reset-password",
+		Expiration:      30 * time.Minute,
+		MaxUserRequests: 3,
+		MaxIPRequests:   20,
+		Window:          time.Hour,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *PasswordResetOptions) Validate() []error {
+	var errs []error
+	if _, err := url.Parse(o.URL); err != nil || o.URL == "" {
+		errs = append(errs, fmt.Errorf("--password-reset.url must be a valid URL"))
+	}
+	if o.Expiration <= 0 {
+		errs = append(errs, fmt.Errorf("--password-reset.expiration must be greater than 0"))
+	}
+	if o.MaxUserRequests < 0 || o.MaxIPRequests < 0 {
+		errs = append(errs, fmt.Errorf("--password-reset.max-user-requests and --password-reset.max-ip-requests can not be negative"))
+	}
+	if o.Window <= 0 {
+		errs = append(errs, fmt.Errorf("--password-reset.window must be greater than 0"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the password reset to the specified FlagSet.
+func (o *PasswordResetOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.StringVar(&o.URL, "password-reset.url", o.URL, "Frontend page where the new password is entered, the reset token is appended as the token query parameter.")
+	fs.DurationVar(&o.Expiration, "password-reset.expiration", o.Expiration, "How long a reset token can be used.")
+	fs.IntVar(&o.MaxUserRequests, "password-reset.max-user-requests", o.MaxUserRequests, ""+
+		"Number of reset requests for an account within the window after which further requests are refused, 0 disables it.")
+	fs.IntVar(&o.MaxIPRequests, "password-reset.max-ip-requests", o.MaxIPRequests, ""+
+		"Number of reset requests from a client IP within the window after which further requests are refused, 0 disables it.")
+	fs.DurationVar(&o.Window, "password-reset.window", o.Window, "Window reset requests are counted in.")
+}
@@ -0,0 +1,65 @@
+package options
+
+import (
+	"fmt"
+	"net/url"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*RegistrationOptions)(nil)
+
+// RegistrationOptions contains the options of the self-registration.
+type RegistrationOptions struct {
+	// VerifyEmail makes new users start as registered, they are activated
+	// once they confirm their email with the mailed link.
+	VerifyEmail bool `json:"verify-email" mapstructure:"verify-email"`
+	// URL is the page of the frontend which confirms the email. The
+	// verification token is appended to it as the token query parameter.
+	URL string `json:"url" mapstructure:"url"`
+	// Expiration is how long a verification token can be used.
+	Expiration time.Duration `json:"expiration" mapstructure:"expiration"`
+	// ResendInterval is the minimum time between two verification mails of a user.
+	ResendInterval time.Duration `json:"resend-interval" mapstructure:"resend-interval"`
+}
+
+// NewRegistrationOptions creates a RegistrationOptions with default values.
+func NewRegistrationOptions() *RegistrationOptions {
+	return &RegistrationOptions{
+		VerifyEmail:    false,
+		URL:            "http://127.0.0.1:3006/#/verify-email",
+		Expiration:     24 * time.Hour,
+		ResendInterval: time.Minute,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *RegistrationOptions) Validate() []error {
+	var errs []error
+	if _, err := url.Parse(o.URL); err != nil || o.URL == "" {
+		errs = append(errs, fmt.Errorf("--registration.url must be a valid URL"))
+	}
+	if o.Expiration <= 0 {
+		errs = append(errs, fmt.Errorf("--registration.expiration must be greater than 0"))
+	}
+	if o.ResendInterval < 0 {
+		errs = append(errs, fmt.Errorf("--registration.resend-interval can not be negative"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the registration to the specified FlagSet.
+func (o *RegistrationOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.BoolVar(&o.VerifyEmail, "registration.verify-email", o.VerifyEmail, "Require new users to verify their email before they can log in.")
+	fs.StringVar(&o.URL, "registration.url", o.URL, "Frontend page which confirms the email, the verification token is appended as the token query parameter.")
+	fs.DurationVar(&o.Expiration, "registration.expiration", o.Expiration, "How long a verification token can be used.")
+	fs.DurationVar(&o.ResendInterval, "registration.resend-interval", o.ResendInterval, "Minimum time between two verification mails of a user.")
+}
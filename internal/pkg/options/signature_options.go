@@ -0,0 +1,55 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/spf13/pflag"
+)
+
+var _ genericoptions.IOptions = (*SignatureOptions)(nil)
+
+// SignatureOptions contains the options of the AK/SK request signatures.
+type SignatureOptions struct {
+	// ClockSkew is how far the timestamp of a signed request may be from the
+	// server time. Nonces are remembered for twice as long.
+	ClockSkew time.Duration `json:"clock-skew" mapstructure:"clock-skew"`
+	// MaxBodySize is the largest body, in bytes, a signed request may have.
+	// The body is read into memory to be hashed before the signature is checked.
+	MaxBodySize int64 `json:"max-body-size" mapstructure:"max-body-size"`
+}
+
+// NewSignatureOptions creates a SignatureOptions with default values.
+func NewSignatureOptions() *SignatureOptions {
+	return &SignatureOptions{
+		ClockSkew:   5 * time.Minute,
+		MaxBodySize: 1 << 20,
+	}
+}
+
+// Validate is used to parse and validate the parameters entered by the user at
+// the command line when the program starts.
+func (o *SignatureOptions) Validate() []error {
+	var errs []error
+	if o.ClockSkew <= 0 {
+		errs = append(errs, fmt.Errorf("--signature.clock-skew must be greater than 0"))
+	}
+	if o.MaxBodySize <= 0 {
+		errs = append(errs, fmt.Errorf("--signature.max-body-size must be greater than 0"))
+	}
+
+	return errs
+}
+
+// AddFlags adds flags related to the request signatures to the specified FlagSet.
+func (o *SignatureOptions) AddFlags(fs *pflag.FlagSet, prefixes ...string) {
+	if fs == nil {
+		return
+	}
+
+	fs.DurationVar(&o.ClockSkew, "signature.clock-skew", o.ClockSkew, ""+
+		"Maximum difference between the timestamp of a signed request and the server time.")
+	fs.Int64Var(&o.MaxBodySize, "signature.max-body-size", o.MaxBodySize, ""+
+		"Maximum size in bytes of the body of a signed request.")
+}
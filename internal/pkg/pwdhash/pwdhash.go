@@ -0,0 +1,166 @@
+// Package pwdhash hashes the passwords of the local users. The hashes carry
+// their algorithm and parameters, so that hashes created with other settings,
+// such as the bcrypt hashes of older releases, still verify and can be
+// replaced once their user logs in again.
+//
+// argon2id hashes use the PHC string format:
+//
+//	$argon2id$v=19$m=65536,t=3,p=2$<salt>$<hash>
+//
+// and bcrypt hashes the modular crypt format, e.g. $2a$10$<salt and hash>.
+package pwdhash
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+var (
+	// ErrMismatch is returned by Verify if the password does not match the hash.
+	ErrMismatch = errors.New("password does not match")
+	// ErrUnknownFormat is returned by Verify if the hash is not of a supported algorithm.
+	ErrUnknownFormat = errors.New("unknown password hash format")
+)
+
+// std is the Hasher used by the package level functions.
+var std = New(options.NewPasswordHashOptions())
+
+// Init makes the package level functions create hashes with opts. It must be
+// called at startup, before any password is hashed.
+func Init(opts *options.PasswordHashOptions) {
+	std = New(opts)
+}
+
+// Hash hashes password with the configured algorithm.
+func Hash(password string) (string, error) {
+	return std.Hash(password)
+}
+
+// Verify checks password against hash, which can be of any supported algorithm.
+func Verify(hash, password string) error {
+	return std.Verify(hash, password)
+}
+
+// NeedsRehash reports whether hash was not created with the configured algorithm and parameters.
+func NeedsRehash(hash string) bool {
+	return std.NeedsRehash(hash)
+}
+
+// Hasher creates and verifies password hashes.
+type Hasher struct {
+	opts *options.PasswordHashOptions
+}
+
+// New creates a Hasher which creates hashes with opts.
+func New(opts *options.PasswordHashOptions) *Hasher {
+	return &Hasher{opts: opts}
+}
+
+// argon2Params are the parameters of an argon2id hash.
+type argon2Params struct {
+	memory, iterations uint32
+	parallelism        uint8
+	salt, key          []byte
+}
+
+// Hash hashes password with the configured algorithm.
+func (h *Hasher) Hash(password string) (string, error) {
+	if h.opts.Algorithm == options.PasswordHashBcrypt {
+		hash, err := bcrypt.GenerateFromPassword([]byte(password), h.opts.BcryptCost)
+		return string(hash), err
+	}
+
+	salt := make([]byte, h.opts.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, h.opts.Iterations, h.opts.Memory, h.opts.Parallelism, h.opts.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s", argon2.Version, h.opts.Memory, h.opts.Iterations, h.opts.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt), base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+// Verify checks password against hash, which can be of any supported algorithm.
+func (h *Hasher) Verify(hash, password string) error {
+	if isBcrypt(hash) {
+		if err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password)); err != nil {
+			if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+				return ErrMismatch
+			}
+			return err
+		}
+		return nil
+	}
+
+	params, err := parseArgon2(hash)
+	if err != nil {
+		return err
+	}
+	key := argon2.IDKey([]byte(password), params.salt, params.iterations, params.memory, params.parallelism, uint32(len(params.key)))
+	if subtle.ConstantTimeCompare(key, params.key) != 1 {
+		return ErrMismatch
+	}
+
+	return nil
+}
+
+// NeedsRehash reports whether hash was not created with the configured algorithm and parameters.
+func (h *Hasher) NeedsRehash(hash string) bool {
+	if h.opts.Algorithm == options.PasswordHashBcrypt {
+		if !isBcrypt(hash) {
+			return true
+		}
+		cost, err := bcrypt.Cost([]byte(hash))
+		return err != nil || cost != h.opts.BcryptCost
+	}
+
+	params, err := parseArgon2(hash)
+	if err != nil {
+		return true
+	}
+
+	return params.memory != h.opts.Memory || params.iterations != h.opts.Iterations || params.parallelism != h.opts.Parallelism ||
+		uint32(len(params.salt)) != h.opts.SaltLength || uint32(len(params.key)) != h.opts.KeyLength
+}
+
+// isBcrypt reports whether hash is in the modular crypt format of bcrypt.
+func isBcrypt(hash string) bool {
+	return strings.HasPrefix(hash, "$2a$") || strings.HasPrefix(hash, "$2b$") || strings.HasPrefix(hash, "$2y$")
+}
+
+// parseArgon2 decodes an argon2id hash in the PHC string format.
+func parseArgon2(hash string) (*argon2Params, error) {
+	parts := strings.Split(hash, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return nil, ErrUnknownFormat
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrUnknownFormat
+	}
+
+	params := &argon2Params{}
+	if _, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.memory, &params.iterations, &params.parallelism); err != nil {
+		return nil, ErrUnknownFormat
+	}
+
+	var err error
+	if params.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrUnknownFormat
+	}
+	if params.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(params.key) == 0 {
+		return nil, ErrUnknownFormat
+	}
+
+	return params, nil
+}
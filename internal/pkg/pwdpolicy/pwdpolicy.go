@@ -0,0 +1,199 @@
+// Package pwdpolicy checks new passwords against the configured password policy:
+// the minimum length, the required character classes, a blocklist of common
+// passwords and the password history. It also decides when a password expires.
+package pwdpolicy
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	_ "embed"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/google/wire"
+	"github.com/moweilong/milady/pkg/i18n"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/locales"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+	"github.com/moweilong/art-design-pro-go/internal/pkg/pwdhash"
+	v1 "github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1"
+)
+
+// ProviderSet is the wire provider set of the password policy.
+var ProviderSet = wire.NewSet(New)
+
+//go:embed common_passwords.txt
+var commonPasswords []byte
+
+// Rules identify the requirements of the policy, they are the keys of the
+// metadata of the errors returned for violations.
+const (
+	RuleMinLength = "min_length"
+	RuleLetter    = "letter"
+	RuleUpper     = "upper"
+	RuleLower     = "lower"
+	RuleDigit     = "digit"
+	RuleSymbol    = "symbol"
+	RuleBlocklist = "blocklist"
+	RuleHistory   = "history"
+)
+
+// Violation is a requirement of the policy a password does not meet.
+type Violation struct {
+	// Rule is one of the Rule constants.
+	Rule string
+	// MessageID is the locale message describing the violation.
+	MessageID string
+	// Args fill in the verbs of the localized message.
+	Args []any
+}
+
+// Policy is the password policy read from the configuration.
+type Policy struct {
+	opts      *options.PasswordPolicyOptions
+	blocklist map[string]struct{}
+}
+
+// New creates a Policy, loading the blocklists it is configured with.
+func New(opts *options.PasswordPolicyOptions) (*Policy, error) {
+	p := &Policy{opts: opts, blocklist: make(map[string]struct{})}
+	if opts.Blocklist {
+		_ = p.load(bytes.NewReader(commonPasswords))
+	}
+	if opts.BlocklistFile != "" {
+		f, err := os.Open(opts.BlocklistFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to open password blocklist: %w", err)
+		}
+		defer f.Close()
+
+		if err := p.load(f); err != nil {
+			return nil, fmt.Errorf("failed to read password blocklist: %w", err)
+		}
+	}
+
+	return p, nil
+}
+
+// Check returns the requirements password does not meet, ignoring the history.
+func (p *Policy) Check(password string) []Violation {
+	var violations []Violation
+	if utf8.RuneCountInString(password) < p.opts.MinLength {
+		violations = append(violations, Violation{RuleMinLength, locales.PasswordTooShort, []any{p.opts.MinLength}})
+	}
+
+	var letter, upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			letter, upper = true, true
+		case unicode.IsLower(r):
+			letter, lower = true, true
+		case unicode.IsLetter(r):
+			letter = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+
+	classes := []struct {
+		required, present bool
+		rule, messageID   string
+	}{
+		{p.opts.RequireLetter, letter, RuleLetter, locales.PasswordMissingLetter},
+		{p.opts.RequireUpper, upper, RuleUpper, locales.PasswordMissingUpper},
+		{p.opts.RequireLower, lower, RuleLower, locales.PasswordMissingLower},
+		{p.opts.RequireDigit, digit, RuleDigit, locales.PasswordMissingDigit},
+		{p.opts.RequireSymbol, symbol, RuleSymbol, locales.PasswordMissingSymbol},
+	}
+	for _, class := range classes {
+		if class.required && !class.present {
+			violations = append(violations, Violation{Rule: class.rule, MessageID: class.messageID})
+		}
+	}
+
+	if _, ok := p.blocklist[strings.ToLower(password)]; ok {
+		violations = append(violations, Violation{Rule: RuleBlocklist, MessageID: locales.PasswordTooCommon})
+	}
+
+	return violations
+}
+
+// CheckHistory returns a violation if password matches one of the hashes,
+// which are the current and the previous passwords of a user, newest first.
+// Only as many hashes as the policy remembers are compared.
+func (p *Policy) CheckHistory(password string, hashes []string) []Violation {
+	size := p.HistorySize()
+	for i, hash := range hashes {
+		if i >= size {
+			break
+		}
+		if pwdhash.Verify(hash, password) == nil {
+			return []Violation{{Rule: RuleHistory, MessageID: locales.PasswordReused, Args: []any{size}}}
+		}
+	}
+
+	return nil
+}
+
+// Validate checks password against the policy, ignoring the history, and returns
+// the localized error of its violations.
+func (p *Policy) Validate(ctx context.Context, password string) error {
+	return Error(ctx, p.Check(password))
+}
+
+// HistorySize returns the number of recent passwords of a user which can not be
+// reused, including the current one.
+func (p *Policy) HistorySize() int {
+	return max(p.opts.HistorySize, 1)
+}
+
+// Expired reports whether a password changed at changedAt must be changed before logging in.
+// Passwords whose change time is unknown never expire.
+func (p *Policy) Expired(changedAt time.Time) bool {
+	return p.opts.MaxAge > 0 && !changedAt.IsZero() && time.Since(changedAt) > p.opts.MaxAge
+}
+
+// Error returns a PasswordPolicyViolation error listing the localized
+// violations, or nil if there are none. The metadata maps each violated rule
+// to its message, so that clients can show them next to the password field.
+func Error(ctx context.Context, violations []Violation) error {
+	if len(violations) == 0 {
+		return nil
+	}
+
+	messages := make([]string, 0, len(violations))
+	metadata := make(map[string]string, len(violations))
+	for _, violation := range violations {
+		message := i18n.FromContext(ctx).T(violation.MessageID)
+		if len(violation.Args) > 0 {
+			message = fmt.Sprintf(message, violation.Args...)
+		}
+		messages = append(messages, message)
+		metadata[violation.Rule] = message
+	}
+
+	return v1.ErrorPasswordPolicyViolation("%s", strings.Join(messages, "; ")).WithMetadata(metadata)
+}
+
+// load adds the passwords read from r to the blocklist.
+func (p *Policy) load(r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		p.blocklist[strings.ToLower(line)] = struct{}{}
+	}
+
+	return scanner.Err()
+}
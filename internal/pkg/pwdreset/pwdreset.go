@@ -0,0 +1,132 @@
+// Package pwdreset keeps the state of the self-service password reset in Redis:
+// the rate limits of the reset requests per account and per client IP, and the
+// single current reset token of every user.
+package pwdreset
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the password reset.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(Reset), new(*redisReset)))
+
+const (
+	// limitKeyPrefix is the prefix of the Redis keys counting the reset requests.
+	limitKeyPrefix = "password_reset_limit_"
+	// tokenKeyPrefix is the prefix of the Redis keys holding the current token of a user.
+	tokenKeyPrefix = "password_reset_token_"
+)
+
+// consumeScript deletes the token of a user only if it is still the given one.
+var consumeScript = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0`)
+
+// Reset tracks the password reset requests and tokens.
+type Reset interface {
+	// Allow records a reset request for username from ip, and reports whether
+	// both are still within their limits.
+	Allow(ctx context.Context, username, ip string) (bool, error)
+	// Issue returns the ID of a new reset token of userID, which replaces the
+	// previous tokens of the user and expires after the configured expiration.
+	Issue(ctx context.Context, userID string) (string, error)
+	// Valid reports whether tokenID is the current reset token of userID.
+	Valid(ctx context.Context, userID, tokenID string) (bool, error)
+	// Consume invalidates tokenID, it reports false if the token was not current anymore.
+	Consume(ctx context.Context, userID, tokenID string) (bool, error)
+	// Options returns the options of the password reset.
+	Options() *options.PasswordResetOptions
+}
+
+// redisReset is a Redis backed Reset.
+type redisReset struct {
+	cli  *redis.Client
+	opts *options.PasswordResetOptions
+}
+
+// Ensure redisReset implements Reset.
+var _ Reset = (*redisReset)(nil)
+
+// New creates a Redis backed Reset.
+func New(opts *options.PasswordResetOptions, redisOpts *genericoptions.RedisOptions) (*redisReset, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisReset{cli: cli, opts: opts}, nil
+}
+
+// Allow counts the request in fixed windows of username and ip.
+func (r *redisReset) Allow(ctx context.Context, username, ip string) (bool, error) {
+	type limit struct {
+		key string
+		max int
+	}
+	limits := []limit{{limitKeyPrefix + "user_" + username, r.opts.MaxUserRequests}}
+	if ip != "" {
+		limits = append(limits, limit{limitKeyPrefix + "ip_" + ip, r.opts.MaxIPRequests})
+	}
+
+	pipe := r.cli.TxPipeline()
+	counts := make([]*redis.IntCmd, len(limits))
+	for i, limit := range limits {
+		counts[i] = pipe.Incr(ctx, limit.key)
+		pipe.ExpireNX(ctx, limit.key, r.opts.Window)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		return false, err
+	}
+
+	for i, limit := range limits {
+		if limit.max > 0 && counts[i].Val() > int64(limit.max) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Issue stores a new token ID as the current token of userID.
+func (r *redisReset) Issue(ctx context.Context, userID string) (string, error) {
+	tokenID := uuid.New().String()
+	if err := r.cli.Set(ctx, tokenKeyPrefix+userID, tokenID, r.opts.Expiration).Err(); err != nil {
+		return "", err
+	}
+
+	return tokenID, nil
+}
+
+// Valid reports whether tokenID is the current token of userID.
+func (r *redisReset) Valid(ctx context.Context, userID, tokenID string) (bool, error) {
+	current, err := r.cli.Get(ctx, tokenKeyPrefix+userID).Result()
+	if err != nil && err != redis.Nil {
+		return false, err
+	}
+
+	return tokenID != "" && current == tokenID, nil
+}
+
+// Consume deletes the current token of userID if it is tokenID.
+func (r *redisReset) Consume(ctx context.Context, userID, tokenID string) (bool, error) {
+	n, err := consumeScript.Run(ctx, r.cli, []string{tokenKeyPrefix + userID}, tokenID).Int()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+// Options returns the options of the password reset.
+func (r *redisReset) Options() *options.PasswordResetOptions {
+	return r.opts
+}
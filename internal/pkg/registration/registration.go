@@ -0,0 +1,133 @@
+// Package registration keeps the state of the email verification of
+// self-registered users in Redis: the single current verification token of
+// every user, and the cooldown between two requests for another verification mail.
+package registration
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/google/wire"
+	genericoptions "github.com/moweilong/milady/pkg/options"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/options"
+)
+
+// ProviderSet is the wire provider set of the registration.
+var ProviderSet = wire.NewSet(New, wire.Bind(new(Registration), new(*redisRegistration)))
+
+const (
+	// tokenKeyPrefix is the prefix of the Redis keys mapping a token to its user.
+	tokenKeyPrefix = "email_verify_token_"
+	// userKeyPrefix is the prefix of the Redis keys holding the current token of a user.
+	userKeyPrefix = "email_verify_user_"
+	// resendKeyPrefix is the prefix of the Redis keys blocking the next resend request of a username.
+	resendKeyPrefix = "email_verify_resend_"
+)
+
+// issueScript makes ARGV[2] the only token of the user ARGV[1] for ARGV[3] milliseconds.
+var issueScript = redis.NewScript(`
+local previous = redis.call("GET", KEYS[1])
+if previous then
+	redis.call("DEL", ARGV[4] .. previous)
+end
+redis.call("SET", KEYS[1], ARGV[2], "PX", ARGV[3])
+redis.call("SET", ARGV[4] .. ARGV[2], ARGV[1], "PX", ARGV[3])
+return 1`)
+
+// consumeScript deletes the token KEYS[1] and returns its user, or false if it is unknown.
+var consumeScript = redis.NewScript(`
+local userID = redis.call("GET", KEYS[1])
+if not userID then
+	return false
+end
+redis.call("DEL", KEYS[1], ARGV[1] .. userID)
+return userID`)
+
+// Registration tracks the email verification tokens.
+type Registration interface {
+	// Enabled reports whether new users have to verify their email before logging in.
+	Enabled() bool
+	// Issue returns a new verification token of userID, which replaces the
+	// previous token of the user and expires after the configured expiration.
+	Issue(ctx context.Context, userID string) (string, error)
+	// Consume invalidates token and returns its user, or an empty string if
+	// the token is unknown, expired or has been replaced.
+	Consume(ctx context.Context, token string) (string, error)
+	// AllowResend reports whether a verification mail can be requested for
+	// username, and blocks the next request for the configured resend interval.
+	AllowResend(ctx context.Context, username string) (bool, error)
+	// Options returns the options of the registration.
+	Options() *options.RegistrationOptions
+}
+
+// redisRegistration is a Redis backed Registration.
+type redisRegistration struct {
+	cli  *redis.Client
+	opts *options.RegistrationOptions
+}
+
+// Ensure redisRegistration implements Registration.
+var _ Registration = (*redisRegistration)(nil)
+
+// New creates a Redis backed Registration.
+func New(opts *options.RegistrationOptions, redisOpts *genericoptions.RedisOptions) (*redisRegistration, error) {
+	cli, err := redisOpts.NewClient()
+	if err != nil {
+		return nil, err
+	}
+
+	return &redisRegistration{cli: cli, opts: opts}, nil
+}
+
+// Enabled reports whether the email verification is configured.
+func (r *redisRegistration) Enabled() bool {
+	return r.opts.VerifyEmail
+}
+
+// Issue stores a new random token as the current token of userID. The token
+// is opaque, the user it belongs to is only known to Redis.
+func (r *redisRegistration) Issue(ctx context.Context, userID string) (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+
+	args := []any{userID, token, r.opts.Expiration.Milliseconds(), tokenKeyPrefix}
+	if err := issueScript.Run(ctx, r.cli, []string{userKeyPrefix + userID}, args...).Err(); err != nil {
+		return "", err
+	}
+
+	return token, nil
+}
+
+// Consume deletes token and the reference of its user to it.
+func (r *redisRegistration) Consume(ctx context.Context, token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+
+	userID, err := consumeScript.Run(ctx, r.cli, []string{tokenKeyPrefix + token}, userKeyPrefix).Text()
+	if err == redis.Nil {
+		return "", nil
+	}
+
+	return userID, err
+}
+
+// AllowResend sets the cooldown of username unless it is still running.
+func (r *redisRegistration) AllowResend(ctx context.Context, username string) (bool, error) {
+	if r.opts.ResendInterval <= 0 {
+		return true, nil
+	}
+
+	return r.cli.SetNX(ctx, resendKeyPrefix+username, 1, r.opts.ResendInterval).Result()
+}
+
+// Options returns the options of the registration.
+func (r *redisRegistration) Options() *options.RegistrationOptions {
+	return r.opts
+}
@@ -0,0 +1,58 @@
+package totp_test
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/moweilong/art-design-pro-go/internal/pkg/totp"
+)
+
+// rfcSecret is the SHA1 seed of the RFC 6238 test vectors.
+var rfcSecret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestCode(t *testing.T) {
+	// The last 6 digits of the 8 digits codes of RFC 6238 appendix B.
+	tests := map[int64]string{
+		59:          "287082",
+		1111111109:  "081804",
+		1111111111:  "050471",
+		1234567890:  "005924",
+		2000000000:  "279037",
+		20000000000: "353130",
+	}
+
+	for unix, want := range tests {
+		code, err := totp.Code(rfcSecret, totp.Step(time.Unix(unix, 0)))
+		assert.NoError(t, err)
+		assert.Equal(t, want, code, "time %d", unix)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	secret, err := totp.GenerateSecret()
+	assert.NoError(t, err)
+
+	now := time.Now()
+	code, _ := totp.Code(secret, totp.Step(now))
+
+	step, ok := totp.Validate(secret, code, now, 0)
+	assert.True(t, ok)
+	assert.Equal(t, totp.Step(now), step)
+
+	// The previous period is still accepted, but a used step is not.
+	_, ok = totp.Validate(secret, code, now.Add(totp.Period*time.Second), 0)
+	assert.True(t, ok)
+	_, ok = totp.Validate(secret, code, now, step)
+	assert.False(t, ok)
+
+	_, ok = totp.Validate(secret, "12345", now, 0)
+	assert.False(t, ok)
+}
+
+func TestURI(t *testing.T) {
+	uri := totp.URI("Art Design Pro", "admin", "JBSWY3DPEHPK3PXP")
+	assert.Equal(t, "otpauth://totp/Art%20Design%20Pro:admin?algorithm=SHA1&digits=6&issuer=Art+Design+Pro&period=30&secret=JBSWY3DPEHPK3PXP", uri)
+}
@@ -34,3 +34,33 @@ func (x *AuthRequest) Default() {
 
 func (x *AuthResponse) Default() {
 }
+
+func (x *JWKSRequest) Default() {
+}
+
+func (x *JSONWebKey) Default() {
+}
+
+func (x *JWKSResponse) Default() {
+}
+
+func (x *JWTKey) Default() {
+}
+
+func (x *ListJWTKeyRequest) Default() {
+}
+
+func (x *ListJWTKeyResponse) Default() {
+}
+
+func (x *PromoteJWTKeyRequest) Default() {
+}
+
+func (x *PromoteJWTKeyResponse) Default() {
+}
+
+func (x *GetCaptchaRequest) Default() {
+}
+
+func (x *GetCaptchaResponse) Default() {
+}
@@ -12,6 +12,7 @@ package v1
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -69,8 +70,12 @@ func (x *AuthenticateRequest) GetToken() string {
 }
 
 type AuthenticateResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserID string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	// sessionID is the login session the token belongs to.
+	SessionID string `protobuf:"bytes,2,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	// actorID is the administrator who signed the token to impersonate the user, if any.
+	ActorID       string `protobuf:"bytes,3,opt,name=actorID,proto3" json:"actorID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -112,6 +117,20 @@ func (x *AuthenticateResponse) GetUserID() string {
 	return ""
 }
 
+func (x *AuthenticateResponse) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AuthenticateResponse) GetActorID() string {
+	if x != nil {
+		return x.ActorID
+	}
+	return ""
+}
+
 type AuthorizeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Sub           string                 `protobuf:"bytes,1,opt,name=sub,proto3" json:"sub,omitempty"`
@@ -277,9 +296,13 @@ func (x *AuthRequest) GetAct() string {
 }
 
 type AuthResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserID        string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
-	Allowed       bool                   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	UserID  string                 `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	Allowed bool                   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// sessionID is the login session the token belongs to.
+	SessionID string `protobuf:"bytes,3,opt,name=sessionID,proto3" json:"sessionID,omitempty"`
+	// actorID is the administrator who signed the token to impersonate the user, if any.
+	ActorID       string `protobuf:"bytes,4,opt,name=actorID,proto3" json:"actorID,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -328,15 +351,571 @@ func (x *AuthResponse) GetAllowed() bool {
 	return false
 }
 
+func (x *AuthResponse) GetSessionID() string {
+	if x != nil {
+		return x.SessionID
+	}
+	return ""
+}
+
+func (x *AuthResponse) GetActorID() string {
+	if x != nil {
+		return x.ActorID
+	}
+	return ""
+}
+
+// JWKSRequest represents the request message for listing the token verification keys.
+type JWKSRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWKSRequest) Reset() {
+	*x = JWKSRequest{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWKSRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWKSRequest) ProtoMessage() {}
+
+func (x *JWKSRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWKSRequest.ProtoReflect.Descriptor instead.
+func (*JWKSRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{6}
+}
+
+// JSONWebKey is a public key in the JSON Web Key format (RFC 7517).
+type JSONWebKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kty is the key type: RSA, EC or OKP.
+	Kty string `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	// kid matches the `kid` header of the tokens signed by the key.
+	Kid string `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use string `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg string `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	// n and e are the modulus and exponent of a RSA key.
+	N string `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E string `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	// crv, x and y are the curve and coordinates of an EC or OKP key.
+	Crv           string `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	Y             string `protobuf:"bytes,9,opt,name=y,proto3" json:"y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JSONWebKey) Reset() {
+	*x = JSONWebKey{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JSONWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONWebKey) ProtoMessage() {}
+
+func (x *JSONWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONWebKey.ProtoReflect.Descriptor instead.
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *JSONWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetY() string {
+	if x != nil {
+		return x.Y
+	}
+	return ""
+}
+
+// JWKSResponse is the JSON Web Key Set used to verify tokens.
+type JWKSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JSONWebKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWKSResponse) Reset() {
+	*x = JWKSResponse{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWKSResponse) ProtoMessage() {}
+
+func (x *JWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWKSResponse.ProtoReflect.Descriptor instead.
+func (*JWKSResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *JWKSResponse) GetKeys() []*JSONWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// JWTKey describes a key that tokens are signed or verified with.
+type JWTKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Kid   string                 `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	Alg   string                 `protobuf:"bytes,2,opt,name=alg,proto3" json:"alg,omitempty"`
+	// state is one of active, verify-only and retired.
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	// notAfter is the time after which tokens signed by the key are rejected.
+	NotAfter      *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=notAfter,proto3" json:"notAfter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTKey) Reset() {
+	*x = JWTKey{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTKey) ProtoMessage() {}
+
+func (x *JWTKey) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTKey.ProtoReflect.Descriptor instead.
+func (*JWTKey) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *JWTKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWTKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JWTKey) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *JWTKey) GetNotAfter() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NotAfter
+	}
+	return nil
+}
+
+// ListJWTKeyRequest represents the request message for listing the JWT keys.
+type ListJWTKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJWTKeyRequest) Reset() {
+	*x = ListJWTKeyRequest{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJWTKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJWTKeyRequest) ProtoMessage() {}
+
+func (x *ListJWTKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJWTKeyRequest.ProtoReflect.Descriptor instead.
+func (*ListJWTKeyRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{10}
+}
+
+// ListJWTKeyResponse represents the response message for listing the JWT keys.
+type ListJWTKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JWTKey              `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListJWTKeyResponse) Reset() {
+	*x = ListJWTKeyResponse{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListJWTKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJWTKeyResponse) ProtoMessage() {}
+
+func (x *ListJWTKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJWTKeyResponse.ProtoReflect.Descriptor instead.
+func (*ListJWTKeyResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListJWTKeyResponse) GetKeys() []*JWTKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+// PromoteJWTKeyRequest represents the request message for promoting a JWT key to active.
+type PromoteJWTKeyRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kid is the key to promote, the key following the active one is used if empty.
+	Kid           string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteJWTKeyRequest) Reset() {
+	*x = PromoteJWTKeyRequest{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteJWTKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteJWTKeyRequest) ProtoMessage() {}
+
+func (x *PromoteJWTKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteJWTKeyRequest.ProtoReflect.Descriptor instead.
+func (*PromoteJWTKeyRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PromoteJWTKeyRequest) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+// PromoteJWTKeyResponse represents the response message for promoting a JWT key.
+type PromoteJWTKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Key           *JWTKey                `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PromoteJWTKeyResponse) Reset() {
+	*x = PromoteJWTKeyResponse{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PromoteJWTKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PromoteJWTKeyResponse) ProtoMessage() {}
+
+func (x *PromoteJWTKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PromoteJWTKeyResponse.ProtoReflect.Descriptor instead.
+func (*PromoteJWTKeyResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PromoteJWTKeyResponse) GetKey() *JWTKey {
+	if x != nil {
+		return x.Key
+	}
+	return nil
+}
+
+// GetCaptchaRequest represents the request message for generating a captcha.
+type GetCaptchaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaptchaRequest) Reset() {
+	*x = GetCaptchaRequest{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaptchaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaRequest) ProtoMessage() {}
+
+func (x *GetCaptchaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaRequest.ProtoReflect.Descriptor instead.
+func (*GetCaptchaRequest) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{14}
+}
+
+// GetCaptchaResponse represents the response message carrying a captcha.
+type GetCaptchaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// captchaID identifies the captcha, it must be sent back with the answer.
+	CaptchaID string `protobuf:"bytes,1,opt,name=captchaID,proto3" json:"captchaID,omitempty"`
+	// image is the captcha image encoded as a base64 data URI.
+	Image string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`
+	// expiresIn is the number of seconds the captcha stays valid.
+	ExpiresIn     int64 `protobuf:"varint,3,opt,name=expiresIn,proto3" json:"expiresIn,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCaptchaResponse) Reset() {
+	*x = GetCaptchaResponse{}
+	mi := &file_apiserver_v1_auth_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCaptchaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCaptchaResponse) ProtoMessage() {}
+
+func (x *GetCaptchaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_apiserver_v1_auth_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCaptchaResponse.ProtoReflect.Descriptor instead.
+func (*GetCaptchaResponse) Descriptor() ([]byte, []int) {
+	return file_apiserver_v1_auth_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetCaptchaResponse) GetCaptchaID() string {
+	if x != nil {
+		return x.CaptchaID
+	}
+	return ""
+}
+
+func (x *GetCaptchaResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *GetCaptchaResponse) GetExpiresIn() int64 {
+	if x != nil {
+		return x.ExpiresIn
+	}
+	return 0
+}
+
 var File_apiserver_v1_auth_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_auth_proto_rawDesc = "" +
 	"\n" +
-	"\x17apiserver/v1/auth.proto\x12\fapiserver.v1\"+\n" +
+	"\x17apiserver/v1/auth.proto\x12\fapiserver.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"+\n" +
 	"\x13AuthenticateRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\".\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"f\n" +
 	"\x14AuthenticateResponse\x12\x16\n" +
-	"\x06userID\x18\x01 \x01(\tR\x06userID\"H\n" +
+	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x1c\n" +
+	"\tsessionID\x18\x02 \x01(\tR\tsessionID\x12\x18\n" +
+	"\aactorID\x18\x03 \x01(\tR\aactorID\"H\n" +
 	"\x10AuthorizeRequest\x12\x10\n" +
 	"\x03sub\x18\x01 \x01(\tR\x03sub\x12\x10\n" +
 	"\x03obj\x18\x03 \x01(\tR\x03obj\x12\x10\n" +
@@ -346,10 +925,43 @@ const file_apiserver_v1_auth_proto_rawDesc = "" +
 	"\vAuthRequest\x12\x14\n" +
 	"\x05token\x18\x01 \x01(\tR\x05token\x12\x10\n" +
 	"\x03obj\x18\x02 \x01(\tR\x03obj\x12\x10\n" +
-	"\x03act\x18\x03 \x01(\tR\x03act\"@\n" +
+	"\x03act\x18\x03 \x01(\tR\x03act\"x\n" +
 	"\fAuthResponse\x12\x16\n" +
 	"\x06userID\x18\x01 \x01(\tR\x06userID\x12\x18\n" +
-	"\aallowed\x18\x02 \x01(\bR\aallowedB@Z>github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1;v1b\x06proto3"
+	"\aallowed\x18\x02 \x01(\bR\aallowed\x12\x1c\n" +
+	"\tsessionID\x18\x03 \x01(\tR\tsessionID\x12\x18\n" +
+	"\aactorID\x18\x04 \x01(\tR\aactorID\"\r\n" +
+	"\vJWKSRequest\"\x9e\x01\n" +
+	"\n" +
+	"JSONWebKey\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03kid\x18\x02 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03use\x18\x03 \x01(\tR\x03use\x12\x10\n" +
+	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\f\n" +
+	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
+	"\x01e\x18\x06 \x01(\tR\x01e\x12\x10\n" +
+	"\x03crv\x18\a \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\b \x01(\tR\x01x\x12\f\n" +
+	"\x01y\x18\t \x01(\tR\x01y\"<\n" +
+	"\fJWKSResponse\x12,\n" +
+	"\x04keys\x18\x01 \x03(\v2\x18.apiserver.v1.JSONWebKeyR\x04keys\"z\n" +
+	"\x06JWTKey\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03alg\x18\x02 \x01(\tR\x03alg\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x126\n" +
+	"\bnotAfter\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\bnotAfter\"\x13\n" +
+	"\x11ListJWTKeyRequest\">\n" +
+	"\x12ListJWTKeyResponse\x12(\n" +
+	"\x04keys\x18\x01 \x03(\v2\x14.apiserver.v1.JWTKeyR\x04keys\"(\n" +
+	"\x14PromoteJWTKeyRequest\x12\x10\n" +
+	"\x03kid\x18\x01 \x01(\tR\x03kid\"?\n" +
+	"\x15PromoteJWTKeyResponse\x12&\n" +
+	"\x03key\x18\x01 \x01(\v2\x14.apiserver.v1.JWTKeyR\x03key\"\x13\n" +
+	"\x11GetCaptchaRequest\"f\n" +
+	"\x12GetCaptchaResponse\x12\x1c\n" +
+	"\tcaptchaID\x18\x01 \x01(\tR\tcaptchaID\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x1c\n" +
+	"\texpiresIn\x18\x03 \x01(\x03R\texpiresInB@Z>github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var (
 	file_apiserver_v1_auth_proto_rawDescOnce sync.Once
@@ -363,21 +975,36 @@ func file_apiserver_v1_auth_proto_rawDescGZIP() []byte {
 	return file_apiserver_v1_auth_proto_rawDescData
 }
 
-var file_apiserver_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_apiserver_v1_auth_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_apiserver_v1_auth_proto_goTypes = []any{
-	(*AuthenticateRequest)(nil),  // 0: apiserver.v1.AuthenticateRequest
-	(*AuthenticateResponse)(nil), // 1: apiserver.v1.AuthenticateResponse
-	(*AuthorizeRequest)(nil),     // 2: apiserver.v1.AuthorizeRequest
-	(*AuthorizeResponse)(nil),    // 3: apiserver.v1.AuthorizeResponse
-	(*AuthRequest)(nil),          // 4: apiserver.v1.AuthRequest
-	(*AuthResponse)(nil),         // 5: apiserver.v1.AuthResponse
+	(*AuthenticateRequest)(nil),   // 0: apiserver.v1.AuthenticateRequest
+	(*AuthenticateResponse)(nil),  // 1: apiserver.v1.AuthenticateResponse
+	(*AuthorizeRequest)(nil),      // 2: apiserver.v1.AuthorizeRequest
+	(*AuthorizeResponse)(nil),     // 3: apiserver.v1.AuthorizeResponse
+	(*AuthRequest)(nil),           // 4: apiserver.v1.AuthRequest
+	(*AuthResponse)(nil),          // 5: apiserver.v1.AuthResponse
+	(*JWKSRequest)(nil),           // 6: apiserver.v1.JWKSRequest
+	(*JSONWebKey)(nil),            // 7: apiserver.v1.JSONWebKey
+	(*JWKSResponse)(nil),          // 8: apiserver.v1.JWKSResponse
+	(*JWTKey)(nil),                // 9: apiserver.v1.JWTKey
+	(*ListJWTKeyRequest)(nil),     // 10: apiserver.v1.ListJWTKeyRequest
+	(*ListJWTKeyResponse)(nil),    // 11: apiserver.v1.ListJWTKeyResponse
+	(*PromoteJWTKeyRequest)(nil),  // 12: apiserver.v1.PromoteJWTKeyRequest
+	(*PromoteJWTKeyResponse)(nil), // 13: apiserver.v1.PromoteJWTKeyResponse
+	(*GetCaptchaRequest)(nil),     // 14: apiserver.v1.GetCaptchaRequest
+	(*GetCaptchaResponse)(nil),    // 15: apiserver.v1.GetCaptchaResponse
+	(*timestamppb.Timestamp)(nil), // 16: google.protobuf.Timestamp
 }
 var file_apiserver_v1_auth_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	7,  // 0: apiserver.v1.JWKSResponse.keys:type_name -> apiserver.v1.JSONWebKey
+	16, // 1: apiserver.v1.JWTKey.notAfter:type_name -> google.protobuf.Timestamp
+	9,  // 2: apiserver.v1.ListJWTKeyResponse.keys:type_name -> apiserver.v1.JWTKey
+	9,  // 3: apiserver.v1.PromoteJWTKeyResponse.key:type_name -> apiserver.v1.JWTKey
+	4,  // [4:4] is the sub-list for method output_type
+	4,  // [4:4] is the sub-list for method input_type
+	4,  // [4:4] is the sub-list for extension type_name
+	4,  // [4:4] is the sub-list for extension extendee
+	0,  // [0:4] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_auth_proto_init() }
@@ -391,7 +1018,7 @@ func file_apiserver_v1_auth_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_apiserver_v1_auth_proto_rawDesc), len(file_apiserver_v1_auth_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
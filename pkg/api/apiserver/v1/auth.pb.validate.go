@@ -163,6 +163,10 @@ func (m *AuthenticateResponse) validate(all bool) error {
 
 	// no validation rules for UserID
 
+	// no validation rules for SessionID
+
+	// no validation rules for ActorID
+
 	if len(errors) > 0 {
 		return AuthenticateResponseMultiError(errors)
 	}
@@ -584,6 +588,10 @@ func (m *AuthResponse) validate(all bool) error {
 
 	// no validation rules for Allowed
 
+	// no validation rules for SessionID
+
+	// no validation rules for ActorID
+
 	if len(errors) > 0 {
 		return AuthResponseMultiError(errors)
 	}
@@ -660,3 +668,1168 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AuthResponseValidationError{}
+
+// Validate checks the field values on JWKSRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JWKSRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JWKSRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JWKSRequestMultiError, or
+// nil if none found.
+func (m *JWKSRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JWKSRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return JWKSRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// JWKSRequestMultiError is an error wrapping multiple validation errors
+// returned by JWKSRequest.ValidateAll() if the designated constraints aren't met.
+type JWKSRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JWKSRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JWKSRequestMultiError) AllErrors() []error { return m }
+
+// JWKSRequestValidationError is the validation error returned by
+// JWKSRequest.Validate if the designated constraints aren't met.
+type JWKSRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JWKSRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JWKSRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JWKSRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JWKSRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JWKSRequestValidationError) ErrorName() string { return "JWKSRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JWKSRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJWKSRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JWKSRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JWKSRequestValidationError{}
+
+// Validate checks the field values on JSONWebKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JSONWebKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JSONWebKey with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JSONWebKeyMultiError, or
+// nil if none found.
+func (m *JSONWebKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JSONWebKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kty
+
+	// no validation rules for Kid
+
+	// no validation rules for Use
+
+	// no validation rules for Alg
+
+	// no validation rules for N
+
+	// no validation rules for E
+
+	// no validation rules for Crv
+
+	// no validation rules for X
+
+	// no validation rules for Y
+
+	if len(errors) > 0 {
+		return JSONWebKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// JSONWebKeyMultiError is an error wrapping multiple validation errors
+// returned by JSONWebKey.ValidateAll() if the designated constraints aren't met.
+type JSONWebKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JSONWebKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JSONWebKeyMultiError) AllErrors() []error { return m }
+
+// JSONWebKeyValidationError is the validation error returned by
+// JSONWebKey.Validate if the designated constraints aren't met.
+type JSONWebKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JSONWebKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JSONWebKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JSONWebKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JSONWebKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JSONWebKeyValidationError) ErrorName() string { return "JSONWebKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JSONWebKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJSONWebKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JSONWebKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JSONWebKeyValidationError{}
+
+// Validate checks the field values on JWKSResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JWKSResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JWKSResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in JWKSResponseMultiError, or
+// nil if none found.
+func (m *JWKSResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JWKSResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, JWKSResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, JWKSResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return JWKSResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return JWKSResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// JWKSResponseMultiError is an error wrapping multiple validation errors
+// returned by JWKSResponse.ValidateAll() if the designated constraints aren't met.
+type JWKSResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JWKSResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JWKSResponseMultiError) AllErrors() []error { return m }
+
+// JWKSResponseValidationError is the validation error returned by
+// JWKSResponse.Validate if the designated constraints aren't met.
+type JWKSResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JWKSResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JWKSResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JWKSResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JWKSResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JWKSResponseValidationError) ErrorName() string { return "JWKSResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JWKSResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJWKSResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JWKSResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JWKSResponseValidationError{}
+
+// Validate checks the field values on JWTKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *JWTKey) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on JWTKey with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in JWTKeyMultiError, or nil if none found.
+func (m *JWTKey) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *JWTKey) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kid
+
+	// no validation rules for Alg
+
+	// no validation rules for State
+
+	if all {
+		switch v := interface{}(m.GetNotAfter()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, JWTKeyValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, JWTKeyValidationError{
+					field:  "NotAfter",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetNotAfter()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return JWTKeyValidationError{
+				field:  "NotAfter",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return JWTKeyMultiError(errors)
+	}
+
+	return nil
+}
+
+// JWTKeyMultiError is an error wrapping multiple validation errors returned by
+// JWTKey.ValidateAll() if the designated constraints aren't met.
+type JWTKeyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m JWTKeyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m JWTKeyMultiError) AllErrors() []error { return m }
+
+// JWTKeyValidationError is the validation error returned by JWTKey.Validate if
+// the designated constraints aren't met.
+type JWTKeyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e JWTKeyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e JWTKeyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e JWTKeyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e JWTKeyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e JWTKeyValidationError) ErrorName() string { return "JWTKeyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e JWTKeyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sJWTKey.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = JWTKeyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = JWTKeyValidationError{}
+
+// Validate checks the field values on ListJWTKeyRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListJWTKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJWTKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJWTKeyRequestMultiError, or nil if none found.
+func (m *ListJWTKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJWTKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListJWTKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJWTKeyRequestMultiError is an error wrapping multiple validation errors
+// returned by ListJWTKeyRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListJWTKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJWTKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJWTKeyRequestMultiError) AllErrors() []error { return m }
+
+// ListJWTKeyRequestValidationError is the validation error returned by
+// ListJWTKeyRequest.Validate if the designated constraints aren't met.
+type ListJWTKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJWTKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJWTKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJWTKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJWTKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJWTKeyRequestValidationError) ErrorName() string {
+	return "ListJWTKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListJWTKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJWTKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJWTKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJWTKeyRequestValidationError{}
+
+// Validate checks the field values on ListJWTKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListJWTKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListJWTKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListJWTKeyResponseMultiError, or nil if none found.
+func (m *ListJWTKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListJWTKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetKeys() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListJWTKeyResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListJWTKeyResponseValidationError{
+						field:  fmt.Sprintf("Keys[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListJWTKeyResponseValidationError{
+					field:  fmt.Sprintf("Keys[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListJWTKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListJWTKeyResponseMultiError is an error wrapping multiple validation errors
+// returned by ListJWTKeyResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListJWTKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListJWTKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListJWTKeyResponseMultiError) AllErrors() []error { return m }
+
+// ListJWTKeyResponseValidationError is the validation error returned by
+// ListJWTKeyResponse.Validate if the designated constraints aren't met.
+type ListJWTKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListJWTKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListJWTKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListJWTKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListJWTKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListJWTKeyResponseValidationError) ErrorName() string {
+	return "ListJWTKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListJWTKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListJWTKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListJWTKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListJWTKeyResponseValidationError{}
+
+// Validate checks the field values on PromoteJWTKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PromoteJWTKeyRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromoteJWTKeyRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PromoteJWTKeyRequestMultiError, or nil if none found.
+func (m *PromoteJWTKeyRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromoteJWTKeyRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Kid
+
+	if len(errors) > 0 {
+		return PromoteJWTKeyRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromoteJWTKeyRequestMultiError is an error wrapping multiple validation
+// errors returned by PromoteJWTKeyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PromoteJWTKeyRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromoteJWTKeyRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromoteJWTKeyRequestMultiError) AllErrors() []error { return m }
+
+// PromoteJWTKeyRequestValidationError is the validation error returned by
+// PromoteJWTKeyRequest.Validate if the designated constraints aren't met.
+type PromoteJWTKeyRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoteJWTKeyRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoteJWTKeyRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoteJWTKeyRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoteJWTKeyRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoteJWTKeyRequestValidationError) ErrorName() string {
+	return "PromoteJWTKeyRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PromoteJWTKeyRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoteJWTKeyRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoteJWTKeyRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoteJWTKeyRequestValidationError{}
+
+// Validate checks the field values on PromoteJWTKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PromoteJWTKeyResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PromoteJWTKeyResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PromoteJWTKeyResponseMultiError, or nil if none found.
+func (m *PromoteJWTKeyResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PromoteJWTKeyResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetKey()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PromoteJWTKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PromoteJWTKeyResponseValidationError{
+					field:  "Key",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetKey()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PromoteJWTKeyResponseValidationError{
+				field:  "Key",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PromoteJWTKeyResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PromoteJWTKeyResponseMultiError is an error wrapping multiple validation
+// errors returned by PromoteJWTKeyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PromoteJWTKeyResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PromoteJWTKeyResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PromoteJWTKeyResponseMultiError) AllErrors() []error { return m }
+
+// PromoteJWTKeyResponseValidationError is the validation error returned by
+// PromoteJWTKeyResponse.Validate if the designated constraints aren't met.
+type PromoteJWTKeyResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PromoteJWTKeyResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PromoteJWTKeyResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PromoteJWTKeyResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PromoteJWTKeyResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PromoteJWTKeyResponseValidationError) ErrorName() string {
+	return "PromoteJWTKeyResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PromoteJWTKeyResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPromoteJWTKeyResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PromoteJWTKeyResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PromoteJWTKeyResponseValidationError{}
+
+// Validate checks the field values on GetCaptchaRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *GetCaptchaRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCaptchaRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCaptchaRequestMultiError, or nil if none found.
+func (m *GetCaptchaRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCaptchaRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetCaptchaRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCaptchaRequestMultiError is an error wrapping multiple validation errors
+// returned by GetCaptchaRequest.ValidateAll() if the designated constraints
+// aren't met.
+type GetCaptchaRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCaptchaRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCaptchaRequestMultiError) AllErrors() []error { return m }
+
+// GetCaptchaRequestValidationError is the validation error returned by
+// GetCaptchaRequest.Validate if the designated constraints aren't met.
+type GetCaptchaRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCaptchaRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCaptchaRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCaptchaRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCaptchaRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCaptchaRequestValidationError) ErrorName() string {
+	return "GetCaptchaRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCaptchaRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCaptchaRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCaptchaRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCaptchaRequestValidationError{}
+
+// Validate checks the field values on GetCaptchaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetCaptchaResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetCaptchaResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetCaptchaResponseMultiError, or nil if none found.
+func (m *GetCaptchaResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetCaptchaResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CaptchaID
+
+	// no validation rules for Image
+
+	// no validation rules for ExpiresIn
+
+	if len(errors) > 0 {
+		return GetCaptchaResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetCaptchaResponseMultiError is an error wrapping multiple validation errors
+// returned by GetCaptchaResponse.ValidateAll() if the designated constraints
+// aren't met.
+type GetCaptchaResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetCaptchaResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetCaptchaResponseMultiError) AllErrors() []error { return m }
+
+// GetCaptchaResponseValidationError is the validation error returned by
+// GetCaptchaResponse.Validate if the designated constraints aren't met.
+type GetCaptchaResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCaptchaResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCaptchaResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCaptchaResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCaptchaResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCaptchaResponseValidationError) ErrorName() string {
+	return "GetCaptchaResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCaptchaResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCaptchaResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCaptchaResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCaptchaResponseValidationError{}
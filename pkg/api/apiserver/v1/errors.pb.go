@@ -41,29 +41,137 @@ const (
 	ErrorReason_SecretNotFound ErrorReason = 6
 	// 创建密钥失败，可能是由于服务器或其他问题导致的创建过程中的错误
 	ErrorReason_SecretCreateFailed ErrorReason = 7
+	// 用户已被锁定，可能是由于登录失败次数过多，需要等待锁定到期或由管理员解锁
+	ErrorReason_UserLocked ErrorReason = 8
+	// 验证码错误，可能是验证码缺失、已过期或已被使用
+	ErrorReason_InvalidCaptcha ErrorReason = 9
+	// 多因素认证已启用，需要先停用才能重新绑定
+	ErrorReason_MFAAlreadyEnabled ErrorReason = 10
+	// 多因素认证未启用或未开始绑定
+	ErrorReason_MFANotEnabled ErrorReason = 11
+	// 多因素认证验证码错误，可能是验证码已过期、已被使用或恢复码无效
+	ErrorReason_InvalidMFACode ErrorReason = 12
+	// 身份提供方未配置，可能是输入的身份提供方名称有误
+	ErrorReason_OIDCProviderNotFound ErrorReason = 13
+	// 单点登录失败，可能是 state 已过期、授权码无效或 ID Token 校验未通过
+	ErrorReason_OIDCLoginFailed ErrorReason = 14
+	// 会话不存在，可能是会话已过期、已被撤销或不属于该用户
+	ErrorReason_SessionNotFound ErrorReason = 15
+	// 会话已被撤销，令牌不能再使用，需要重新登录
+	ErrorReason_SessionRevoked ErrorReason = 16
+	// 密码不符合密码策略，metadata 中按规则列出了每一项不满足的原因
+	ErrorReason_PasswordPolicyViolation ErrorReason = 17
+	// 找回密码请求过于频繁，同一账号或同一 IP 需要稍后再试
+	ErrorReason_PasswordResetRateLimited ErrorReason = 18
+	// 重置密码令牌无效，可能已过期、已被使用或已被更新的令牌取代
+	ErrorReason_InvalidPasswordResetToken ErrorReason = 19
+	// 用户尚未激活，需要先通过邮件中的链接验证邮箱
+	ErrorReason_UserNotActivated ErrorReason = 20
+	// 邮箱验证令牌无效，可能已过期、已被使用或已被重新发送的令牌取代
+	ErrorReason_InvalidEmailVerificationToken ErrorReason = 21
+	// 重新发送验证邮件过于频繁，需要稍后再试
+	ErrorReason_EmailVerificationRateLimited ErrorReason = 22
+	// 刷新令牌已经轮换过又被再次使用，令牌可能已经泄露，令牌所属的会话已被撤销，需要重新登录
+	ErrorReason_RefreshTokenReused ErrorReason = 23
+	// 角色未找到，可能是角色不存在或输入的角色名称有误
+	ErrorReason_RoleNotFound ErrorReason = 24
+	// 角色已存在，无法创建角色
+	ErrorReason_RoleAlreadyExists ErrorReason = 25
+	// 角色已被禁用，不能分配给用户
+	ErrorReason_RoleDisabled ErrorReason = 26
+	// 内置角色不能删除或禁用
+	ErrorReason_RoleBuiltin ErrorReason = 27
+	// 菜单未找到，可能是菜单不存在或输入的菜单 ID 有误
+	ErrorReason_MenuNotFound ErrorReason = 28
+	// 菜单下仍有子菜单或按钮，需要先删除它们
+	ErrorReason_MenuHasChildren ErrorReason = 29
+	// 部门未找到，可能是部门不存在或输入的部门 ID 有误
+	ErrorReason_DepartmentNotFound ErrorReason = 30
+	// 部门下仍有下级部门，需要先删除或移走它们
+	ErrorReason_DepartmentHasChildren ErrorReason = 31
+	// 部门下仍有用户，需要先将用户移到其他部门
+	ErrorReason_DepartmentHasUsers ErrorReason = 32
+	// 部门已被禁用，用户不能加入该部门
+	ErrorReason_DepartmentDisabled ErrorReason = 33
+	// 用户已被禁用，需要联系管理员启用
+	ErrorReason_UserDisabled ErrorReason = 34
 )
 
 // Enum value maps for ErrorReason.
 var (
 	ErrorReason_name = map[int32]string{
-		0: "UserLoginFailed",
-		1: "UserAlreadyExists",
-		2: "UserNotFound",
-		3: "UserCreateFailed",
-		4: "UserOperationForbidden",
-		5: "SecretReachMaxCount",
-		6: "SecretNotFound",
-		7: "SecretCreateFailed",
+		0:  "UserLoginFailed",
+		1:  "UserAlreadyExists",
+		2:  "UserNotFound",
+		3:  "UserCreateFailed",
+		4:  "UserOperationForbidden",
+		5:  "SecretReachMaxCount",
+		6:  "SecretNotFound",
+		7:  "SecretCreateFailed",
+		8:  "UserLocked",
+		9:  "InvalidCaptcha",
+		10: "MFAAlreadyEnabled",
+		11: "MFANotEnabled",
+		12: "InvalidMFACode",
+		13: "OIDCProviderNotFound",
+		14: "OIDCLoginFailed",
+		15: "SessionNotFound",
+		16: "SessionRevoked",
+		17: "PasswordPolicyViolation",
+		18: "PasswordResetRateLimited",
+		19: "InvalidPasswordResetToken",
+		20: "UserNotActivated",
+		21: "InvalidEmailVerificationToken",
+		22: "EmailVerificationRateLimited",
+		23: "RefreshTokenReused",
+		24: "RoleNotFound",
+		25: "RoleAlreadyExists",
+		26: "RoleDisabled",
+		27: "RoleBuiltin",
+		28: "MenuNotFound",
+		29: "MenuHasChildren",
+		30: "DepartmentNotFound",
+		31: "DepartmentHasChildren",
+		32: "DepartmentHasUsers",
+		33: "DepartmentDisabled",
+		34: "UserDisabled",
 	}
 	ErrorReason_value = map[string]int32{
-		"UserLoginFailed":        0,
-		"UserAlreadyExists":      1,
-		"UserNotFound":           2,
-		"UserCreateFailed":       3,
-		"UserOperationForbidden": 4,
-		"SecretReachMaxCount":    5,
-		"SecretNotFound":         6,
-		"SecretCreateFailed":     7,
+		"UserLoginFailed":               0,
+		"UserAlreadyExists":             1,
+		"UserNotFound":                  2,
+		"UserCreateFailed":              3,
+		"UserOperationForbidden":        4,
+		"SecretReachMaxCount":           5,
+		"SecretNotFound":                6,
+		"SecretCreateFailed":            7,
+		"UserLocked":                    8,
+		"InvalidCaptcha":                9,
+		"MFAAlreadyEnabled":             10,
+		"MFANotEnabled":                 11,
+		"InvalidMFACode":                12,
+		"OIDCProviderNotFound":          13,
+		"OIDCLoginFailed":               14,
+		"SessionNotFound":               15,
+		"SessionRevoked":                16,
+		"PasswordPolicyViolation":       17,
+		"PasswordResetRateLimited":      18,
+		"InvalidPasswordResetToken":     19,
+		"UserNotActivated":              20,
+		"InvalidEmailVerificationToken": 21,
+		"EmailVerificationRateLimited":  22,
+		"RefreshTokenReused":            23,
+		"RoleNotFound":                  24,
+		"RoleAlreadyExists":             25,
+		"RoleDisabled":                  26,
+		"RoleBuiltin":                   27,
+		"MenuNotFound":                  28,
+		"MenuHasChildren":               29,
+		"DepartmentNotFound":            30,
+		"DepartmentHasChildren":         31,
+		"DepartmentHasUsers":            32,
+		"DepartmentDisabled":            33,
+		"UserDisabled":                  34,
 	}
 )
 
@@ -98,7 +206,7 @@ var File_apiserver_v1_errors_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_errors_proto_rawDesc = "" +
 	"\n" +
-	"\x19apiserver/v1/errors.proto\x12\fapiserver.v1\x1a\x13errors/errors.proto*\xf8\x01\n" +
+	"\x19apiserver/v1/errors.proto\x12\fapiserver.v1\x1a\x13errors/errors.proto*\x89\b\n" +
 	"\vErrorReason\x12\x19\n" +
 	"\x0fUserLoginFailed\x10\x00\x1a\x04\xa8E\x91\x03\x12\x1b\n" +
 	"\x11UserAlreadyExists\x10\x01\x1a\x04\xa8E\x99\x03\x12\x16\n" +
@@ -107,7 +215,36 @@ const file_apiserver_v1_errors_proto_rawDesc = "" +
 	"\x16UserOperationForbidden\x10\x04\x1a\x04\xa8E\x93\x03\x12\x1d\n" +
 	"\x13SecretReachMaxCount\x10\x05\x1a\x04\xa8E\x90\x03\x12\x18\n" +
 	"\x0eSecretNotFound\x10\x06\x1a\x04\xa8E\x94\x03\x12\x1c\n" +
-	"\x12SecretCreateFailed\x10\a\x1a\x04\xa8E\x9d\x04\x1a\x04\xa0E\xf4\x03B@Z>github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1;v1b\x06proto3"
+	"\x12SecretCreateFailed\x10\a\x1a\x04\xa8E\x9d\x04\x12\x14\n" +
+	"\n" +
+	"UserLocked\x10\b\x1a\x04\xa8E\x93\x03\x12\x18\n" +
+	"\x0eInvalidCaptcha\x10\t\x1a\x04\xa8E\x90\x03\x12\x1b\n" +
+	"\x11MFAAlreadyEnabled\x10\n" +
+	"\x1a\x04\xa8E\x99\x03\x12\x17\n" +
+	"\rMFANotEnabled\x10\v\x1a\x04\xa8E\x90\x03\x12\x18\n" +
+	"\x0eInvalidMFACode\x10\f\x1a\x04\xa8E\x91\x03\x12\x1e\n" +
+	"\x14OIDCProviderNotFound\x10\r\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0fOIDCLoginFailed\x10\x0e\x1a\x04\xa8E\x91\x03\x12\x19\n" +
+	"\x0fSessionNotFound\x10\x0f\x1a\x04\xa8E\x94\x03\x12\x18\n" +
+	"\x0eSessionRevoked\x10\x10\x1a\x04\xa8E\x91\x03\x12!\n" +
+	"\x17PasswordPolicyViolation\x10\x11\x1a\x04\xa8E\x90\x03\x12\"\n" +
+	"\x18PasswordResetRateLimited\x10\x12\x1a\x04\xa8E\xad\x03\x12#\n" +
+	"\x19InvalidPasswordResetToken\x10\x13\x1a\x04\xa8E\x90\x03\x12\x1a\n" +
+	"\x10UserNotActivated\x10\x14\x1a\x04\xa8E\x93\x03\x12'\n" +
+	"\x1dInvalidEmailVerificationToken\x10\x15\x1a\x04\xa8E\x90\x03\x12&\n" +
+	"\x1cEmailVerificationRateLimited\x10\x16\x1a\x04\xa8E\xad\x03\x12\x1c\n" +
+	"\x12RefreshTokenReused\x10\x17\x1a\x04\xa8E\x91\x03\x12\x16\n" +
+	"\fRoleNotFound\x10\x18\x1a\x04\xa8E\x94\x03\x12\x1b\n" +
+	"\x11RoleAlreadyExists\x10\x19\x1a\x04\xa8E\x99\x03\x12\x16\n" +
+	"\fRoleDisabled\x10\x1a\x1a\x04\xa8E\x90\x03\x12\x15\n" +
+	"\vRoleBuiltin\x10\x1b\x1a\x04\xa8E\x93\x03\x12\x16\n" +
+	"\fMenuNotFound\x10\x1c\x1a\x04\xa8E\x94\x03\x12\x19\n" +
+	"\x0fMenuHasChildren\x10\x1d\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12DepartmentNotFound\x10\x1e\x1a\x04\xa8E\x94\x03\x12\x1f\n" +
+	"\x15DepartmentHasChildren\x10\x1f\x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12DepartmentHasUsers\x10 \x1a\x04\xa8E\x99\x03\x12\x1c\n" +
+	"\x12DepartmentDisabled\x10!\x1a\x04\xa8E\x90\x03\x12\x16\n" +
+	"\fUserDisabled\x10\"\x1a\x04\xa8E\x93\x03\x1a\x04\xa0E\xf4\x03B@Z>github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var (
 	file_apiserver_v1_errors_proto_rawDescOnce sync.Once
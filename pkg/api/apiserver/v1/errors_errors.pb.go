@@ -122,3 +122,381 @@ func IsSecretCreateFailed(err error) bool {
 func ErrorSecretCreateFailed(format string, args ...interface{}) *errors.Error {
 	return errors.New(541, ErrorReason_SecretCreateFailed.String(), fmt.Sprintf(format, args...))
 }
+
+// 用户已被锁定，可能是由于登录失败次数过多，需要等待锁定到期或由管理员解锁
+func IsUserLocked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UserLocked.String() && e.Code == 403
+}
+
+// 用户已被锁定，可能是由于登录失败次数过多，需要等待锁定到期或由管理员解锁
+func ErrorUserLocked(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_UserLocked.String(), fmt.Sprintf(format, args...))
+}
+
+// 验证码错误，可能是验证码缺失、已过期或已被使用
+func IsInvalidCaptcha(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_InvalidCaptcha.String() && e.Code == 400
+}
+
+// 验证码错误，可能是验证码缺失、已过期或已被使用
+func ErrorInvalidCaptcha(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_InvalidCaptcha.String(), fmt.Sprintf(format, args...))
+}
+
+// 多因素认证已启用，需要先停用才能重新绑定
+func IsMFAAlreadyEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFAAlreadyEnabled.String() && e.Code == 409
+}
+
+// 多因素认证已启用，需要先停用才能重新绑定
+func ErrorMFAAlreadyEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_MFAAlreadyEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 多因素认证未启用或未开始绑定
+func IsMFANotEnabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MFANotEnabled.String() && e.Code == 400
+}
+
+// 多因素认证未启用或未开始绑定
+func ErrorMFANotEnabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_MFANotEnabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 多因素认证验证码错误，可能是验证码已过期、已被使用或恢复码无效
+func IsInvalidMFACode(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_InvalidMFACode.String() && e.Code == 401
+}
+
+// 多因素认证验证码错误，可能是验证码已过期、已被使用或恢复码无效
+func ErrorInvalidMFACode(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_InvalidMFACode.String(), fmt.Sprintf(format, args...))
+}
+
+// 身份提供方未配置，可能是输入的身份提供方名称有误
+func IsOIDCProviderNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDCProviderNotFound.String() && e.Code == 404
+}
+
+// 身份提供方未配置，可能是输入的身份提供方名称有误
+func ErrorOIDCProviderNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_OIDCProviderNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 单点登录失败，可能是 state 已过期、授权码无效或 ID Token 校验未通过
+func IsOIDCLoginFailed(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_OIDCLoginFailed.String() && e.Code == 401
+}
+
+// 单点登录失败，可能是 state 已过期、授权码无效或 ID Token 校验未通过
+func ErrorOIDCLoginFailed(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_OIDCLoginFailed.String(), fmt.Sprintf(format, args...))
+}
+
+// 会话不存在，可能是会话已过期、已被撤销或不属于该用户
+func IsSessionNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SessionNotFound.String() && e.Code == 404
+}
+
+// 会话不存在，可能是会话已过期、已被撤销或不属于该用户
+func ErrorSessionNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_SessionNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 会话已被撤销，令牌不能再使用，需要重新登录
+func IsSessionRevoked(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_SessionRevoked.String() && e.Code == 401
+}
+
+// 会话已被撤销，令牌不能再使用，需要重新登录
+func ErrorSessionRevoked(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_SessionRevoked.String(), fmt.Sprintf(format, args...))
+}
+
+// 密码不符合密码策略，metadata 中按规则列出了每一项不满足的原因
+func IsPasswordPolicyViolation(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PasswordPolicyViolation.String() && e.Code == 400
+}
+
+// 密码不符合密码策略，metadata 中按规则列出了每一项不满足的原因
+func ErrorPasswordPolicyViolation(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_PasswordPolicyViolation.String(), fmt.Sprintf(format, args...))
+}
+
+// 找回密码请求过于频繁，同一账号或同一 IP 需要稍后再试
+func IsPasswordResetRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_PasswordResetRateLimited.String() && e.Code == 429
+}
+
+// 找回密码请求过于频繁，同一账号或同一 IP 需要稍后再试
+func ErrorPasswordResetRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_PasswordResetRateLimited.String(), fmt.Sprintf(format, args...))
+}
+
+// 重置密码令牌无效，可能已过期、已被使用或已被更新的令牌取代
+func IsInvalidPasswordResetToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_InvalidPasswordResetToken.String() && e.Code == 400
+}
+
+// 重置密码令牌无效，可能已过期、已被使用或已被更新的令牌取代
+func ErrorInvalidPasswordResetToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_InvalidPasswordResetToken.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户尚未激活，需要先通过邮件中的链接验证邮箱
+func IsUserNotActivated(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UserNotActivated.String() && e.Code == 403
+}
+
+// 用户尚未激活，需要先通过邮件中的链接验证邮箱
+func ErrorUserNotActivated(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_UserNotActivated.String(), fmt.Sprintf(format, args...))
+}
+
+// 邮箱验证令牌无效，可能已过期、已被使用或已被重新发送的令牌取代
+func IsInvalidEmailVerificationToken(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_InvalidEmailVerificationToken.String() && e.Code == 400
+}
+
+// 邮箱验证令牌无效，可能已过期、已被使用或已被重新发送的令牌取代
+func ErrorInvalidEmailVerificationToken(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_InvalidEmailVerificationToken.String(), fmt.Sprintf(format, args...))
+}
+
+// 重新发送验证邮件过于频繁，需要稍后再试
+func IsEmailVerificationRateLimited(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_EmailVerificationRateLimited.String() && e.Code == 429
+}
+
+// 重新发送验证邮件过于频繁，需要稍后再试
+func ErrorEmailVerificationRateLimited(format string, args ...interface{}) *errors.Error {
+	return errors.New(429, ErrorReason_EmailVerificationRateLimited.String(), fmt.Sprintf(format, args...))
+}
+
+// 刷新令牌已经轮换过又被再次使用，令牌可能已经泄露，令牌所属的会话已被撤销，需要重新登录
+func IsRefreshTokenReused(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RefreshTokenReused.String() && e.Code == 401
+}
+
+// 刷新令牌已经轮换过又被再次使用，令牌可能已经泄露，令牌所属的会话已被撤销，需要重新登录
+func ErrorRefreshTokenReused(format string, args ...interface{}) *errors.Error {
+	return errors.New(401, ErrorReason_RefreshTokenReused.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色未找到，可能是角色不存在或输入的角色名称有误
+func IsRoleNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleNotFound.String() && e.Code == 404
+}
+
+// 角色未找到，可能是角色不存在或输入的角色名称有误
+func ErrorRoleNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_RoleNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色已存在，无法创建角色
+func IsRoleAlreadyExists(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleAlreadyExists.String() && e.Code == 409
+}
+
+// 角色已存在，无法创建角色
+func ErrorRoleAlreadyExists(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_RoleAlreadyExists.String(), fmt.Sprintf(format, args...))
+}
+
+// 角色已被禁用，不能分配给用户
+func IsRoleDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleDisabled.String() && e.Code == 400
+}
+
+// 角色已被禁用，不能分配给用户
+func ErrorRoleDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_RoleDisabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 内置角色不能删除或禁用
+func IsRoleBuiltin(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_RoleBuiltin.String() && e.Code == 403
+}
+
+// 内置角色不能删除或禁用
+func ErrorRoleBuiltin(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_RoleBuiltin.String(), fmt.Sprintf(format, args...))
+}
+
+// 菜单未找到，可能是菜单不存在或输入的菜单 ID 有误
+func IsMenuNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MenuNotFound.String() && e.Code == 404
+}
+
+// 菜单未找到，可能是菜单不存在或输入的菜单 ID 有误
+func ErrorMenuNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_MenuNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 菜单下仍有子菜单或按钮，需要先删除它们
+func IsMenuHasChildren(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_MenuHasChildren.String() && e.Code == 409
+}
+
+// 菜单下仍有子菜单或按钮，需要先删除它们
+func ErrorMenuHasChildren(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_MenuHasChildren.String(), fmt.Sprintf(format, args...))
+}
+
+// 部门未找到，可能是部门不存在或输入的部门 ID 有误
+func IsDepartmentNotFound(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DepartmentNotFound.String() && e.Code == 404
+}
+
+// 部门未找到，可能是部门不存在或输入的部门 ID 有误
+func ErrorDepartmentNotFound(format string, args ...interface{}) *errors.Error {
+	return errors.New(404, ErrorReason_DepartmentNotFound.String(), fmt.Sprintf(format, args...))
+}
+
+// 部门下仍有下级部门，需要先删除或移走它们
+func IsDepartmentHasChildren(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DepartmentHasChildren.String() && e.Code == 409
+}
+
+// 部门下仍有下级部门，需要先删除或移走它们
+func ErrorDepartmentHasChildren(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_DepartmentHasChildren.String(), fmt.Sprintf(format, args...))
+}
+
+// 部门下仍有用户，需要先将用户移到其他部门
+func IsDepartmentHasUsers(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DepartmentHasUsers.String() && e.Code == 409
+}
+
+// 部门下仍有用户，需要先将用户移到其他部门
+func ErrorDepartmentHasUsers(format string, args ...interface{}) *errors.Error {
+	return errors.New(409, ErrorReason_DepartmentHasUsers.String(), fmt.Sprintf(format, args...))
+}
+
+// 部门已被禁用，用户不能加入该部门
+func IsDepartmentDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_DepartmentDisabled.String() && e.Code == 400
+}
+
+// 部门已被禁用，用户不能加入该部门
+func ErrorDepartmentDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(400, ErrorReason_DepartmentDisabled.String(), fmt.Sprintf(format, args...))
+}
+
+// 用户已被禁用，需要联系管理员启用
+func IsUserDisabled(err error) bool {
+	if err == nil {
+		return false
+	}
+	e := errors.FromError(err)
+	return e.Reason == ErrorReason_UserDisabled.String() && e.Code == 403
+}
+
+// 用户已被禁用，需要联系管理员启用
+func ErrorUserDisabled(format string, args ...interface{}) *errors.Error {
+	return errors.New(403, ErrorReason_UserDisabled.String(), fmt.Sprintf(format, args...))
+}
@@ -70,3 +70,42 @@ func (x *UpdatePasswordRequest) Default() {
 
 func (x *UpdatePasswordResponse) Default() {
 }
+
+func (x *ChangeExpiredPasswordRequest) Default() {
+}
+
+func (x *ForgotPasswordRequest) Default() {
+}
+
+func (x *ForgotPasswordResponse) Default() {
+}
+
+func (x *ResetPasswordRequest) Default() {
+}
+
+func (x *ResetPasswordResponse) Default() {
+}
+
+func (x *VerifyEmailRequest) Default() {
+}
+
+func (x *VerifyEmailResponse) Default() {
+}
+
+func (x *ResendVerificationRequest) Default() {
+}
+
+func (x *ResendVerificationResponse) Default() {
+}
+
+func (x *ImpersonateUserRequest) Default() {
+}
+
+func (x *ImpersonateUserResponse) Default() {
+}
+
+func (x *UnlockUserRequest) Default() {
+}
+
+func (x *UnlockUserResponse) Default() {
+}
@@ -25,15 +25,35 @@ var File_apiserver_v1_usercenter_proto protoreflect.FileDescriptor
 
 const file_apiserver_v1_usercenter_proto_rawDesc = "" +
 	"\n" +
-	"\x1dapiserver/v1/usercenter.proto\x12\fapiserver.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19apiserver/v1/secret.proto\x1a\x17apiserver/v1/user.proto\x1a\x17apiserver/v1/auth.proto2\xb1\x0e\n" +
+	"\x1dapiserver/v1/usercenter.proto\x12\fapiserver.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x19apiserver/v1/secret.proto\x1a\x17apiserver/v1/user.proto\x1a\x17apiserver/v1/auth.proto\x1a\x16apiserver/v1/mfa.proto\x1a\x17apiserver/v1/oidc.proto\x1a\x1aapiserver/v1/session.proto\x1a\x18apiserver/v1/audit.proto\x1a\x17apiserver/v1/role.proto\x1a\x19apiserver/v1/policy.proto\x1a\x17apiserver/v1/menu.proto\x1a\x1dapiserver/v1/department.proto2\x9cC\n" +
 	"\n" +
 	"UserCenter\x12X\n" +
-	"\x05Login\x12\x1a.apiserver.v1.LoginRequest\x1a\x18.apiserver.v1.LoginReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12_\n" +
+	"\x05Login\x12\x1a.apiserver.v1.LoginRequest\x1a\x18.apiserver.v1.LoginReply\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/auth/login\x12e\n" +
+	"\tVerifyMFA\x12\x1e.apiserver.v1.VerifyMFARequest\x1a\x18.apiserver.v1.LoginReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/v1/auth/mfa/verify\x12\x8a\x01\n" +
+	"\x15ChangeExpiredPassword\x12*.apiserver.v1.ChangeExpiredPasswordRequest\x1a\x18.apiserver.v1.LoginReply\"+\x82\xd3\xe4\x93\x02%:\x01*\" /v1/auth/change-expired-password\x12\x80\x01\n" +
+	"\x0eForgotPassword\x12#.apiserver.v1.ForgotPasswordRequest\x1a$.apiserver.v1.ForgotPasswordResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/auth/password/forgot\x12|\n" +
+	"\rResetPassword\x12\".apiserver.v1.ResetPasswordRequest\x1a#.apiserver.v1.ResetPasswordResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/v1/auth/password/reset\x12t\n" +
+	"\vVerifyEmail\x12 .apiserver.v1.VerifyEmailRequest\x1a!.apiserver.v1.VerifyEmailResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/verify\x12\x89\x01\n" +
+	"\x12ResendVerification\x12'.apiserver.v1.ResendVerificationRequest\x1a(.apiserver.v1.ResendVerificationResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/email/resend\x12g\n" +
+	"\tEnrollMFA\x12\x1e.apiserver.v1.EnrollMFARequest\x1a\x1f.apiserver.v1.EnrollMFAResponse\"\x19\x82\xd3\xe4\x93\x02\x13:\x01*\"\x0e/v1/mfa/enroll\x12k\n" +
+	"\n" +
+	"ConfirmMFA\x12\x1f.apiserver.v1.ConfirmMFARequest\x1a .apiserver.v1.ConfirmMFAResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/mfa/confirm\x12k\n" +
+	"\n" +
+	"DisableMFA\x12\x1f.apiserver.v1.DisableMFARequest\x1a .apiserver.v1.DisableMFAResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/mfa/disable\x12\x82\x01\n" +
+	"\x10ListOIDCProvider\x12%.apiserver.v1.ListOIDCProviderRequest\x1a&.apiserver.v1.ListOIDCProviderResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/v1/auth/oidc/providers\x12t\n" +
+	"\tOIDCLogin\x12\x1e.apiserver.v1.OIDCLoginRequest\x1a\x1f.apiserver.v1.OIDCLoginResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/auth/oidc/{provider}/login\x12v\n" +
+	"\fOIDCCallback\x12!.apiserver.v1.OIDCCallbackRequest\x1a\x18.apiserver.v1.LoginReply\")\x82\xd3\xe4\x93\x02#\x12!/v1/auth/oidc/{provider}/callback\x12i\n" +
+	"\n" +
+	"GetCaptcha\x12\x1f.apiserver.v1.GetCaptchaRequest\x1a .apiserver.v1.GetCaptchaResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/auth/captcha\x12_\n" +
 	"\x06Logout\x12\x1b.apiserver.v1.LogoutRequest\x1a\x1c.apiserver.v1.LogoutResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/auth/logout\x12n\n" +
 	"\fRefreshToken\x12!.apiserver.v1.RefreshTokenRequest\x1a\x18.apiserver.v1.LoginReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/v1/auth/refresh-token\x12w\n" +
 	"\fAuthenticate\x12!.apiserver.v1.AuthenticateRequest\x1a\".apiserver.v1.AuthenticateResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/v1/auth/authenticate\x12k\n" +
 	"\tAuthorize\x12\x1e.apiserver.v1.AuthorizeRequest\x1a\x1f.apiserver.v1.AuthorizeResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\"\x12/v1/auth/authorize\x12W\n" +
-	"\x04Auth\x12\x19.apiserver.v1.AuthRequest\x1a\x1a.apiserver.v1.AuthResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/auth/auth\x12e\n" +
+	"\x04Auth\x12\x19.apiserver.v1.AuthRequest\x1a\x1a.apiserver.v1.AuthResponse\"\x18\x82\xd3\xe4\x93\x02\x12:\x01*\"\r/v1/auth/auth\x12]\n" +
+	"\x04JWKS\x12\x19.apiserver.v1.JWKSRequest\x1a\x1a.apiserver.v1.JWKSResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/.well-known/jwks.json\x12e\n" +
+	"\n" +
+	"ListJWTKey\x12\x1f.apiserver.v1.ListJWTKeyRequest\x1a .apiserver.v1.ListJWTKeyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/jwt-keys\x12y\n" +
+	"\rPromoteJWTKey\x12\".apiserver.v1.PromoteJWTKeyRequest\x1a#.apiserver.v1.PromoteJWTKeyResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/v1/jwt-keys/promote\x12e\n" +
 	"\n" +
 	"CreateUser\x12\x1f.apiserver.v1.CreateUserRequest\x1a .apiserver.v1.CreateUserResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/users\x12n\n" +
 	"\n" +
@@ -42,7 +62,56 @@ const file_apiserver_v1_usercenter_proto_rawDesc = "" +
 	"DeleteUser\x12\x1f.apiserver.v1.DeleteUserRequest\x1a .apiserver.v1.DeleteUserResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/users/{userID}\x12b\n" +
 	"\aGetUser\x12\x1c.apiserver.v1.GetUserRequest\x1a\x1d.apiserver.v1.GetUserResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/users/{userID}\x12\\\n" +
 	"\bListUser\x12\x1d.apiserver.v1.ListUserRequest\x1a\x1e.apiserver.v1.ListUserResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/users\x12\x8a\x01\n" +
-	"\x0eUpdatePassword\x12#.apiserver.v1.UpdatePasswordRequest\x1a$.apiserver.v1.UpdatePasswordResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/users/{userID}/update-password\x12m\n" +
+	"\x0eUpdatePassword\x12#.apiserver.v1.UpdatePasswordRequest\x1a$.apiserver.v1.UpdatePasswordResponse\"-\x82\xd3\xe4\x93\x02':\x01*\x1a\"/v1/users/{userID}/update-password\x12u\n" +
+	"\n" +
+	"UnlockUser\x12\x1f.apiserver.v1.UnlockUserRequest\x1a .apiserver.v1.UnlockUserResponse\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\"\x19/v1/users/{userID}/unlock\x12\x89\x01\n" +
+	"\x0fImpersonateUser\x12$.apiserver.v1.ImpersonateUserRequest\x1a%.apiserver.v1.ImpersonateUserResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/v1/users/{userID}/impersonate\x12h\n" +
+	"\vListSession\x12 .apiserver.v1.ListSessionRequest\x1a!.apiserver.v1.ListSessionResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/sessions\x12z\n" +
+	"\rDeleteSession\x12\".apiserver.v1.DeleteSessionRequest\x1a#.apiserver.v1.DeleteSessionResponse\" \x82\xd3\xe4\x93\x02\x1a*\x18/v1/sessions/{sessionID}\x12w\n" +
+	"\x10DeleteAllSession\x12%.apiserver.v1.DeleteAllSessionRequest\x1a&.apiserver.v1.DeleteAllSessionResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/v1/sessions\x12{\n" +
+	"\x0fListUserSession\x12 .apiserver.v1.ListSessionRequest\x1a!.apiserver.v1.ListSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/v1/users/{userID}/sessions\x12\x8d\x01\n" +
+	"\x11DeleteUserSession\x12\".apiserver.v1.DeleteSessionRequest\x1a#.apiserver.v1.DeleteSessionResponse\"/\x82\xd3\xe4\x93\x02)*'/v1/users/{userID}/sessions/{sessionID}\x12\x8a\x01\n" +
+	"\x14DeleteAllUserSession\x12%.apiserver.v1.DeleteAllSessionRequest\x1a&.apiserver.v1.DeleteAllSessionResponse\"#\x82\xd3\xe4\x93\x02\x1d*\x1b/v1/users/{userID}/sessions\x12m\n" +
+	"\fListAuditLog\x12!.apiserver.v1.ListAuditLogRequest\x1a\".apiserver.v1.ListAuditLogResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/audit-logs\x12\x80\x01\n" +
+	"\x10ListUserAuditLog\x12!.apiserver.v1.ListAuditLogRequest\x1a\".apiserver.v1.ListAuditLogResponse\"%\x82\xd3\xe4\x93\x02\x1f\x12\x1d/v1/users/{userID}/audit-logs\x12e\n" +
+	"\n" +
+	"CreateRole\x12\x1f.apiserver.v1.CreateRoleRequest\x1a .apiserver.v1.CreateRoleResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/roles\x12l\n" +
+	"\n" +
+	"UpdateRole\x12\x1f.apiserver.v1.UpdateRoleRequest\x1a .apiserver.v1.UpdateRoleResponse\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/v1/roles/{name}\x12i\n" +
+	"\n" +
+	"DeleteRole\x12\x1f.apiserver.v1.DeleteRoleRequest\x1a .apiserver.v1.DeleteRoleResponse\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/v1/roles/{name}\x12`\n" +
+	"\aGetRole\x12\x1c.apiserver.v1.GetRoleRequest\x1a\x1d.apiserver.v1.GetRoleResponse\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/v1/roles/{name}\x12\\\n" +
+	"\bListRole\x12\x1d.apiserver.v1.ListRoleRequest\x1a\x1e.apiserver.v1.ListRoleResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/roles\x12}\n" +
+	"\x0eListRoleMember\x12#.apiserver.v1.ListRoleMemberRequest\x1a$.apiserver.v1.ListRoleMemberResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/roles/{name}/members\x12w\n" +
+	"\fListUserRole\x12!.apiserver.v1.ListUserRoleRequest\x1a\".apiserver.v1.ListUserRoleResponse\" \x82\xd3\xe4\x93\x02\x1a\x12\x18/v1/users/{userID}/roles\x12\x80\x01\n" +
+	"\x0eAssignUserRole\x12#.apiserver.v1.AssignUserRoleRequest\x1a$.apiserver.v1.AssignUserRoleResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/v1/users/{userID}/roles\x12\x84\x01\n" +
+	"\x0eRemoveUserRole\x12#.apiserver.v1.RemoveUserRoleRequest\x1a$.apiserver.v1.RemoveUserRoleResponse\"'\x82\xd3\xe4\x93\x02!*\x1f/v1/users/{userID}/roles/{name}\x12e\n" +
+	"\n" +
+	"ListPolicy\x12\x1f.apiserver.v1.ListPolicyRequest\x1a .apiserver.v1.ListPolicyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e\x12\f/v1/policies\x12n\n" +
+	"\fCreatePolicy\x12!.apiserver.v1.CreatePolicyRequest\x1a\".apiserver.v1.CreatePolicyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/v1/policies\x12n\n" +
+	"\fUpdatePolicy\x12!.apiserver.v1.UpdatePolicyRequest\x1a\".apiserver.v1.UpdatePolicyResponse\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/v1/policies\x12k\n" +
+	"\fDeletePolicy\x12!.apiserver.v1.DeletePolicyRequest\x1a\".apiserver.v1.DeletePolicyResponse\"\x14\x82\xd3\xe4\x93\x02\x0e*\f/v1/policies\x12\x90\x01\n" +
+	"\x14ReplaceSubjectPolicy\x12).apiserver.v1.ReplaceSubjectPolicyRequest\x1a*.apiserver.v1.ReplaceSubjectPolicyResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/v1/policies/{subject}\x12e\n" +
+	"\n" +
+	"CreateMenu\x12\x1f.apiserver.v1.CreateMenuRequest\x1a .apiserver.v1.CreateMenuResponse\"\x14\x82\xd3\xe4\x93\x02\x0e:\x01*\"\t/v1/menus\x12n\n" +
+	"\n" +
+	"UpdateMenu\x12\x1f.apiserver.v1.UpdateMenuRequest\x1a .apiserver.v1.UpdateMenuResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/menus/{menuID}\x12k\n" +
+	"\n" +
+	"DeleteMenu\x12\x1f.apiserver.v1.DeleteMenuRequest\x1a .apiserver.v1.DeleteMenuResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/menus/{menuID}\x12b\n" +
+	"\aGetMenu\x12\x1c.apiserver.v1.GetMenuRequest\x1a\x1d.apiserver.v1.GetMenuResponse\"\x1a\x82\xd3\xe4\x93\x02\x14\x12\x12/v1/menus/{menuID}\x12\\\n" +
+	"\bListMenu\x12\x1d.apiserver.v1.ListMenuRequest\x1a\x1e.apiserver.v1.ListMenuResponse\"\x11\x82\xd3\xe4\x93\x02\v\x12\t/v1/menus\x12g\n" +
+	"\n" +
+	"ListMyMenu\x12\x1f.apiserver.v1.ListMyMenuRequest\x1a .apiserver.v1.ListMyMenuResponse\"\x16\x82\xd3\xe4\x93\x02\x10\x12\x0e/v1/menus/mine\x12u\n" +
+	"\fListRoleMenu\x12!.apiserver.v1.ListRoleMenuRequest\x1a\".apiserver.v1.ListRoleMenuResponse\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/v1/roles/{name}/menus\x12~\n" +
+	"\x0eUpdateRoleMenu\x12#.apiserver.v1.UpdateRoleMenuRequest\x1a$.apiserver.v1.UpdateRoleMenuResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/v1/roles/{name}/menus\x12}\n" +
+	"\x10CreateDepartment\x12%.apiserver.v1.CreateDepartmentRequest\x1a&.apiserver.v1.CreateDepartmentResponse\"\x1a\x82\xd3\xe4\x93\x02\x14:\x01*\"\x0f/v1/departments\x12\x8c\x01\n" +
+	"\x10UpdateDepartment\x12%.apiserver.v1.UpdateDepartmentRequest\x1a&.apiserver.v1.UpdateDepartmentResponse\")\x82\xd3\xe4\x93\x02#:\x01*\x1a\x1e/v1/departments/{departmentID}\x12\x89\x01\n" +
+	"\x10DeleteDepartment\x12%.apiserver.v1.DeleteDepartmentRequest\x1a&.apiserver.v1.DeleteDepartmentResponse\"&\x82\xd3\xe4\x93\x02 *\x1e/v1/departments/{departmentID}\x12\x80\x01\n" +
+	"\rGetDepartment\x12\".apiserver.v1.GetDepartmentRequest\x1a#.apiserver.v1.GetDepartmentResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/v1/departments/{departmentID}\x12t\n" +
+	"\x0eListDepartment\x12#.apiserver.v1.ListDepartmentRequest\x1a$.apiserver.v1.ListDepartmentResponse\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/v1/departments\x12\x9d\x01\n" +
+	"\x14GetDepartmentSubtree\x12).apiserver.v1.GetDepartmentSubtreeRequest\x1a*.apiserver.v1.GetDepartmentSubtreeResponse\".\x82\xd3\xe4\x93\x02(\x12&/v1/departments/{departmentID}/subtree\x12\xa5\x01\n" +
+	"\x16ListDepartmentAncestor\x12+.apiserver.v1.ListDepartmentAncestorRequest\x1a,.apiserver.v1.ListDepartmentAncestorResponse\"0\x82\xd3\xe4\x93\x02*\x12(/v1/departments/{departmentID}/ancestors\x12\x97\x01\n" +
+	"\x14UpdateUserDepartment\x12).apiserver.v1.UpdateUserDepartmentRequest\x1a*.apiserver.v1.UpdateUserDepartmentResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/v1/users/{userID}/department\x12m\n" +
 	"\fCreateSecret\x12!.apiserver.v1.CreateSecretRequest\x1a\".apiserver.v1.CreateSecretResponse\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/v1/secrets\x12t\n" +
 	"\fUpdateSecret\x12!.apiserver.v1.UpdateSecretRequest\x1a\".apiserver.v1.UpdateSecretResponse\"\x1d\x82\xd3\xe4\x93\x02\x17:\x01*\x1a\x12/v1/secrets/{name}\x12q\n" +
 	"\fDeleteSecret\x12!.apiserver.v1.DeleteSecretRequest\x1a\".apiserver.v1.DeleteSecretResponse\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/v1/secrets/{name}\x12h\n" +
@@ -51,80 +120,293 @@ const file_apiserver_v1_usercenter_proto_rawDesc = "" +
 	"ListSecret\x12\x1f.apiserver.v1.ListSecretRequest\x1a .apiserver.v1.ListSecretResponse\"\x13\x82\xd3\xe4\x93\x02\r\x12\v/v1/secretsB@Z>github.com/moweilong/art-design-pro-go/pkg/api/apiserver/v1;v1b\x06proto3"
 
 var file_apiserver_v1_usercenter_proto_goTypes = []any{
-	(*LoginRequest)(nil),           // 0: apiserver.v1.LoginRequest
-	(*LogoutRequest)(nil),          // 1: apiserver.v1.LogoutRequest
-	(*RefreshTokenRequest)(nil),    // 2: apiserver.v1.RefreshTokenRequest
-	(*AuthenticateRequest)(nil),    // 3: apiserver.v1.AuthenticateRequest
-	(*AuthorizeRequest)(nil),       // 4: apiserver.v1.AuthorizeRequest
-	(*AuthRequest)(nil),            // 5: apiserver.v1.AuthRequest
-	(*CreateUserRequest)(nil),      // 6: apiserver.v1.CreateUserRequest
-	(*UpdateUserRequest)(nil),      // 7: apiserver.v1.UpdateUserRequest
-	(*DeleteUserRequest)(nil),      // 8: apiserver.v1.DeleteUserRequest
-	(*GetUserRequest)(nil),         // 9: apiserver.v1.GetUserRequest
-	(*ListUserRequest)(nil),        // 10: apiserver.v1.ListUserRequest
-	(*UpdatePasswordRequest)(nil),  // 11: apiserver.v1.UpdatePasswordRequest
-	(*CreateSecretRequest)(nil),    // 12: apiserver.v1.CreateSecretRequest
-	(*UpdateSecretRequest)(nil),    // 13: apiserver.v1.UpdateSecretRequest
-	(*DeleteSecretRequest)(nil),    // 14: apiserver.v1.DeleteSecretRequest
-	(*GetSecretRequest)(nil),       // 15: apiserver.v1.GetSecretRequest
-	(*ListSecretRequest)(nil),      // 16: apiserver.v1.ListSecretRequest
-	(*LoginReply)(nil),             // 17: apiserver.v1.LoginReply
-	(*LogoutResponse)(nil),         // 18: apiserver.v1.LogoutResponse
-	(*AuthenticateResponse)(nil),   // 19: apiserver.v1.AuthenticateResponse
-	(*AuthorizeResponse)(nil),      // 20: apiserver.v1.AuthorizeResponse
-	(*AuthResponse)(nil),           // 21: apiserver.v1.AuthResponse
-	(*CreateUserResponse)(nil),     // 22: apiserver.v1.CreateUserResponse
-	(*UpdateUserResponse)(nil),     // 23: apiserver.v1.UpdateUserResponse
-	(*DeleteUserResponse)(nil),     // 24: apiserver.v1.DeleteUserResponse
-	(*GetUserResponse)(nil),        // 25: apiserver.v1.GetUserResponse
-	(*ListUserResponse)(nil),       // 26: apiserver.v1.ListUserResponse
-	(*UpdatePasswordResponse)(nil), // 27: apiserver.v1.UpdatePasswordResponse
-	(*CreateSecretResponse)(nil),   // 28: apiserver.v1.CreateSecretResponse
-	(*UpdateSecretResponse)(nil),   // 29: apiserver.v1.UpdateSecretResponse
-	(*DeleteSecretResponse)(nil),   // 30: apiserver.v1.DeleteSecretResponse
-	(*GetSecretResponse)(nil),      // 31: apiserver.v1.GetSecretResponse
-	(*ListSecretResponse)(nil),     // 32: apiserver.v1.ListSecretResponse
+	(*LoginRequest)(nil),                   // 0: apiserver.v1.LoginRequest
+	(*VerifyMFARequest)(nil),               // 1: apiserver.v1.VerifyMFARequest
+	(*ChangeExpiredPasswordRequest)(nil),   // 2: apiserver.v1.ChangeExpiredPasswordRequest
+	(*ForgotPasswordRequest)(nil),          // 3: apiserver.v1.ForgotPasswordRequest
+	(*ResetPasswordRequest)(nil),           // 4: apiserver.v1.ResetPasswordRequest
+	(*VerifyEmailRequest)(nil),             // 5: apiserver.v1.VerifyEmailRequest
+	(*ResendVerificationRequest)(nil),      // 6: apiserver.v1.ResendVerificationRequest
+	(*EnrollMFARequest)(nil),               // 7: apiserver.v1.EnrollMFARequest
+	(*ConfirmMFARequest)(nil),              // 8: apiserver.v1.ConfirmMFARequest
+	(*DisableMFARequest)(nil),              // 9: apiserver.v1.DisableMFARequest
+	(*ListOIDCProviderRequest)(nil),        // 10: apiserver.v1.ListOIDCProviderRequest
+	(*OIDCLoginRequest)(nil),               // 11: apiserver.v1.OIDCLoginRequest
+	(*OIDCCallbackRequest)(nil),            // 12: apiserver.v1.OIDCCallbackRequest
+	(*GetCaptchaRequest)(nil),              // 13: apiserver.v1.GetCaptchaRequest
+	(*LogoutRequest)(nil),                  // 14: apiserver.v1.LogoutRequest
+	(*RefreshTokenRequest)(nil),            // 15: apiserver.v1.RefreshTokenRequest
+	(*AuthenticateRequest)(nil),            // 16: apiserver.v1.AuthenticateRequest
+	(*AuthorizeRequest)(nil),               // 17: apiserver.v1.AuthorizeRequest
+	(*AuthRequest)(nil),                    // 18: apiserver.v1.AuthRequest
+	(*JWKSRequest)(nil),                    // 19: apiserver.v1.JWKSRequest
+	(*ListJWTKeyRequest)(nil),              // 20: apiserver.v1.ListJWTKeyRequest
+	(*PromoteJWTKeyRequest)(nil),           // 21: apiserver.v1.PromoteJWTKeyRequest
+	(*CreateUserRequest)(nil),              // 22: apiserver.v1.CreateUserRequest
+	(*UpdateUserRequest)(nil),              // 23: apiserver.v1.UpdateUserRequest
+	(*DeleteUserRequest)(nil),              // 24: apiserver.v1.DeleteUserRequest
+	(*GetUserRequest)(nil),                 // 25: apiserver.v1.GetUserRequest
+	(*ListUserRequest)(nil),                // 26: apiserver.v1.ListUserRequest
+	(*UpdatePasswordRequest)(nil),          // 27: apiserver.v1.UpdatePasswordRequest
+	(*UnlockUserRequest)(nil),              // 28: apiserver.v1.UnlockUserRequest
+	(*ImpersonateUserRequest)(nil),         // 29: apiserver.v1.ImpersonateUserRequest
+	(*ListSessionRequest)(nil),             // 30: apiserver.v1.ListSessionRequest
+	(*DeleteSessionRequest)(nil),           // 31: apiserver.v1.DeleteSessionRequest
+	(*DeleteAllSessionRequest)(nil),        // 32: apiserver.v1.DeleteAllSessionRequest
+	(*ListAuditLogRequest)(nil),            // 33: apiserver.v1.ListAuditLogRequest
+	(*CreateRoleRequest)(nil),              // 34: apiserver.v1.CreateRoleRequest
+	(*UpdateRoleRequest)(nil),              // 35: apiserver.v1.UpdateRoleRequest
+	(*DeleteRoleRequest)(nil),              // 36: apiserver.v1.DeleteRoleRequest
+	(*GetRoleRequest)(nil),                 // 37: apiserver.v1.GetRoleRequest
+	(*ListRoleRequest)(nil),                // 38: apiserver.v1.ListRoleRequest
+	(*ListRoleMemberRequest)(nil),          // 39: apiserver.v1.ListRoleMemberRequest
+	(*ListUserRoleRequest)(nil),            // 40: apiserver.v1.ListUserRoleRequest
+	(*AssignUserRoleRequest)(nil),          // 41: apiserver.v1.AssignUserRoleRequest
+	(*RemoveUserRoleRequest)(nil),          // 42: apiserver.v1.RemoveUserRoleRequest
+	(*ListPolicyRequest)(nil),              // 43: apiserver.v1.ListPolicyRequest
+	(*CreatePolicyRequest)(nil),            // 44: apiserver.v1.CreatePolicyRequest
+	(*UpdatePolicyRequest)(nil),            // 45: apiserver.v1.UpdatePolicyRequest
+	(*DeletePolicyRequest)(nil),            // 46: apiserver.v1.DeletePolicyRequest
+	(*ReplaceSubjectPolicyRequest)(nil),    // 47: apiserver.v1.ReplaceSubjectPolicyRequest
+	(*CreateMenuRequest)(nil),              // 48: apiserver.v1.CreateMenuRequest
+	(*UpdateMenuRequest)(nil),              // 49: apiserver.v1.UpdateMenuRequest
+	(*DeleteMenuRequest)(nil),              // 50: apiserver.v1.DeleteMenuRequest
+	(*GetMenuRequest)(nil),                 // 51: apiserver.v1.GetMenuRequest
+	(*ListMenuRequest)(nil),                // 52: apiserver.v1.ListMenuRequest
+	(*ListMyMenuRequest)(nil),              // 53: apiserver.v1.ListMyMenuRequest
+	(*ListRoleMenuRequest)(nil),            // 54: apiserver.v1.ListRoleMenuRequest
+	(*UpdateRoleMenuRequest)(nil),          // 55: apiserver.v1.UpdateRoleMenuRequest
+	(*CreateDepartmentRequest)(nil),        // 56: apiserver.v1.CreateDepartmentRequest
+	(*UpdateDepartmentRequest)(nil),        // 57: apiserver.v1.UpdateDepartmentRequest
+	(*DeleteDepartmentRequest)(nil),        // 58: apiserver.v1.DeleteDepartmentRequest
+	(*GetDepartmentRequest)(nil),           // 59: apiserver.v1.GetDepartmentRequest
+	(*ListDepartmentRequest)(nil),          // 60: apiserver.v1.ListDepartmentRequest
+	(*GetDepartmentSubtreeRequest)(nil),    // 61: apiserver.v1.GetDepartmentSubtreeRequest
+	(*ListDepartmentAncestorRequest)(nil),  // 62: apiserver.v1.ListDepartmentAncestorRequest
+	(*UpdateUserDepartmentRequest)(nil),    // 63: apiserver.v1.UpdateUserDepartmentRequest
+	(*CreateSecretRequest)(nil),            // 64: apiserver.v1.CreateSecretRequest
+	(*UpdateSecretRequest)(nil),            // 65: apiserver.v1.UpdateSecretRequest
+	(*DeleteSecretRequest)(nil),            // 66: apiserver.v1.DeleteSecretRequest
+	(*GetSecretRequest)(nil),               // 67: apiserver.v1.GetSecretRequest
+	(*ListSecretRequest)(nil),              // 68: apiserver.v1.ListSecretRequest
+	(*LoginReply)(nil),                     // 69: apiserver.v1.LoginReply
+	(*ForgotPasswordResponse)(nil),         // 70: apiserver.v1.ForgotPasswordResponse
+	(*ResetPasswordResponse)(nil),          // 71: apiserver.v1.ResetPasswordResponse
+	(*VerifyEmailResponse)(nil),            // 72: apiserver.v1.VerifyEmailResponse
+	(*ResendVerificationResponse)(nil),     // 73: apiserver.v1.ResendVerificationResponse
+	(*EnrollMFAResponse)(nil),              // 74: apiserver.v1.EnrollMFAResponse
+	(*ConfirmMFAResponse)(nil),             // 75: apiserver.v1.ConfirmMFAResponse
+	(*DisableMFAResponse)(nil),             // 76: apiserver.v1.DisableMFAResponse
+	(*ListOIDCProviderResponse)(nil),       // 77: apiserver.v1.ListOIDCProviderResponse
+	(*OIDCLoginResponse)(nil),              // 78: apiserver.v1.OIDCLoginResponse
+	(*GetCaptchaResponse)(nil),             // 79: apiserver.v1.GetCaptchaResponse
+	(*LogoutResponse)(nil),                 // 80: apiserver.v1.LogoutResponse
+	(*AuthenticateResponse)(nil),           // 81: apiserver.v1.AuthenticateResponse
+	(*AuthorizeResponse)(nil),              // 82: apiserver.v1.AuthorizeResponse
+	(*AuthResponse)(nil),                   // 83: apiserver.v1.AuthResponse
+	(*JWKSResponse)(nil),                   // 84: apiserver.v1.JWKSResponse
+	(*ListJWTKeyResponse)(nil),             // 85: apiserver.v1.ListJWTKeyResponse
+	(*PromoteJWTKeyResponse)(nil),          // 86: apiserver.v1.PromoteJWTKeyResponse
+	(*CreateUserResponse)(nil),             // 87: apiserver.v1.CreateUserResponse
+	(*UpdateUserResponse)(nil),             // 88: apiserver.v1.UpdateUserResponse
+	(*DeleteUserResponse)(nil),             // 89: apiserver.v1.DeleteUserResponse
+	(*GetUserResponse)(nil),                // 90: apiserver.v1.GetUserResponse
+	(*ListUserResponse)(nil),               // 91: apiserver.v1.ListUserResponse
+	(*UpdatePasswordResponse)(nil),         // 92: apiserver.v1.UpdatePasswordResponse
+	(*UnlockUserResponse)(nil),             // 93: apiserver.v1.UnlockUserResponse
+	(*ImpersonateUserResponse)(nil),        // 94: apiserver.v1.ImpersonateUserResponse
+	(*ListSessionResponse)(nil),            // 95: apiserver.v1.ListSessionResponse
+	(*DeleteSessionResponse)(nil),          // 96: apiserver.v1.DeleteSessionResponse
+	(*DeleteAllSessionResponse)(nil),       // 97: apiserver.v1.DeleteAllSessionResponse
+	(*ListAuditLogResponse)(nil),           // 98: apiserver.v1.ListAuditLogResponse
+	(*CreateRoleResponse)(nil),             // 99: apiserver.v1.CreateRoleResponse
+	(*UpdateRoleResponse)(nil),             // 100: apiserver.v1.UpdateRoleResponse
+	(*DeleteRoleResponse)(nil),             // 101: apiserver.v1.DeleteRoleResponse
+	(*GetRoleResponse)(nil),                // 102: apiserver.v1.GetRoleResponse
+	(*ListRoleResponse)(nil),               // 103: apiserver.v1.ListRoleResponse
+	(*ListRoleMemberResponse)(nil),         // 104: apiserver.v1.ListRoleMemberResponse
+	(*ListUserRoleResponse)(nil),           // 105: apiserver.v1.ListUserRoleResponse
+	(*AssignUserRoleResponse)(nil),         // 106: apiserver.v1.AssignUserRoleResponse
+	(*RemoveUserRoleResponse)(nil),         // 107: apiserver.v1.RemoveUserRoleResponse
+	(*ListPolicyResponse)(nil),             // 108: apiserver.v1.ListPolicyResponse
+	(*CreatePolicyResponse)(nil),           // 109: apiserver.v1.CreatePolicyResponse
+	(*UpdatePolicyResponse)(nil),           // 110: apiserver.v1.UpdatePolicyResponse
+	(*DeletePolicyResponse)(nil),           // 111: apiserver.v1.DeletePolicyResponse
+	(*ReplaceSubjectPolicyResponse)(nil),   // 112: apiserver.v1.ReplaceSubjectPolicyResponse
+	(*CreateMenuResponse)(nil),             // 113: apiserver.v1.CreateMenuResponse
+	(*UpdateMenuResponse)(nil),             // 114: apiserver.v1.UpdateMenuResponse
+	(*DeleteMenuResponse)(nil),             // 115: apiserver.v1.DeleteMenuResponse
+	(*GetMenuResponse)(nil),                // 116: apiserver.v1.GetMenuResponse
+	(*ListMenuResponse)(nil),               // 117: apiserver.v1.ListMenuResponse
+	(*ListMyMenuResponse)(nil),             // 118: apiserver.v1.ListMyMenuResponse
+	(*ListRoleMenuResponse)(nil),           // 119: apiserver.v1.ListRoleMenuResponse
+	(*UpdateRoleMenuResponse)(nil),         // 120: apiserver.v1.UpdateRoleMenuResponse
+	(*CreateDepartmentResponse)(nil),       // 121: apiserver.v1.CreateDepartmentResponse
+	(*UpdateDepartmentResponse)(nil),       // 122: apiserver.v1.UpdateDepartmentResponse
+	(*DeleteDepartmentResponse)(nil),       // 123: apiserver.v1.DeleteDepartmentResponse
+	(*GetDepartmentResponse)(nil),          // 124: apiserver.v1.GetDepartmentResponse
+	(*ListDepartmentResponse)(nil),         // 125: apiserver.v1.ListDepartmentResponse
+	(*GetDepartmentSubtreeResponse)(nil),   // 126: apiserver.v1.GetDepartmentSubtreeResponse
+	(*ListDepartmentAncestorResponse)(nil), // 127: apiserver.v1.ListDepartmentAncestorResponse
+	(*UpdateUserDepartmentResponse)(nil),   // 128: apiserver.v1.UpdateUserDepartmentResponse
+	(*CreateSecretResponse)(nil),           // 129: apiserver.v1.CreateSecretResponse
+	(*UpdateSecretResponse)(nil),           // 130: apiserver.v1.UpdateSecretResponse
+	(*DeleteSecretResponse)(nil),           // 131: apiserver.v1.DeleteSecretResponse
+	(*GetSecretResponse)(nil),              // 132: apiserver.v1.GetSecretResponse
+	(*ListSecretResponse)(nil),             // 133: apiserver.v1.ListSecretResponse
 }
 var file_apiserver_v1_usercenter_proto_depIdxs = []int32{
-	0,  // 0: apiserver.v1.UserCenter.Login:input_type -> apiserver.v1.LoginRequest
-	1,  // 1: apiserver.v1.UserCenter.Logout:input_type -> apiserver.v1.LogoutRequest
-	2,  // 2: apiserver.v1.UserCenter.RefreshToken:input_type -> apiserver.v1.RefreshTokenRequest
-	3,  // 3: apiserver.v1.UserCenter.Authenticate:input_type -> apiserver.v1.AuthenticateRequest
-	4,  // 4: apiserver.v1.UserCenter.Authorize:input_type -> apiserver.v1.AuthorizeRequest
-	5,  // 5: apiserver.v1.UserCenter.Auth:input_type -> apiserver.v1.AuthRequest
-	6,  // 6: apiserver.v1.UserCenter.CreateUser:input_type -> apiserver.v1.CreateUserRequest
-	7,  // 7: apiserver.v1.UserCenter.UpdateUser:input_type -> apiserver.v1.UpdateUserRequest
-	8,  // 8: apiserver.v1.UserCenter.DeleteUser:input_type -> apiserver.v1.DeleteUserRequest
-	9,  // 9: apiserver.v1.UserCenter.GetUser:input_type -> apiserver.v1.GetUserRequest
-	10, // 10: apiserver.v1.UserCenter.ListUser:input_type -> apiserver.v1.ListUserRequest
-	11, // 11: apiserver.v1.UserCenter.UpdatePassword:input_type -> apiserver.v1.UpdatePasswordRequest
-	12, // 12: apiserver.v1.UserCenter.CreateSecret:input_type -> apiserver.v1.CreateSecretRequest
-	13, // 13: apiserver.v1.UserCenter.UpdateSecret:input_type -> apiserver.v1.UpdateSecretRequest
-	14, // 14: apiserver.v1.UserCenter.DeleteSecret:input_type -> apiserver.v1.DeleteSecretRequest
-	15, // 15: apiserver.v1.UserCenter.GetSecret:input_type -> apiserver.v1.GetSecretRequest
-	16, // 16: apiserver.v1.UserCenter.ListSecret:input_type -> apiserver.v1.ListSecretRequest
-	17, // 17: apiserver.v1.UserCenter.Login:output_type -> apiserver.v1.LoginReply
-	18, // 18: apiserver.v1.UserCenter.Logout:output_type -> apiserver.v1.LogoutResponse
-	17, // 19: apiserver.v1.UserCenter.RefreshToken:output_type -> apiserver.v1.LoginReply
-	19, // 20: apiserver.v1.UserCenter.Authenticate:output_type -> apiserver.v1.AuthenticateResponse
-	20, // 21: apiserver.v1.UserCenter.Authorize:output_type -> apiserver.v1.AuthorizeResponse
-	21, // 22: apiserver.v1.UserCenter.Auth:output_type -> apiserver.v1.AuthResponse
-	22, // 23: apiserver.v1.UserCenter.CreateUser:output_type -> apiserver.v1.CreateUserResponse
-	23, // 24: apiserver.v1.UserCenter.UpdateUser:output_type -> apiserver.v1.UpdateUserResponse
-	24, // 25: apiserver.v1.UserCenter.DeleteUser:output_type -> apiserver.v1.DeleteUserResponse
-	25, // 26: apiserver.v1.UserCenter.GetUser:output_type -> apiserver.v1.GetUserResponse
-	26, // 27: apiserver.v1.UserCenter.ListUser:output_type -> apiserver.v1.ListUserResponse
-	27, // 28: apiserver.v1.UserCenter.UpdatePassword:output_type -> apiserver.v1.UpdatePasswordResponse
-	28, // 29: apiserver.v1.UserCenter.CreateSecret:output_type -> apiserver.v1.CreateSecretResponse
-	29, // 30: apiserver.v1.UserCenter.UpdateSecret:output_type -> apiserver.v1.UpdateSecretResponse
-	30, // 31: apiserver.v1.UserCenter.DeleteSecret:output_type -> apiserver.v1.DeleteSecretResponse
-	31, // 32: apiserver.v1.UserCenter.GetSecret:output_type -> apiserver.v1.GetSecretResponse
-	32, // 33: apiserver.v1.UserCenter.ListSecret:output_type -> apiserver.v1.ListSecretResponse
-	17, // [17:34] is the sub-list for method output_type
-	0,  // [0:17] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	0,   // 0: apiserver.v1.UserCenter.Login:input_type -> apiserver.v1.LoginRequest
+	1,   // 1: apiserver.v1.UserCenter.VerifyMFA:input_type -> apiserver.v1.VerifyMFARequest
+	2,   // 2: apiserver.v1.UserCenter.ChangeExpiredPassword:input_type -> apiserver.v1.ChangeExpiredPasswordRequest
+	3,   // 3: apiserver.v1.UserCenter.ForgotPassword:input_type -> apiserver.v1.ForgotPasswordRequest
+	4,   // 4: apiserver.v1.UserCenter.ResetPassword:input_type -> apiserver.v1.ResetPasswordRequest
+	5,   // 5: apiserver.v1.UserCenter.VerifyEmail:input_type -> apiserver.v1.VerifyEmailRequest
+	6,   // 6: apiserver.v1.UserCenter.ResendVerification:input_type -> apiserver.v1.ResendVerificationRequest
+	7,   // 7: apiserver.v1.UserCenter.EnrollMFA:input_type -> apiserver.v1.EnrollMFARequest
+	8,   // 8: apiserver.v1.UserCenter.ConfirmMFA:input_type -> apiserver.v1.ConfirmMFARequest
+	9,   // 9: apiserver.v1.UserCenter.DisableMFA:input_type -> apiserver.v1.DisableMFARequest
+	10,  // 10: apiserver.v1.UserCenter.ListOIDCProvider:input_type -> apiserver.v1.ListOIDCProviderRequest
+	11,  // 11: apiserver.v1.UserCenter.OIDCLogin:input_type -> apiserver.v1.OIDCLoginRequest
+	12,  // 12: apiserver.v1.UserCenter.OIDCCallback:input_type -> apiserver.v1.OIDCCallbackRequest
+	13,  // 13: apiserver.v1.UserCenter.GetCaptcha:input_type -> apiserver.v1.GetCaptchaRequest
+	14,  // 14: apiserver.v1.UserCenter.Logout:input_type -> apiserver.v1.LogoutRequest
+	15,  // 15: apiserver.v1.UserCenter.RefreshToken:input_type -> apiserver.v1.RefreshTokenRequest
+	16,  // 16: apiserver.v1.UserCenter.Authenticate:input_type -> apiserver.v1.AuthenticateRequest
+	17,  // 17: apiserver.v1.UserCenter.Authorize:input_type -> apiserver.v1.AuthorizeRequest
+	18,  // 18: apiserver.v1.UserCenter.Auth:input_type -> apiserver.v1.AuthRequest
+	19,  // 19: apiserver.v1.UserCenter.JWKS:input_type -> apiserver.v1.JWKSRequest
+	20,  // 20: apiserver.v1.UserCenter.ListJWTKey:input_type -> apiserver.v1.ListJWTKeyRequest
+	21,  // 21: apiserver.v1.UserCenter.PromoteJWTKey:input_type -> apiserver.v1.PromoteJWTKeyRequest
+	22,  // 22: apiserver.v1.UserCenter.CreateUser:input_type -> apiserver.v1.CreateUserRequest
+	23,  // 23: apiserver.v1.UserCenter.UpdateUser:input_type -> apiserver.v1.UpdateUserRequest
+	24,  // 24: apiserver.v1.UserCenter.DeleteUser:input_type -> apiserver.v1.DeleteUserRequest
+	25,  // 25: apiserver.v1.UserCenter.GetUser:input_type -> apiserver.v1.GetUserRequest
+	26,  // 26: apiserver.v1.UserCenter.ListUser:input_type -> apiserver.v1.ListUserRequest
+	27,  // 27: apiserver.v1.UserCenter.UpdatePassword:input_type -> apiserver.v1.UpdatePasswordRequest
+	28,  // 28: apiserver.v1.UserCenter.UnlockUser:input_type -> apiserver.v1.UnlockUserRequest
+	29,  // 29: apiserver.v1.UserCenter.ImpersonateUser:input_type -> apiserver.v1.ImpersonateUserRequest
+	30,  // 30: apiserver.v1.UserCenter.ListSession:input_type -> apiserver.v1.ListSessionRequest
+	31,  // 31: apiserver.v1.UserCenter.DeleteSession:input_type -> apiserver.v1.DeleteSessionRequest
+	32,  // 32: apiserver.v1.UserCenter.DeleteAllSession:input_type -> apiserver.v1.DeleteAllSessionRequest
+	30,  // 33: apiserver.v1.UserCenter.ListUserSession:input_type -> apiserver.v1.ListSessionRequest
+	31,  // 34: apiserver.v1.UserCenter.DeleteUserSession:input_type -> apiserver.v1.DeleteSessionRequest
+	32,  // 35: apiserver.v1.UserCenter.DeleteAllUserSession:input_type -> apiserver.v1.DeleteAllSessionRequest
+	33,  // 36: apiserver.v1.UserCenter.ListAuditLog:input_type -> apiserver.v1.ListAuditLogRequest
+	33,  // 37: apiserver.v1.UserCenter.ListUserAuditLog:input_type -> apiserver.v1.ListAuditLogRequest
+	34,  // 38: apiserver.v1.UserCenter.CreateRole:input_type -> apiserver.v1.CreateRoleRequest
+	35,  // 39: apiserver.v1.UserCenter.UpdateRole:input_type -> apiserver.v1.UpdateRoleRequest
+	36,  // 40: apiserver.v1.UserCenter.DeleteRole:input_type -> apiserver.v1.DeleteRoleRequest
+	37,  // 41: apiserver.v1.UserCenter.GetRole:input_type -> apiserver.v1.GetRoleRequest
+	38,  // 42: apiserver.v1.UserCenter.ListRole:input_type -> apiserver.v1.ListRoleRequest
+	39,  // 43: apiserver.v1.UserCenter.ListRoleMember:input_type -> apiserver.v1.ListRoleMemberRequest
+	40,  // 44: apiserver.v1.UserCenter.ListUserRole:input_type -> apiserver.v1.ListUserRoleRequest
+	41,  // 45: apiserver.v1.UserCenter.AssignUserRole:input_type -> apiserver.v1.AssignUserRoleRequest
+	42,  // 46: apiserver.v1.UserCenter.RemoveUserRole:input_type -> apiserver.v1.RemoveUserRoleRequest
+	43,  // 47: apiserver.v1.UserCenter.ListPolicy:input_type -> apiserver.v1.ListPolicyRequest
+	44,  // 48: apiserver.v1.UserCenter.CreatePolicy:input_type -> apiserver.v1.CreatePolicyRequest
+	45,  // 49: apiserver.v1.UserCenter.UpdatePolicy:input_type -> apiserver.v1.UpdatePolicyRequest
+	46,  // 50: apiserver.v1.UserCenter.DeletePolicy:input_type -> apiserver.v1.DeletePolicyRequest
+	47,  // 51: apiserver.v1.UserCenter.ReplaceSubjectPolicy:input_type -> apiserver.v1.ReplaceSubjectPolicyRequest
+	48,  // 52: apiserver.v1.UserCenter.CreateMenu:input_type -> apiserver.v1.CreateMenuRequest
+	49,  // 53: apiserver.v1.UserCenter.UpdateMenu:input_type -> apiserver.v1.UpdateMenuRequest
+	50,  // 54: apiserver.v1.UserCenter.DeleteMenu:input_type -> apiserver.v1.DeleteMenuRequest
+	51,  // 55: apiserver.v1.UserCenter.GetMenu:input_type -> apiserver.v1.GetMenuRequest
+	52,  // 56: apiserver.v1.UserCenter.ListMenu:input_type -> apiserver.v1.ListMenuRequest
+	53,  // 57: apiserver.v1.UserCenter.ListMyMenu:input_type -> apiserver.v1.ListMyMenuRequest
+	54,  // 58: apiserver.v1.UserCenter.ListRoleMenu:input_type -> apiserver.v1.ListRoleMenuRequest
+	55,  // 59: apiserver.v1.UserCenter.UpdateRoleMenu:input_type -> apiserver.v1.UpdateRoleMenuRequest
+	56,  // 60: apiserver.v1.UserCenter.CreateDepartment:input_type -> apiserver.v1.CreateDepartmentRequest
+	57,  // 61: apiserver.v1.UserCenter.UpdateDepartment:input_type -> apiserver.v1.UpdateDepartmentRequest
+	58,  // 62: apiserver.v1.UserCenter.DeleteDepartment:input_type -> apiserver.v1.DeleteDepartmentRequest
+	59,  // 63: apiserver.v1.UserCenter.GetDepartment:input_type -> apiserver.v1.GetDepartmentRequest
+	60,  // 64: apiserver.v1.UserCenter.ListDepartment:input_type -> apiserver.v1.ListDepartmentRequest
+	61,  // 65: apiserver.v1.UserCenter.GetDepartmentSubtree:input_type -> apiserver.v1.GetDepartmentSubtreeRequest
+	62,  // 66: apiserver.v1.UserCenter.ListDepartmentAncestor:input_type -> apiserver.v1.ListDepartmentAncestorRequest
+	63,  // 67: apiserver.v1.UserCenter.UpdateUserDepartment:input_type -> apiserver.v1.UpdateUserDepartmentRequest
+	64,  // 68: apiserver.v1.UserCenter.CreateSecret:input_type -> apiserver.v1.CreateSecretRequest
+	65,  // 69: apiserver.v1.UserCenter.UpdateSecret:input_type -> apiserver.v1.UpdateSecretRequest
+	66,  // 70: apiserver.v1.UserCenter.DeleteSecret:input_type -> apiserver.v1.DeleteSecretRequest
+	67,  // 71: apiserver.v1.UserCenter.GetSecret:input_type -> apiserver.v1.GetSecretRequest
+	68,  // 72: apiserver.v1.UserCenter.ListSecret:input_type -> apiserver.v1.ListSecretRequest
+	69,  // 73: apiserver.v1.UserCenter.Login:output_type -> apiserver.v1.LoginReply
+	69,  // 74: apiserver.v1.UserCenter.VerifyMFA:output_type -> apiserver.v1.LoginReply
+	69,  // 75: apiserver.v1.UserCenter.ChangeExpiredPassword:output_type -> apiserver.v1.LoginReply
+	70,  // 76: apiserver.v1.UserCenter.ForgotPassword:output_type -> apiserver.v1.ForgotPasswordResponse
+	71,  // 77: apiserver.v1.UserCenter.ResetPassword:output_type -> apiserver.v1.ResetPasswordResponse
+	72,  // 78: apiserver.v1.UserCenter.VerifyEmail:output_type -> apiserver.v1.VerifyEmailResponse
+	73,  // 79: apiserver.v1.UserCenter.ResendVerification:output_type -> apiserver.v1.ResendVerificationResponse
+	74,  // 80: apiserver.v1.UserCenter.EnrollMFA:output_type -> apiserver.v1.EnrollMFAResponse
+	75,  // 81: apiserver.v1.UserCenter.ConfirmMFA:output_type -> apiserver.v1.ConfirmMFAResponse
+	76,  // 82: apiserver.v1.UserCenter.DisableMFA:output_type -> apiserver.v1.DisableMFAResponse
+	77,  // 83: apiserver.v1.UserCenter.ListOIDCProvider:output_type -> apiserver.v1.ListOIDCProviderResponse
+	78,  // 84: apiserver.v1.UserCenter.OIDCLogin:output_type -> apiserver.v1.OIDCLoginResponse
+	69,  // 85: apiserver.v1.UserCenter.OIDCCallback:output_type -> apiserver.v1.LoginReply
+	79,  // 86: apiserver.v1.UserCenter.GetCaptcha:output_type -> apiserver.v1.GetCaptchaResponse
+	80,  // 87: apiserver.v1.UserCenter.Logout:output_type -> apiserver.v1.LogoutResponse
+	69,  // 88: apiserver.v1.UserCenter.RefreshToken:output_type -> apiserver.v1.LoginReply
+	81,  // 89: apiserver.v1.UserCenter.Authenticate:output_type -> apiserver.v1.AuthenticateResponse
+	82,  // 90: apiserver.v1.UserCenter.Authorize:output_type -> apiserver.v1.AuthorizeResponse
+	83,  // 91: apiserver.v1.UserCenter.Auth:output_type -> apiserver.v1.AuthResponse
+	84,  // 92: apiserver.v1.UserCenter.JWKS:output_type -> apiserver.v1.JWKSResponse
+	85,  // 93: apiserver.v1.UserCenter.ListJWTKey:output_type -> apiserver.v1.ListJWTKeyResponse
+	86,  // 94: apiserver.v1.UserCenter.PromoteJWTKey:output_type -> apiserver.v1.PromoteJWTKeyResponse
+	87,  // 95: apiserver.v1.UserCenter.CreateUser:output_type -> apiserver.v1.CreateUserResponse
+	88,  // 96: apiserver.v1.UserCenter.UpdateUser:output_type -> apiserver.v1.UpdateUserResponse
+	89,  // 97: apiserver.v1.UserCenter.DeleteUser:output_type -> apiserver.v1.DeleteUserResponse
+	90,  // 98: apiserver.v1.UserCenter.GetUser:output_type -> apiserver.v1.GetUserResponse
+	91,  // 99: apiserver.v1.UserCenter.ListUser:output_type -> apiserver.v1.ListUserResponse
+	92,  // 100: apiserver.v1.UserCenter.UpdatePassword:output_type -> apiserver.v1.UpdatePasswordResponse
+	93,  // 101: apiserver.v1.UserCenter.UnlockUser:output_type -> apiserver.v1.UnlockUserResponse
+	94,  // 102: apiserver.v1.UserCenter.ImpersonateUser:output_type -> apiserver.v1.ImpersonateUserResponse
+	95,  // 103: apiserver.v1.UserCenter.ListSession:output_type -> apiserver.v1.ListSessionResponse
+	96,  // 104: apiserver.v1.UserCenter.DeleteSession:output_type -> apiserver.v1.DeleteSessionResponse
+	97,  // 105: apiserver.v1.UserCenter.DeleteAllSession:output_type -> apiserver.v1.DeleteAllSessionResponse
+	95,  // 106: apiserver.v1.UserCenter.ListUserSession:output_type -> apiserver.v1.ListSessionResponse
+	96,  // 107: apiserver.v1.UserCenter.DeleteUserSession:output_type -> apiserver.v1.DeleteSessionResponse
+	97,  // 108: apiserver.v1.UserCenter.DeleteAllUserSession:output_type -> apiserver.v1.DeleteAllSessionResponse
+	98,  // 109: apiserver.v1.UserCenter.ListAuditLog:output_type -> apiserver.v1.ListAuditLogResponse
+	98,  // 110: apiserver.v1.UserCenter.ListUserAuditLog:output_type -> apiserver.v1.ListAuditLogResponse
+	99,  // 111: apiserver.v1.UserCenter.CreateRole:output_type -> apiserver.v1.CreateRoleResponse
+	100, // 112: apiserver.v1.UserCenter.UpdateRole:output_type -> apiserver.v1.UpdateRoleResponse
+	101, // 113: apiserver.v1.UserCenter.DeleteRole:output_type -> apiserver.v1.DeleteRoleResponse
+	102, // 114: apiserver.v1.UserCenter.GetRole:output_type -> apiserver.v1.GetRoleResponse
+	103, // 115: apiserver.v1.UserCenter.ListRole:output_type -> apiserver.v1.ListRoleResponse
+	104, // 116: apiserver.v1.UserCenter.ListRoleMember:output_type -> apiserver.v1.ListRoleMemberResponse
+	105, // 117: apiserver.v1.UserCenter.ListUserRole:output_type -> apiserver.v1.ListUserRoleResponse
+	106, // 118: apiserver.v1.UserCenter.AssignUserRole:output_type -> apiserver.v1.AssignUserRoleResponse
+	107, // 119: apiserver.v1.UserCenter.RemoveUserRole:output_type -> apiserver.v1.RemoveUserRoleResponse
+	108, // 120: apiserver.v1.UserCenter.ListPolicy:output_type -> apiserver.v1.ListPolicyResponse
+	109, // 121: apiserver.v1.UserCenter.CreatePolicy:output_type -> apiserver.v1.CreatePolicyResponse
+	110, // 122: apiserver.v1.UserCenter.UpdatePolicy:output_type -> apiserver.v1.UpdatePolicyResponse
+	111, // 123: apiserver.v1.UserCenter.DeletePolicy:output_type -> apiserver.v1.DeletePolicyResponse
+	112, // 124: apiserver.v1.UserCenter.ReplaceSubjectPolicy:output_type -> apiserver.v1.ReplaceSubjectPolicyResponse
+	113, // 125: apiserver.v1.UserCenter.CreateMenu:output_type -> apiserver.v1.CreateMenuResponse
+	114, // 126: apiserver.v1.UserCenter.UpdateMenu:output_type -> apiserver.v1.UpdateMenuResponse
+	115, // 127: apiserver.v1.UserCenter.DeleteMenu:output_type -> apiserver.v1.DeleteMenuResponse
+	116, // 128: apiserver.v1.UserCenter.GetMenu:output_type -> apiserver.v1.GetMenuResponse
+	117, // 129: apiserver.v1.UserCenter.ListMenu:output_type -> apiserver.v1.ListMenuResponse
+	118, // 130: apiserver.v1.UserCenter.ListMyMenu:output_type -> apiserver.v1.ListMyMenuResponse
+	119, // 131: apiserver.v1.UserCenter.ListRoleMenu:output_type -> apiserver.v1.ListRoleMenuResponse
+	120, // 132: apiserver.v1.UserCenter.UpdateRoleMenu:output_type -> apiserver.v1.UpdateRoleMenuResponse
+	121, // 133: apiserver.v1.UserCenter.CreateDepartment:output_type -> apiserver.v1.CreateDepartmentResponse
+	122, // 134: apiserver.v1.UserCenter.UpdateDepartment:output_type -> apiserver.v1.UpdateDepartmentResponse
+	123, // 135: apiserver.v1.UserCenter.DeleteDepartment:output_type -> apiserver.v1.DeleteDepartmentResponse
+	124, // 136: apiserver.v1.UserCenter.GetDepartment:output_type -> apiserver.v1.GetDepartmentResponse
+	125, // 137: apiserver.v1.UserCenter.ListDepartment:output_type -> apiserver.v1.ListDepartmentResponse
+	126, // 138: apiserver.v1.UserCenter.GetDepartmentSubtree:output_type -> apiserver.v1.GetDepartmentSubtreeResponse
+	127, // 139: apiserver.v1.UserCenter.ListDepartmentAncestor:output_type -> apiserver.v1.ListDepartmentAncestorResponse
+	128, // 140: apiserver.v1.UserCenter.UpdateUserDepartment:output_type -> apiserver.v1.UpdateUserDepartmentResponse
+	129, // 141: apiserver.v1.UserCenter.CreateSecret:output_type -> apiserver.v1.CreateSecretResponse
+	130, // 142: apiserver.v1.UserCenter.UpdateSecret:output_type -> apiserver.v1.UpdateSecretResponse
+	131, // 143: apiserver.v1.UserCenter.DeleteSecret:output_type -> apiserver.v1.DeleteSecretResponse
+	132, // 144: apiserver.v1.UserCenter.GetSecret:output_type -> apiserver.v1.GetSecretResponse
+	133, // 145: apiserver.v1.UserCenter.ListSecret:output_type -> apiserver.v1.ListSecretResponse
+	73,  // [73:146] is the sub-list for method output_type
+	0,   // [0:73] is the sub-list for method input_type
+	0,   // [0:0] is the sub-list for extension type_name
+	0,   // [0:0] is the sub-list for extension extendee
+	0,   // [0:0] is the sub-list for field type_name
 }
 
 func init() { file_apiserver_v1_usercenter_proto_init() }
@@ -135,6 +417,14 @@ func file_apiserver_v1_usercenter_proto_init() {
 	file_apiserver_v1_secret_proto_init()
 	file_apiserver_v1_user_proto_init()
 	file_apiserver_v1_auth_proto_init()
+	file_apiserver_v1_mfa_proto_init()
+	file_apiserver_v1_oidc_proto_init()
+	file_apiserver_v1_session_proto_init()
+	file_apiserver_v1_audit_proto_init()
+	file_apiserver_v1_role_proto_init()
+	file_apiserver_v1_policy_proto_init()
+	file_apiserver_v1_menu_proto_init()
+	file_apiserver_v1_department_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -19,72 +19,296 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationUserCenterAssignUserRole = "/apiserver.v1.UserCenter/AssignUserRole"
 const OperationUserCenterAuth = "/apiserver.v1.UserCenter/Auth"
 const OperationUserCenterAuthenticate = "/apiserver.v1.UserCenter/Authenticate"
 const OperationUserCenterAuthorize = "/apiserver.v1.UserCenter/Authorize"
+const OperationUserCenterChangeExpiredPassword = "/apiserver.v1.UserCenter/ChangeExpiredPassword"
+const OperationUserCenterConfirmMFA = "/apiserver.v1.UserCenter/ConfirmMFA"
+const OperationUserCenterCreateDepartment = "/apiserver.v1.UserCenter/CreateDepartment"
+const OperationUserCenterCreateMenu = "/apiserver.v1.UserCenter/CreateMenu"
+const OperationUserCenterCreatePolicy = "/apiserver.v1.UserCenter/CreatePolicy"
+const OperationUserCenterCreateRole = "/apiserver.v1.UserCenter/CreateRole"
 const OperationUserCenterCreateSecret = "/apiserver.v1.UserCenter/CreateSecret"
 const OperationUserCenterCreateUser = "/apiserver.v1.UserCenter/CreateUser"
+const OperationUserCenterDeleteAllSession = "/apiserver.v1.UserCenter/DeleteAllSession"
+const OperationUserCenterDeleteAllUserSession = "/apiserver.v1.UserCenter/DeleteAllUserSession"
+const OperationUserCenterDeleteDepartment = "/apiserver.v1.UserCenter/DeleteDepartment"
+const OperationUserCenterDeleteMenu = "/apiserver.v1.UserCenter/DeleteMenu"
+const OperationUserCenterDeletePolicy = "/apiserver.v1.UserCenter/DeletePolicy"
+const OperationUserCenterDeleteRole = "/apiserver.v1.UserCenter/DeleteRole"
 const OperationUserCenterDeleteSecret = "/apiserver.v1.UserCenter/DeleteSecret"
+const OperationUserCenterDeleteSession = "/apiserver.v1.UserCenter/DeleteSession"
 const OperationUserCenterDeleteUser = "/apiserver.v1.UserCenter/DeleteUser"
+const OperationUserCenterDeleteUserSession = "/apiserver.v1.UserCenter/DeleteUserSession"
+const OperationUserCenterDisableMFA = "/apiserver.v1.UserCenter/DisableMFA"
+const OperationUserCenterEnrollMFA = "/apiserver.v1.UserCenter/EnrollMFA"
+const OperationUserCenterForgotPassword = "/apiserver.v1.UserCenter/ForgotPassword"
+const OperationUserCenterGetCaptcha = "/apiserver.v1.UserCenter/GetCaptcha"
+const OperationUserCenterGetDepartment = "/apiserver.v1.UserCenter/GetDepartment"
+const OperationUserCenterGetDepartmentSubtree = "/apiserver.v1.UserCenter/GetDepartmentSubtree"
+const OperationUserCenterGetMenu = "/apiserver.v1.UserCenter/GetMenu"
+const OperationUserCenterGetRole = "/apiserver.v1.UserCenter/GetRole"
 const OperationUserCenterGetSecret = "/apiserver.v1.UserCenter/GetSecret"
 const OperationUserCenterGetUser = "/apiserver.v1.UserCenter/GetUser"
+const OperationUserCenterImpersonateUser = "/apiserver.v1.UserCenter/ImpersonateUser"
+const OperationUserCenterJWKS = "/apiserver.v1.UserCenter/JWKS"
+const OperationUserCenterListAuditLog = "/apiserver.v1.UserCenter/ListAuditLog"
+const OperationUserCenterListDepartment = "/apiserver.v1.UserCenter/ListDepartment"
+const OperationUserCenterListDepartmentAncestor = "/apiserver.v1.UserCenter/ListDepartmentAncestor"
+const OperationUserCenterListJWTKey = "/apiserver.v1.UserCenter/ListJWTKey"
+const OperationUserCenterListMenu = "/apiserver.v1.UserCenter/ListMenu"
+const OperationUserCenterListMyMenu = "/apiserver.v1.UserCenter/ListMyMenu"
+const OperationUserCenterListOIDCProvider = "/apiserver.v1.UserCenter/ListOIDCProvider"
+const OperationUserCenterListPolicy = "/apiserver.v1.UserCenter/ListPolicy"
+const OperationUserCenterListRole = "/apiserver.v1.UserCenter/ListRole"
+const OperationUserCenterListRoleMember = "/apiserver.v1.UserCenter/ListRoleMember"
+const OperationUserCenterListRoleMenu = "/apiserver.v1.UserCenter/ListRoleMenu"
 const OperationUserCenterListSecret = "/apiserver.v1.UserCenter/ListSecret"
+const OperationUserCenterListSession = "/apiserver.v1.UserCenter/ListSession"
 const OperationUserCenterListUser = "/apiserver.v1.UserCenter/ListUser"
+const OperationUserCenterListUserAuditLog = "/apiserver.v1.UserCenter/ListUserAuditLog"
+const OperationUserCenterListUserRole = "/apiserver.v1.UserCenter/ListUserRole"
+const OperationUserCenterListUserSession = "/apiserver.v1.UserCenter/ListUserSession"
 const OperationUserCenterLogin = "/apiserver.v1.UserCenter/Login"
 const OperationUserCenterLogout = "/apiserver.v1.UserCenter/Logout"
+const OperationUserCenterOIDCCallback = "/apiserver.v1.UserCenter/OIDCCallback"
+const OperationUserCenterOIDCLogin = "/apiserver.v1.UserCenter/OIDCLogin"
+const OperationUserCenterPromoteJWTKey = "/apiserver.v1.UserCenter/PromoteJWTKey"
 const OperationUserCenterRefreshToken = "/apiserver.v1.UserCenter/RefreshToken"
+const OperationUserCenterRemoveUserRole = "/apiserver.v1.UserCenter/RemoveUserRole"
+const OperationUserCenterReplaceSubjectPolicy = "/apiserver.v1.UserCenter/ReplaceSubjectPolicy"
+const OperationUserCenterResendVerification = "/apiserver.v1.UserCenter/ResendVerification"
+const OperationUserCenterResetPassword = "/apiserver.v1.UserCenter/ResetPassword"
+const OperationUserCenterUnlockUser = "/apiserver.v1.UserCenter/UnlockUser"
+const OperationUserCenterUpdateDepartment = "/apiserver.v1.UserCenter/UpdateDepartment"
+const OperationUserCenterUpdateMenu = "/apiserver.v1.UserCenter/UpdateMenu"
 const OperationUserCenterUpdatePassword = "/apiserver.v1.UserCenter/UpdatePassword"
+const OperationUserCenterUpdatePolicy = "/apiserver.v1.UserCenter/UpdatePolicy"
+const OperationUserCenterUpdateRole = "/apiserver.v1.UserCenter/UpdateRole"
+const OperationUserCenterUpdateRoleMenu = "/apiserver.v1.UserCenter/UpdateRoleMenu"
 const OperationUserCenterUpdateSecret = "/apiserver.v1.UserCenter/UpdateSecret"
 const OperationUserCenterUpdateUser = "/apiserver.v1.UserCenter/UpdateUser"
+const OperationUserCenterUpdateUserDepartment = "/apiserver.v1.UserCenter/UpdateUserDepartment"
+const OperationUserCenterVerifyEmail = "/apiserver.v1.UserCenter/VerifyEmail"
+const OperationUserCenterVerifyMFA = "/apiserver.v1.UserCenter/VerifyMFA"
 
 type UserCenterHTTPServer interface {
+	// AssignUserRole AssignUserRole
+	AssignUserRole(context.Context, *AssignUserRoleRequest) (*AssignUserRoleResponse, error)
 	// Auth Auth
 	Auth(context.Context, *AuthRequest) (*AuthResponse, error)
 	// Authenticate Authenticate
 	Authenticate(context.Context, *AuthenticateRequest) (*AuthenticateResponse, error)
 	// Authorize Authorize
 	Authorize(context.Context, *AuthorizeRequest) (*AuthorizeResponse, error)
+	// ChangeExpiredPassword ChangeExpiredPassword
+	ChangeExpiredPassword(context.Context, *ChangeExpiredPasswordRequest) (*LoginReply, error)
+	// ConfirmMFA ConfirmMFA
+	ConfirmMFA(context.Context, *ConfirmMFARequest) (*ConfirmMFAResponse, error)
+	// CreateDepartment CreateDepartment
+	CreateDepartment(context.Context, *CreateDepartmentRequest) (*CreateDepartmentResponse, error)
+	// CreateMenu CreateMenu
+	CreateMenu(context.Context, *CreateMenuRequest) (*CreateMenuResponse, error)
+	// CreatePolicy CreatePolicy
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
+	// CreateRole CreateRole
+	CreateRole(context.Context, *CreateRoleRequest) (*CreateRoleResponse, error)
 	// CreateSecret CreateSecret
 	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
+	// DeleteAllSession DeleteAllSession
+	DeleteAllSession(context.Context, *DeleteAllSessionRequest) (*DeleteAllSessionResponse, error)
+	// DeleteAllUserSession DeleteAllUserSession
+	DeleteAllUserSession(context.Context, *DeleteAllSessionRequest) (*DeleteAllSessionResponse, error)
+	// DeleteDepartment DeleteDepartment
+	DeleteDepartment(context.Context, *DeleteDepartmentRequest) (*DeleteDepartmentResponse, error)
+	// DeleteMenu DeleteMenu
+	DeleteMenu(context.Context, *DeleteMenuRequest) (*DeleteMenuResponse, error)
+	// DeletePolicy DeletePolicy
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	// DeleteRole DeleteRole
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleResponse, error)
 	// DeleteSecret DeleteSecret
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	// DeleteSession DeleteSession
+	DeleteSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
 	// DeleteUser DeleteUser
 	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	// DeleteUserSession DeleteUserSession
+	DeleteUserSession(context.Context, *DeleteSessionRequest) (*DeleteSessionResponse, error)
+	// DisableMFA DisableMFA
+	DisableMFA(context.Context, *DisableMFARequest) (*DisableMFAResponse, error)
+	// EnrollMFA EnrollMFA
+	EnrollMFA(context.Context, *EnrollMFARequest) (*EnrollMFAResponse, error)
+	// ForgotPassword ForgotPassword
+	ForgotPassword(context.Context, *ForgotPasswordRequest) (*ForgotPasswordResponse, error)
+	// GetCaptcha GetCaptcha
+	GetCaptcha(context.Context, *GetCaptchaRequest) (*GetCaptchaResponse, error)
+	// GetDepartment GetDepartment
+	GetDepartment(context.Context, *GetDepartmentRequest) (*GetDepartmentResponse, error)
+	// GetDepartmentSubtree GetDepartmentSubtree
+	GetDepartmentSubtree(context.Context, *GetDepartmentSubtreeRequest) (*GetDepartmentSubtreeResponse, error)
+	// GetMenu GetMenu
+	GetMenu(context.Context, *GetMenuRequest) (*GetMenuResponse, error)
+	// GetRole GetRole
+	GetRole(context.Context, *GetRoleRequest) (*GetRoleResponse, error)
 	// GetSecret GetSecret
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
+	// ImpersonateUser ImpersonateUser
+	ImpersonateUser(context.Context, *ImpersonateUserRequest) (*ImpersonateUserResponse, error)
+	// JWKS JWKS returns the public keys used to verify tokens.
+	JWKS(context.Context, *JWKSRequest) (*JWKSResponse, error)
+	// ListAuditLog ListAuditLog
+	ListAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	// ListDepartment ListDepartment
+	ListDepartment(context.Context, *ListDepartmentRequest) (*ListDepartmentResponse, error)
+	// ListDepartmentAncestor ListDepartmentAncestor
+	ListDepartmentAncestor(context.Context, *ListDepartmentAncestorRequest) (*ListDepartmentAncestorResponse, error)
+	// ListJWTKey ListJWTKey lists the JWT keys and their state.
+	ListJWTKey(context.Context, *ListJWTKeyRequest) (*ListJWTKeyResponse, error)
+	// ListMenu ListMenu
+	ListMenu(context.Context, *ListMenuRequest) (*ListMenuResponse, error)
+	// ListMyMenu ListMyMenu
+	ListMyMenu(context.Context, *ListMyMenuRequest) (*ListMyMenuResponse, error)
+	// ListOIDCProvider ListOIDCProvider
+	ListOIDCProvider(context.Context, *ListOIDCProviderRequest) (*ListOIDCProviderResponse, error)
+	// ListPolicy ListPolicy
+	ListPolicy(context.Context, *ListPolicyRequest) (*ListPolicyResponse, error)
+	// ListRole ListRole
+	ListRole(context.Context, *ListRoleRequest) (*ListRoleResponse, error)
+	// ListRoleMember ListRoleMember
+	ListRoleMember(context.Context, *ListRoleMemberRequest) (*ListRoleMemberResponse, error)
+	// ListRoleMenu ListRoleMenu
+	ListRoleMenu(context.Context, *ListRoleMenuRequest) (*ListRoleMenuResponse, error)
 	// ListSecret ListSecret
 	ListSecret(context.Context, *ListSecretRequest) (*ListSecretResponse, error)
+	// ListSession ListSession
+	ListSession(context.Context, *ListSessionRequest) (*ListSessionResponse, error)
 	// ListUser ListUser
 	ListUser(context.Context, *ListUserRequest) (*ListUserResponse, error)
+	// ListUserAuditLog ListUserAuditLog
+	ListUserAuditLog(context.Context, *ListAuditLogRequest) (*ListAuditLogResponse, error)
+	// ListUserRole ListUserRole
+	ListUserRole(context.Context, *ListUserRoleRequest) (*ListUserRoleResponse, error)
+	// ListUserSession ListUserSession
+	ListUserSession(context.Context, *ListSessionRequest) (*ListSessionResponse, error)
 	// Login Login
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	// Logout Logout
 	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	// OIDCCallback OIDCCallback
+	OIDCCallback(context.Context, *OIDCCallbackRequest) (*LoginReply, error)
+	// OIDCLogin OIDCLogin
+	OIDCLogin(context.Context, *OIDCLoginRequest) (*OIDCLoginResponse, error)
+	// PromoteJWTKey PromoteJWTKey makes a JWT key the one new tokens are signed with.
+	PromoteJWTKey(context.Context, *PromoteJWTKeyRequest) (*PromoteJWTKeyResponse, error)
 	// RefreshToken RefreshToken
 	RefreshToken(context.Context, *RefreshTokenRequest) (*LoginReply, error)
+	// RemoveUserRole RemoveUserRole
+	RemoveUserRole(context.Context, *RemoveUserRoleRequest) (*RemoveUserRoleResponse, error)
+	// ReplaceSubjectPolicy ReplaceSubjectPolicy
+	ReplaceSubjectPolicy(context.Context, *ReplaceSubjectPolicyRequest) (*ReplaceSubjectPolicyResponse, error)
+	// ResendVerification ResendVerification
+	ResendVerification(context.Context, *ResendVerificationRequest) (*ResendVerificationResponse, error)
+	// ResetPassword ResetPassword
+	ResetPassword(context.Context, *ResetPasswordRequest) (*ResetPasswordResponse, error)
+	// UnlockUser UnlockUser
+	UnlockUser(context.Context, *UnlockUserRequest) (*UnlockUserResponse, error)
+	// UpdateDepartment UpdateDepartment
+	UpdateDepartment(context.Context, *UpdateDepartmentRequest) (*UpdateDepartmentResponse, error)
+	// UpdateMenu UpdateMenu
+	UpdateMenu(context.Context, *UpdateMenuRequest) (*UpdateMenuResponse, error)
 	// UpdatePassword UpdatePassword
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordResponse, error)
+	// UpdatePolicy UpdatePolicy
+	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*UpdatePolicyResponse, error)
+	// UpdateRole UpdateRole
+	UpdateRole(context.Context, *UpdateRoleRequest) (*UpdateRoleResponse, error)
+	// UpdateRoleMenu UpdateRoleMenu
+	UpdateRoleMenu(context.Context, *UpdateRoleMenuRequest) (*UpdateRoleMenuResponse, error)
 	// UpdateSecret UpdateSecret
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	// UpdateUserDepartment UpdateUserDepartment
+	UpdateUserDepartment(context.Context, *UpdateUserDepartmentRequest) (*UpdateUserDepartmentResponse, error)
+	// VerifyEmail VerifyEmail
+	VerifyEmail(context.Context, *VerifyEmailRequest) (*VerifyEmailResponse, error)
+	// VerifyMFA VerifyMFA
+	VerifyMFA(context.Context, *VerifyMFARequest) (*LoginReply, error)
 }
 
 func RegisterUserCenterHTTPServer(s *http.Server, srv UserCenterHTTPServer) {
 	r := s.Route("/")
 	r.POST("/v1/auth/login", _UserCenter_Login0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/verify", _UserCenter_VerifyMFA0_HTTP_Handler(srv))
+	r.POST("/v1/auth/change-expired-password", _UserCenter_ChangeExpiredPassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/forgot", _UserCenter_ForgotPassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password/reset", _UserCenter_ResetPassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/email/verify", _UserCenter_VerifyEmail0_HTTP_Handler(srv))
+	r.POST("/v1/auth/email/resend", _UserCenter_ResendVerification0_HTTP_Handler(srv))
+	r.POST("/v1/mfa/enroll", _UserCenter_EnrollMFA0_HTTP_Handler(srv))
+	r.POST("/v1/mfa/confirm", _UserCenter_ConfirmMFA0_HTTP_Handler(srv))
+	r.POST("/v1/mfa/disable", _UserCenter_DisableMFA0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/providers", _UserCenter_ListOIDCProvider0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/{provider}/login", _UserCenter_OIDCLogin0_HTTP_Handler(srv))
+	r.GET("/v1/auth/oidc/{provider}/callback", _UserCenter_OIDCCallback0_HTTP_Handler(srv))
+	r.GET("/v1/auth/captcha", _UserCenter_GetCaptcha0_HTTP_Handler(srv))
 	r.POST("/v1/auth/logout", _UserCenter_Logout0_HTTP_Handler(srv))
 	r.POST("/v1/auth/refresh-token", _UserCenter_RefreshToken0_HTTP_Handler(srv))
 	r.POST("/v1/auth/authenticate", _UserCenter_Authenticate0_HTTP_Handler(srv))
 	r.POST("/v1/auth/authorize", _UserCenter_Authorize0_HTTP_Handler(srv))
 	r.POST("/v1/auth/auth", _UserCenter_Auth0_HTTP_Handler(srv))
+	r.GET("/.well-known/jwks.json", _UserCenter_JWKS0_HTTP_Handler(srv))
+	r.GET("/v1/jwt-keys", _UserCenter_ListJWTKey0_HTTP_Handler(srv))
+	r.POST("/v1/jwt-keys/promote", _UserCenter_PromoteJWTKey0_HTTP_Handler(srv))
 	r.POST("/v1/users", _UserCenter_CreateUser0_HTTP_Handler(srv))
 	r.PUT("/v1/users/{userID}", _UserCenter_UpdateUser0_HTTP_Handler(srv))
 	r.DELETE("/v1/users/{userID}", _UserCenter_DeleteUser0_HTTP_Handler(srv))
 	r.GET("/v1/users/{userID}", _UserCenter_GetUser0_HTTP_Handler(srv))
 	r.GET("/v1/users", _UserCenter_ListUser0_HTTP_Handler(srv))
 	r.PUT("/v1/users/{userID}/update-password", _UserCenter_UpdatePassword0_HTTP_Handler(srv))
+	r.POST("/v1/users/{userID}/unlock", _UserCenter_UnlockUser0_HTTP_Handler(srv))
+	r.POST("/v1/users/{userID}/impersonate", _UserCenter_ImpersonateUser0_HTTP_Handler(srv))
+	r.GET("/v1/sessions", _UserCenter_ListSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/sessions/{sessionID}", _UserCenter_DeleteSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/sessions", _UserCenter_DeleteAllSession0_HTTP_Handler(srv))
+	r.GET("/v1/users/{userID}/sessions", _UserCenter_ListUserSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{userID}/sessions/{sessionID}", _UserCenter_DeleteUserSession0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{userID}/sessions", _UserCenter_DeleteAllUserSession0_HTTP_Handler(srv))
+	r.GET("/v1/audit-logs", _UserCenter_ListAuditLog0_HTTP_Handler(srv))
+	r.GET("/v1/users/{userID}/audit-logs", _UserCenter_ListUserAuditLog0_HTTP_Handler(srv))
+	r.POST("/v1/roles", _UserCenter_CreateRole0_HTTP_Handler(srv))
+	r.PUT("/v1/roles/{name}", _UserCenter_UpdateRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/roles/{name}", _UserCenter_DeleteRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{name}", _UserCenter_GetRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles", _UserCenter_ListRole0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{name}/members", _UserCenter_ListRoleMember0_HTTP_Handler(srv))
+	r.GET("/v1/users/{userID}/roles", _UserCenter_ListUserRole0_HTTP_Handler(srv))
+	r.POST("/v1/users/{userID}/roles", _UserCenter_AssignUserRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{userID}/roles/{name}", _UserCenter_RemoveUserRole0_HTTP_Handler(srv))
+	r.GET("/v1/policies", _UserCenter_ListPolicy0_HTTP_Handler(srv))
+	r.POST("/v1/policies", _UserCenter_CreatePolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/policies", _UserCenter_UpdatePolicy0_HTTP_Handler(srv))
+	r.DELETE("/v1/policies", _UserCenter_DeletePolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/policies/{subject}", _UserCenter_ReplaceSubjectPolicy0_HTTP_Handler(srv))
+	r.POST("/v1/menus", _UserCenter_CreateMenu0_HTTP_Handler(srv))
+	r.PUT("/v1/menus/{menuID}", _UserCenter_UpdateMenu0_HTTP_Handler(srv))
+	r.DELETE("/v1/menus/{menuID}", _UserCenter_DeleteMenu0_HTTP_Handler(srv))
+	r.GET("/v1/menus/{menuID}", _UserCenter_GetMenu0_HTTP_Handler(srv))
+	r.GET("/v1/menus", _UserCenter_ListMenu0_HTTP_Handler(srv))
+	r.GET("/v1/menus/mine", _UserCenter_ListMyMenu0_HTTP_Handler(srv))
+	r.GET("/v1/roles/{name}/menus", _UserCenter_ListRoleMenu0_HTTP_Handler(srv))
+	r.PUT("/v1/roles/{name}/menus", _UserCenter_UpdateRoleMenu0_HTTP_Handler(srv))
+	r.POST("/v1/departments", _UserCenter_CreateDepartment0_HTTP_Handler(srv))
+	r.PUT("/v1/departments/{departmentID}", _UserCenter_UpdateDepartment0_HTTP_Handler(srv))
+	r.DELETE("/v1/departments/{departmentID}", _UserCenter_DeleteDepartment0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{departmentID}", _UserCenter_GetDepartment0_HTTP_Handler(srv))
+	r.GET("/v1/departments", _UserCenter_ListDepartment0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{departmentID}/subtree", _UserCenter_GetDepartmentSubtree0_HTTP_Handler(srv))
+	r.GET("/v1/departments/{departmentID}/ancestors", _UserCenter_ListDepartmentAncestor0_HTTP_Handler(srv))
+	r.PUT("/v1/users/{userID}/department", _UserCenter_UpdateUserDepartment0_HTTP_Handler(srv))
 	r.POST("/v1/secrets", _UserCenter_CreateSecret0_HTTP_Handler(srv))
 	r.PUT("/v1/secrets/{name}", _UserCenter_UpdateSecret0_HTTP_Handler(srv))
 	r.DELETE("/v1/secrets/{name}", _UserCenter_DeleteSecret0_HTTP_Handler(srv))
@@ -114,40 +338,40 @@ func _UserCenter_Login0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Con
 	}
 }
 
-func _UserCenter_Logout0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_VerifyMFA0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in LogoutRequest
+		var in VerifyMFARequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterLogout)
+		http.SetOperation(ctx, OperationUserCenterVerifyMFA)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Logout(ctx, req.(*LogoutRequest))
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*LogoutResponse)
+		reply := out.(*LoginReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_RefreshToken0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_ChangeExpiredPassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in RefreshTokenRequest
+		var in ChangeExpiredPasswordRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterRefreshToken)
+		http.SetOperation(ctx, OperationUserCenterChangeExpiredPassword)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+			return srv.ChangeExpiredPassword(ctx, req.(*ChangeExpiredPasswordRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
@@ -158,441 +382,2146 @@ func _UserCenter_RefreshToken0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx h
 	}
 }
 
-func _UserCenter_Authenticate0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_ForgotPassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AuthenticateRequest
+		var in ForgotPasswordRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterAuthenticate)
+		http.SetOperation(ctx, OperationUserCenterForgotPassword)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Authenticate(ctx, req.(*AuthenticateRequest))
+			return srv.ForgotPassword(ctx, req.(*ForgotPasswordRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AuthenticateResponse)
+		reply := out.(*ForgotPasswordResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_Authorize0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_ResetPassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AuthorizeRequest
+		var in ResetPasswordRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterAuthorize)
+		http.SetOperation(ctx, OperationUserCenterResetPassword)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Authorize(ctx, req.(*AuthorizeRequest))
+			return srv.ResetPassword(ctx, req.(*ResetPasswordRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AuthorizeResponse)
+		reply := out.(*ResetPasswordResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_Auth0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_VerifyEmail0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in AuthRequest
+		var in VerifyEmailRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterAuth)
+		http.SetOperation(ctx, OperationUserCenterVerifyEmail)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.Auth(ctx, req.(*AuthRequest))
+			return srv.VerifyEmail(ctx, req.(*VerifyEmailRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*AuthResponse)
+		reply := out.(*VerifyEmailResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_CreateUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_ResendVerification0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateUserRequest
+		var in ResendVerificationRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterCreateUser)
+		http.SetOperation(ctx, OperationUserCenterResendVerification)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateUser(ctx, req.(*CreateUserRequest))
+			return srv.ResendVerification(ctx, req.(*ResendVerificationRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateUserResponse)
+		reply := out.(*ResendVerificationResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_UpdateUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_EnrollMFA0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateUserRequest
+		var in EnrollMFARequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		http.SetOperation(ctx, OperationUserCenterEnrollMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollMFA(ctx, req.(*EnrollMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterUpdateUser)
+		reply := out.(*EnrollMFAResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ConfirmMFA0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterConfirmMFA)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateUser(ctx, req.(*UpdateUserRequest))
+			return srv.ConfirmMFA(ctx, req.(*ConfirmMFARequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UpdateUserResponse)
+		reply := out.(*ConfirmMFAResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_DeleteUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_DisableMFA0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteUserRequest
+		var in DisableMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*DisableMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DisableMFAResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListOIDCProvider0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOIDCProviderRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListOIDCProvider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOIDCProvider(ctx, req.(*ListOIDCProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOIDCProviderResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_OIDCLogin0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in OIDCLoginRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterDeleteUser)
+		http.SetOperation(ctx, OperationUserCenterOIDCLogin)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteUser(ctx, req.(*DeleteUserRequest))
+			return srv.OIDCLogin(ctx, req.(*OIDCLoginRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteUserResponse)
+		reply := out.(*OIDCLoginResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_GetUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_OIDCCallback0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetUserRequest
+		var in OIDCCallbackRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindVars(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterGetUser)
+		http.SetOperation(ctx, OperationUserCenterOIDCCallback)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetUser(ctx, req.(*GetUserRequest))
+			return srv.OIDCCallback(ctx, req.(*OIDCCallbackRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetUserResponse)
+		reply := out.(*LoginReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_ListUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_GetCaptcha0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListUserRequest
+		var in GetCaptchaRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterListUser)
+		http.SetOperation(ctx, OperationUserCenterGetCaptcha)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListUser(ctx, req.(*ListUserRequest))
+			return srv.GetCaptcha(ctx, req.(*GetCaptchaRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListUserResponse)
+		reply := out.(*GetCaptchaResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_UpdatePassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_Logout0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdatePasswordRequest
+		var in LogoutRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationUserCenterUpdatePassword)
+		http.SetOperation(ctx, OperationUserCenterLogout)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdatePassword(ctx, req.(*UpdatePasswordRequest))
+			return srv.Logout(ctx, req.(*LogoutRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UpdatePasswordResponse)
+		reply := out.(*LogoutResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_CreateSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_RefreshToken0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in CreateSecretRequest
+		var in RefreshTokenRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterCreateSecret)
+		http.SetOperation(ctx, OperationUserCenterRefreshToken)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.CreateSecret(ctx, req.(*CreateSecretRequest))
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*CreateSecretResponse)
+		reply := out.(*LoginReply)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_UpdateSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_Authenticate0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in UpdateSecretRequest
+		var in AuthenticateRequest
 		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
-			return err
-		}
-		http.SetOperation(ctx, OperationUserCenterUpdateSecret)
+		http.SetOperation(ctx, OperationUserCenterAuthenticate)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.UpdateSecret(ctx, req.(*UpdateSecretRequest))
+			return srv.Authenticate(ctx, req.(*AuthenticateRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*UpdateSecretResponse)
+		reply := out.(*AuthenticateResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_DeleteSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_Authorize0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in DeleteSecretRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in AuthorizeRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterDeleteSecret)
+		http.SetOperation(ctx, OperationUserCenterAuthorize)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.DeleteSecret(ctx, req.(*DeleteSecretRequest))
+			return srv.Authorize(ctx, req.(*AuthorizeRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*DeleteSecretResponse)
+		reply := out.(*AuthorizeResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_GetSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_Auth0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in GetSecretRequest
-		if err := ctx.BindQuery(&in); err != nil {
+		var in AuthRequest
+		if err := ctx.Bind(&in); err != nil {
 			return err
 		}
-		if err := ctx.BindVars(&in); err != nil {
+		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterGetSecret)
+		http.SetOperation(ctx, OperationUserCenterAuth)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.GetSecret(ctx, req.(*GetSecretRequest))
+			return srv.Auth(ctx, req.(*AuthRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*GetSecretResponse)
+		reply := out.(*AuthResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-func _UserCenter_ListSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+func _UserCenter_JWKS0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
-		var in ListSecretRequest
+		var in JWKSRequest
 		if err := ctx.BindQuery(&in); err != nil {
 			return err
 		}
-		http.SetOperation(ctx, OperationUserCenterListSecret)
+		http.SetOperation(ctx, OperationUserCenterJWKS)
 		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
-			return srv.ListSecret(ctx, req.(*ListSecretRequest))
+			return srv.JWKS(ctx, req.(*JWKSRequest))
 		})
 		out, err := h(ctx, &in)
 		if err != nil {
 			return err
 		}
-		reply := out.(*ListSecretResponse)
+		reply := out.(*JWKSResponse)
 		return ctx.Result(200, reply)
 	}
 }
 
-type UserCenterHTTPClient interface {
-	Auth(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
-	Authenticate(ctx context.Context, req *AuthenticateRequest, opts ...http.CallOption) (rsp *AuthenticateResponse, err error)
-	Authorize(ctx context.Context, req *AuthorizeRequest, opts ...http.CallOption) (rsp *AuthorizeResponse, err error)
-	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *CreateSecretResponse, err error)
-	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserResponse, err error)
-	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *DeleteSecretResponse, err error)
-	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserResponse, err error)
-	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *GetSecretResponse, err error)
-	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserResponse, err error)
-	ListSecret(ctx context.Context, req *ListSecretRequest, opts ...http.CallOption) (rsp *ListSecretResponse, err error)
-	ListUser(ctx context.Context, req *ListUserRequest, opts ...http.CallOption) (rsp *ListUserResponse, err error)
-	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
-	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
-	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
-	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *UpdatePasswordResponse, err error)
-	UpdateSecret(ctx context.Context, req *UpdateSecretRequest, opts ...http.CallOption) (rsp *UpdateSecretResponse, err error)
-	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserResponse, err error)
-}
-
-type UserCenterHTTPClientImpl struct {
-	cc *http.Client
+func _UserCenter_ListJWTKey0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListJWTKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListJWTKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListJWTKey(ctx, req.(*ListJWTKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListJWTKeyResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func NewUserCenterHTTPClient(client *http.Client) UserCenterHTTPClient {
-	return &UserCenterHTTPClientImpl{client}
+func _UserCenter_PromoteJWTKey0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PromoteJWTKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterPromoteJWTKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PromoteJWTKey(ctx, req.(*PromoteJWTKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PromoteJWTKeyResponse)
+		return ctx.Result(200, reply)
+	}
 }
 
-func (c *UserCenterHTTPClientImpl) Auth(ctx context.Context, in *AuthRequest, opts ...http.CallOption) (*AuthResponse, error) {
-	var out AuthResponse
-	pattern := "/v1/auth/auth"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCenterAuth))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _UserCenter_CreateUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateUser(ctx, req.(*CreateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateUserResponse)
+		return ctx.Result(200, reply)
 	}
-	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...http.CallOption) (*AuthenticateResponse, error) {
-	var out AuthenticateResponse
-	pattern := "/v1/auth/authenticate"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCenterAuthenticate))
-	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
-	if err != nil {
-		return nil, err
+func _UserCenter_UpdateUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUser(ctx, req.(*UpdateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteUser(ctx, req.(*DeleteUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUser(ctx, req.(*GetUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUser(ctx, req.(*ListUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdatePassword0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdatePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePassword(ctx, req.(*UpdatePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePasswordResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UnlockUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlockUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUnlockUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlockUser(ctx, req.(*UnlockUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlockUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ImpersonateUser0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImpersonateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterImpersonateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImpersonateUser(ctx, req.(*ImpersonateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImpersonateUserResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSession(ctx, req.(*ListSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSession(ctx, req.(*DeleteSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteAllSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAllSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteAllSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAllSession(ctx, req.(*DeleteAllSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAllSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListUserSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListUserSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserSession(ctx, req.(*ListSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteUserSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteUserSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteUserSession(ctx, req.(*DeleteSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteAllUserSession0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteAllSessionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteAllUserSession)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteAllUserSession(ctx, req.(*DeleteAllSessionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteAllSessionResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListAuditLog0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditLogRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListAuditLog)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAuditLog(ctx, req.(*ListAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListUserAuditLog0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAuditLogRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListUserAuditLog)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserAuditLog(ctx, req.(*ListAuditLogRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAuditLogResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreateRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRole(ctx, req.(*CreateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRole(ctx, req.(*DeleteRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRole(ctx, req.(*GetRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRole(ctx, req.(*ListRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListRoleMember0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleMemberRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListRoleMember)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleMember(ctx, req.(*ListRoleMemberRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleMemberResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListUserRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserRole(ctx, req.(*ListUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_AssignUserRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in AssignUserRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterAssignUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.AssignUserRole(ctx, req.(*AssignUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*AssignUserRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_RemoveUserRole0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RemoveUserRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterRemoveUserRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RemoveUserRole(ctx, req.(*RemoveUserRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RemoveUserRoleResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListPolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPolicy(ctx, req.(*ListPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreatePolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreatePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePolicy(ctx, req.(*CreatePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreatePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdatePolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdatePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePolicy(ctx, req.(*UpdatePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdatePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeletePolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeletePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePolicy(ctx, req.(*DeletePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ReplaceSubjectPolicy0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReplaceSubjectPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterReplaceSubjectPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ReplaceSubjectPolicy(ctx, req.(*ReplaceSubjectPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ReplaceSubjectPolicyResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreateMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateMenuRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateMenu(ctx, req.(*CreateMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateMenuRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateMenu(ctx, req.(*UpdateMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteMenuRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteMenu(ctx, req.(*DeleteMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMenuRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMenu(ctx, req.(*GetMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMenuRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMenu(ctx, req.(*ListMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListMyMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListMyMenuRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListMyMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListMyMenu(ctx, req.(*ListMyMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListMyMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListRoleMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleMenuRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListRoleMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleMenu(ctx, req.(*ListRoleMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateRoleMenu0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleMenuRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateRoleMenu)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRoleMenu(ctx, req.(*UpdateRoleMenuRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateRoleMenuResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreateDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateDepartment(ctx, req.(*CreateDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateDepartment(ctx, req.(*UpdateDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteDepartmentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteDepartment(ctx, req.(*DeleteDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDepartmentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDepartment(ctx, req.(*GetDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDepartmentRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDepartment(ctx, req.(*ListDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetDepartmentSubtree0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetDepartmentSubtreeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetDepartmentSubtree)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetDepartmentSubtree(ctx, req.(*GetDepartmentSubtreeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetDepartmentSubtreeResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListDepartmentAncestor0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListDepartmentAncestorRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListDepartmentAncestor)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListDepartmentAncestor(ctx, req.(*ListDepartmentAncestorRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListDepartmentAncestorResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateUserDepartment0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserDepartmentRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateUserDepartment)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUserDepartment(ctx, req.(*UpdateUserDepartmentRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateUserDepartmentResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_CreateSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterCreateSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateSecret(ctx, req.(*CreateSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_UpdateSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateSecretRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterUpdateSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateSecret(ctx, req.(*UpdateSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_DeleteSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterDeleteSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteSecret(ctx, req.(*DeleteSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_GetSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterGetSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetSecret(ctx, req.(*GetSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _UserCenter_ListSecret0_HTTP_Handler(srv UserCenterHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListSecretRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserCenterListSecret)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListSecret(ctx, req.(*ListSecretRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListSecretResponse)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserCenterHTTPClient interface {
+	AssignUserRole(ctx context.Context, req *AssignUserRoleRequest, opts ...http.CallOption) (rsp *AssignUserRoleResponse, err error)
+	Auth(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *AuthResponse, err error)
+	Authenticate(ctx context.Context, req *AuthenticateRequest, opts ...http.CallOption) (rsp *AuthenticateResponse, err error)
+	Authorize(ctx context.Context, req *AuthorizeRequest, opts ...http.CallOption) (rsp *AuthorizeResponse, err error)
+	ChangeExpiredPassword(ctx context.Context, req *ChangeExpiredPasswordRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	ConfirmMFA(ctx context.Context, req *ConfirmMFARequest, opts ...http.CallOption) (rsp *ConfirmMFAResponse, err error)
+	CreateDepartment(ctx context.Context, req *CreateDepartmentRequest, opts ...http.CallOption) (rsp *CreateDepartmentResponse, err error)
+	CreateMenu(ctx context.Context, req *CreateMenuRequest, opts ...http.CallOption) (rsp *CreateMenuResponse, err error)
+	CreatePolicy(ctx context.Context, req *CreatePolicyRequest, opts ...http.CallOption) (rsp *CreatePolicyResponse, err error)
+	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *CreateRoleResponse, err error)
+	CreateSecret(ctx context.Context, req *CreateSecretRequest, opts ...http.CallOption) (rsp *CreateSecretResponse, err error)
+	CreateUser(ctx context.Context, req *CreateUserRequest, opts ...http.CallOption) (rsp *CreateUserResponse, err error)
+	DeleteAllSession(ctx context.Context, req *DeleteAllSessionRequest, opts ...http.CallOption) (rsp *DeleteAllSessionResponse, err error)
+	DeleteAllUserSession(ctx context.Context, req *DeleteAllSessionRequest, opts ...http.CallOption) (rsp *DeleteAllSessionResponse, err error)
+	DeleteDepartment(ctx context.Context, req *DeleteDepartmentRequest, opts ...http.CallOption) (rsp *DeleteDepartmentResponse, err error)
+	DeleteMenu(ctx context.Context, req *DeleteMenuRequest, opts ...http.CallOption) (rsp *DeleteMenuResponse, err error)
+	DeletePolicy(ctx context.Context, req *DeletePolicyRequest, opts ...http.CallOption) (rsp *DeletePolicyResponse, err error)
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *DeleteRoleResponse, err error)
+	DeleteSecret(ctx context.Context, req *DeleteSecretRequest, opts ...http.CallOption) (rsp *DeleteSecretResponse, err error)
+	DeleteSession(ctx context.Context, req *DeleteSessionRequest, opts ...http.CallOption) (rsp *DeleteSessionResponse, err error)
+	DeleteUser(ctx context.Context, req *DeleteUserRequest, opts ...http.CallOption) (rsp *DeleteUserResponse, err error)
+	DeleteUserSession(ctx context.Context, req *DeleteSessionRequest, opts ...http.CallOption) (rsp *DeleteSessionResponse, err error)
+	DisableMFA(ctx context.Context, req *DisableMFARequest, opts ...http.CallOption) (rsp *DisableMFAResponse, err error)
+	EnrollMFA(ctx context.Context, req *EnrollMFARequest, opts ...http.CallOption) (rsp *EnrollMFAResponse, err error)
+	ForgotPassword(ctx context.Context, req *ForgotPasswordRequest, opts ...http.CallOption) (rsp *ForgotPasswordResponse, err error)
+	GetCaptcha(ctx context.Context, req *GetCaptchaRequest, opts ...http.CallOption) (rsp *GetCaptchaResponse, err error)
+	GetDepartment(ctx context.Context, req *GetDepartmentRequest, opts ...http.CallOption) (rsp *GetDepartmentResponse, err error)
+	GetDepartmentSubtree(ctx context.Context, req *GetDepartmentSubtreeRequest, opts ...http.CallOption) (rsp *GetDepartmentSubtreeResponse, err error)
+	GetMenu(ctx context.Context, req *GetMenuRequest, opts ...http.CallOption) (rsp *GetMenuResponse, err error)
+	GetRole(ctx context.Context, req *GetRoleRequest, opts ...http.CallOption) (rsp *GetRoleResponse, err error)
+	GetSecret(ctx context.Context, req *GetSecretRequest, opts ...http.CallOption) (rsp *GetSecretResponse, err error)
+	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *GetUserResponse, err error)
+	ImpersonateUser(ctx context.Context, req *ImpersonateUserRequest, opts ...http.CallOption) (rsp *ImpersonateUserResponse, err error)
+	JWKS(ctx context.Context, req *JWKSRequest, opts ...http.CallOption) (rsp *JWKSResponse, err error)
+	ListAuditLog(ctx context.Context, req *ListAuditLogRequest, opts ...http.CallOption) (rsp *ListAuditLogResponse, err error)
+	ListDepartment(ctx context.Context, req *ListDepartmentRequest, opts ...http.CallOption) (rsp *ListDepartmentResponse, err error)
+	ListDepartmentAncestor(ctx context.Context, req *ListDepartmentAncestorRequest, opts ...http.CallOption) (rsp *ListDepartmentAncestorResponse, err error)
+	ListJWTKey(ctx context.Context, req *ListJWTKeyRequest, opts ...http.CallOption) (rsp *ListJWTKeyResponse, err error)
+	ListMenu(ctx context.Context, req *ListMenuRequest, opts ...http.CallOption) (rsp *ListMenuResponse, err error)
+	ListMyMenu(ctx context.Context, req *ListMyMenuRequest, opts ...http.CallOption) (rsp *ListMyMenuResponse, err error)
+	ListOIDCProvider(ctx context.Context, req *ListOIDCProviderRequest, opts ...http.CallOption) (rsp *ListOIDCProviderResponse, err error)
+	ListPolicy(ctx context.Context, req *ListPolicyRequest, opts ...http.CallOption) (rsp *ListPolicyResponse, err error)
+	ListRole(ctx context.Context, req *ListRoleRequest, opts ...http.CallOption) (rsp *ListRoleResponse, err error)
+	ListRoleMember(ctx context.Context, req *ListRoleMemberRequest, opts ...http.CallOption) (rsp *ListRoleMemberResponse, err error)
+	ListRoleMenu(ctx context.Context, req *ListRoleMenuRequest, opts ...http.CallOption) (rsp *ListRoleMenuResponse, err error)
+	ListSecret(ctx context.Context, req *ListSecretRequest, opts ...http.CallOption) (rsp *ListSecretResponse, err error)
+	ListSession(ctx context.Context, req *ListSessionRequest, opts ...http.CallOption) (rsp *ListSessionResponse, err error)
+	ListUser(ctx context.Context, req *ListUserRequest, opts ...http.CallOption) (rsp *ListUserResponse, err error)
+	ListUserAuditLog(ctx context.Context, req *ListAuditLogRequest, opts ...http.CallOption) (rsp *ListAuditLogResponse, err error)
+	ListUserRole(ctx context.Context, req *ListUserRoleRequest, opts ...http.CallOption) (rsp *ListUserRoleResponse, err error)
+	ListUserSession(ctx context.Context, req *ListSessionRequest, opts ...http.CallOption) (rsp *ListSessionResponse, err error)
+	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutResponse, err error)
+	OIDCCallback(ctx context.Context, req *OIDCCallbackRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	OIDCLogin(ctx context.Context, req *OIDCLoginRequest, opts ...http.CallOption) (rsp *OIDCLoginResponse, err error)
+	PromoteJWTKey(ctx context.Context, req *PromoteJWTKeyRequest, opts ...http.CallOption) (rsp *PromoteJWTKeyResponse, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+	RemoveUserRole(ctx context.Context, req *RemoveUserRoleRequest, opts ...http.CallOption) (rsp *RemoveUserRoleResponse, err error)
+	ReplaceSubjectPolicy(ctx context.Context, req *ReplaceSubjectPolicyRequest, opts ...http.CallOption) (rsp *ReplaceSubjectPolicyResponse, err error)
+	ResendVerification(ctx context.Context, req *ResendVerificationRequest, opts ...http.CallOption) (rsp *ResendVerificationResponse, err error)
+	ResetPassword(ctx context.Context, req *ResetPasswordRequest, opts ...http.CallOption) (rsp *ResetPasswordResponse, err error)
+	UnlockUser(ctx context.Context, req *UnlockUserRequest, opts ...http.CallOption) (rsp *UnlockUserResponse, err error)
+	UpdateDepartment(ctx context.Context, req *UpdateDepartmentRequest, opts ...http.CallOption) (rsp *UpdateDepartmentResponse, err error)
+	UpdateMenu(ctx context.Context, req *UpdateMenuRequest, opts ...http.CallOption) (rsp *UpdateMenuResponse, err error)
+	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *UpdatePasswordResponse, err error)
+	UpdatePolicy(ctx context.Context, req *UpdatePolicyRequest, opts ...http.CallOption) (rsp *UpdatePolicyResponse, err error)
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *UpdateRoleResponse, err error)
+	UpdateRoleMenu(ctx context.Context, req *UpdateRoleMenuRequest, opts ...http.CallOption) (rsp *UpdateRoleMenuResponse, err error)
+	UpdateSecret(ctx context.Context, req *UpdateSecretRequest, opts ...http.CallOption) (rsp *UpdateSecretResponse, err error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UpdateUserResponse, err error)
+	UpdateUserDepartment(ctx context.Context, req *UpdateUserDepartmentRequest, opts ...http.CallOption) (rsp *UpdateUserDepartmentResponse, err error)
+	VerifyEmail(ctx context.Context, req *VerifyEmailRequest, opts ...http.CallOption) (rsp *VerifyEmailResponse, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *LoginReply, err error)
+}
+
+type UserCenterHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewUserCenterHTTPClient(client *http.Client) UserCenterHTTPClient {
+	return &UserCenterHTTPClientImpl{client}
+}
+
+func (c *UserCenterHTTPClientImpl) AssignUserRole(ctx context.Context, in *AssignUserRoleRequest, opts ...http.CallOption) (*AssignUserRoleResponse, error) {
+	var out AssignUserRoleResponse
+	pattern := "/v1/users/{userID}/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterAssignUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) Auth(ctx context.Context, in *AuthRequest, opts ...http.CallOption) (*AuthResponse, error) {
+	var out AuthResponse
+	pattern := "/v1/auth/auth"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterAuth))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) Authenticate(ctx context.Context, in *AuthenticateRequest, opts ...http.CallOption) (*AuthenticateResponse, error) {
+	var out AuthenticateResponse
+	pattern := "/v1/auth/authenticate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterAuthenticate))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...http.CallOption) (*AuthorizeResponse, error) {
+	var out AuthorizeResponse
+	pattern := "/v1/auth/authorize"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterAuthorize))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ChangeExpiredPassword(ctx context.Context, in *ChangeExpiredPasswordRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/auth/change-expired-password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterChangeExpiredPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ConfirmMFA(ctx context.Context, in *ConfirmMFARequest, opts ...http.CallOption) (*ConfirmMFAResponse, error) {
+	var out ConfirmMFAResponse
+	pattern := "/v1/mfa/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterConfirmMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreateDepartment(ctx context.Context, in *CreateDepartmentRequest, opts ...http.CallOption) (*CreateDepartmentResponse, error) {
+	var out CreateDepartmentResponse
+	pattern := "/v1/departments"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreateMenu(ctx context.Context, in *CreateMenuRequest, opts ...http.CallOption) (*CreateMenuResponse, error) {
+	var out CreateMenuResponse
+	pattern := "/v1/menus"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateMenu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...http.CallOption) (*CreatePolicyResponse, error) {
+	var out CreatePolicyResponse
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreatePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*CreateRoleResponse, error) {
+	var out CreateRoleResponse
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*CreateSecretResponse, error) {
+	var out CreateSecretResponse
+	pattern := "/v1/secrets"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserResponse, error) {
+	var out CreateUserResponse
+	pattern := "/v1/users"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterCreateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteAllSession(ctx context.Context, in *DeleteAllSessionRequest, opts ...http.CallOption) (*DeleteAllSessionResponse, error) {
+	var out DeleteAllSessionResponse
+	pattern := "/v1/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteAllSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteAllUserSession(ctx context.Context, in *DeleteAllSessionRequest, opts ...http.CallOption) (*DeleteAllSessionResponse, error) {
+	var out DeleteAllSessionResponse
+	pattern := "/v1/users/{userID}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteAllUserSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteDepartment(ctx context.Context, in *DeleteDepartmentRequest, opts ...http.CallOption) (*DeleteDepartmentResponse, error) {
+	var out DeleteDepartmentResponse
+	pattern := "/v1/departments/{departmentID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteMenu(ctx context.Context, in *DeleteMenuRequest, opts ...http.CallOption) (*DeleteMenuResponse, error) {
+	var out DeleteMenuResponse
+	pattern := "/v1/menus/{menuID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteMenu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...http.CallOption) (*DeletePolicyResponse, error) {
+	var out DeletePolicyResponse
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeletePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*DeleteRoleResponse, error) {
+	var out DeleteRoleResponse
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...http.CallOption) (*DeleteSecretResponse, error) {
+	var out DeleteSecretResponse
+	pattern := "/v1/secrets/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteSession(ctx context.Context, in *DeleteSessionRequest, opts ...http.CallOption) (*DeleteSessionResponse, error) {
+	var out DeleteSessionResponse
+	pattern := "/v1/sessions/{sessionID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...http.CallOption) (*DeleteUserResponse, error) {
+	var out DeleteUserResponse
+	pattern := "/v1/users/{userID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DeleteUserSession(ctx context.Context, in *DeleteSessionRequest, opts ...http.CallOption) (*DeleteSessionResponse, error) {
+	var out DeleteSessionResponse
+	pattern := "/v1/users/{userID}/sessions/{sessionID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterDeleteUserSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) DisableMFA(ctx context.Context, in *DisableMFARequest, opts ...http.CallOption) (*DisableMFAResponse, error) {
+	var out DisableMFAResponse
+	pattern := "/v1/mfa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterDisableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) EnrollMFA(ctx context.Context, in *EnrollMFARequest, opts ...http.CallOption) (*EnrollMFAResponse, error) {
+	var out EnrollMFAResponse
+	pattern := "/v1/mfa/enroll"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterEnrollMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ForgotPassword(ctx context.Context, in *ForgotPasswordRequest, opts ...http.CallOption) (*ForgotPasswordResponse, error) {
+	var out ForgotPasswordResponse
+	pattern := "/v1/auth/password/forgot"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterForgotPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetCaptcha(ctx context.Context, in *GetCaptchaRequest, opts ...http.CallOption) (*GetCaptchaResponse, error) {
+	var out GetCaptchaResponse
+	pattern := "/v1/auth/captcha"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetCaptcha))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetDepartment(ctx context.Context, in *GetDepartmentRequest, opts ...http.CallOption) (*GetDepartmentResponse, error) {
+	var out GetDepartmentResponse
+	pattern := "/v1/departments/{departmentID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetDepartmentSubtree(ctx context.Context, in *GetDepartmentSubtreeRequest, opts ...http.CallOption) (*GetDepartmentSubtreeResponse, error) {
+	var out GetDepartmentSubtreeResponse
+	pattern := "/v1/departments/{departmentID}/subtree"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetDepartmentSubtree))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetMenu(ctx context.Context, in *GetMenuRequest, opts ...http.CallOption) (*GetMenuResponse, error) {
+	var out GetMenuResponse
+	pattern := "/v1/menus/{menuID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetMenu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetRole(ctx context.Context, in *GetRoleRequest, opts ...http.CallOption) (*GetRoleResponse, error) {
+	var out GetRoleResponse
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*GetSecretResponse, error) {
+	var out GetSecretResponse
+	pattern := "/v1/secrets/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetSecret))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*GetUserResponse, error) {
+	var out GetUserResponse
+	pattern := "/v1/users/{userID}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterGetUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ImpersonateUser(ctx context.Context, in *ImpersonateUserRequest, opts ...http.CallOption) (*ImpersonateUserResponse, error) {
+	var out ImpersonateUserResponse
+	pattern := "/v1/users/{userID}/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterImpersonateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) JWKS(ctx context.Context, in *JWKSRequest, opts ...http.CallOption) (*JWKSResponse, error) {
+	var out JWKSResponse
+	pattern := "/.well-known/jwks.json"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterJWKS))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...http.CallOption) (*ListAuditLogResponse, error) {
+	var out ListAuditLogResponse
+	pattern := "/v1/audit-logs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListAuditLog))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) Authorize(ctx context.Context, in *AuthorizeRequest, opts ...http.CallOption) (*AuthorizeResponse, error) {
-	var out AuthorizeResponse
-	pattern := "/v1/auth/authorize"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCenterAuthorize))
+func (c *UserCenterHTTPClientImpl) ListDepartment(ctx context.Context, in *ListDepartmentRequest, opts ...http.CallOption) (*ListDepartmentResponse, error) {
+	var out ListDepartmentResponse
+	pattern := "/v1/departments"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListDepartment))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...http.CallOption) (*CreateSecretResponse, error) {
-	var out CreateSecretResponse
-	pattern := "/v1/secrets"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCenterCreateSecret))
+func (c *UserCenterHTTPClientImpl) ListDepartmentAncestor(ctx context.Context, in *ListDepartmentAncestorRequest, opts ...http.CallOption) (*ListDepartmentAncestorResponse, error) {
+	var out ListDepartmentAncestorResponse
+	pattern := "/v1/departments/{departmentID}/ancestors"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListDepartmentAncestor))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...http.CallOption) (*CreateUserResponse, error) {
-	var out CreateUserResponse
-	pattern := "/v1/users"
-	path := binding.EncodeURL(pattern, in, false)
-	opts = append(opts, http.Operation(OperationUserCenterCreateUser))
+func (c *UserCenterHTTPClientImpl) ListJWTKey(ctx context.Context, in *ListJWTKeyRequest, opts ...http.CallOption) (*ListJWTKeyResponse, error) {
+	var out ListJWTKeyResponse
+	pattern := "/v1/jwt-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListJWTKey))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...http.CallOption) (*DeleteSecretResponse, error) {
-	var out DeleteSecretResponse
-	pattern := "/v1/secrets/{name}"
+func (c *UserCenterHTTPClientImpl) ListMenu(ctx context.Context, in *ListMenuRequest, opts ...http.CallOption) (*ListMenuResponse, error) {
+	var out ListMenuResponse
+	pattern := "/v1/menus"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationUserCenterDeleteSecret))
+	opts = append(opts, http.Operation(OperationUserCenterListMenu))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...http.CallOption) (*DeleteUserResponse, error) {
-	var out DeleteUserResponse
-	pattern := "/v1/users/{userID}"
+func (c *UserCenterHTTPClientImpl) ListMyMenu(ctx context.Context, in *ListMyMenuRequest, opts ...http.CallOption) (*ListMyMenuResponse, error) {
+	var out ListMyMenuResponse
+	pattern := "/v1/menus/mine"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationUserCenterDeleteUser))
+	opts = append(opts, http.Operation(OperationUserCenterListMyMenu))
 	opts = append(opts, http.PathTemplate(pattern))
-	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretRequest, opts ...http.CallOption) (*GetSecretResponse, error) {
-	var out GetSecretResponse
-	pattern := "/v1/secrets/{name}"
+func (c *UserCenterHTTPClientImpl) ListOIDCProvider(ctx context.Context, in *ListOIDCProviderRequest, opts ...http.CallOption) (*ListOIDCProviderResponse, error) {
+	var out ListOIDCProviderResponse
+	pattern := "/v1/auth/oidc/providers"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationUserCenterGetSecret))
+	opts = append(opts, http.Operation(OperationUserCenterListOIDCProvider))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -601,11 +2530,50 @@ func (c *UserCenterHTTPClientImpl) GetSecret(ctx context.Context, in *GetSecretR
 	return &out, nil
 }
 
-func (c *UserCenterHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*GetUserResponse, error) {
-	var out GetUserResponse
-	pattern := "/v1/users/{userID}"
+func (c *UserCenterHTTPClientImpl) ListPolicy(ctx context.Context, in *ListPolicyRequest, opts ...http.CallOption) (*ListPolicyResponse, error) {
+	var out ListPolicyResponse
+	pattern := "/v1/policies"
 	path := binding.EncodeURL(pattern, in, true)
-	opts = append(opts, http.Operation(OperationUserCenterGetUser))
+	opts = append(opts, http.Operation(OperationUserCenterListPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListRole(ctx context.Context, in *ListRoleRequest, opts ...http.CallOption) (*ListRoleResponse, error) {
+	var out ListRoleResponse
+	pattern := "/v1/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListRoleMember(ctx context.Context, in *ListRoleMemberRequest, opts ...http.CallOption) (*ListRoleMemberResponse, error) {
+	var out ListRoleMemberResponse
+	pattern := "/v1/roles/{name}/members"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListRoleMember))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListRoleMenu(ctx context.Context, in *ListRoleMenuRequest, opts ...http.CallOption) (*ListRoleMenuResponse, error) {
+	var out ListRoleMenuResponse
+	pattern := "/v1/roles/{name}/menus"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListRoleMenu))
 	opts = append(opts, http.PathTemplate(pattern))
 	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
 	if err != nil {
@@ -627,6 +2595,19 @@ func (c *UserCenterHTTPClientImpl) ListSecret(ctx context.Context, in *ListSecre
 	return &out, nil
 }
 
+func (c *UserCenterHTTPClientImpl) ListSession(ctx context.Context, in *ListSessionRequest, opts ...http.CallOption) (*ListSessionResponse, error) {
+	var out ListSessionResponse
+	pattern := "/v1/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserCenterHTTPClientImpl) ListUser(ctx context.Context, in *ListUserRequest, opts ...http.CallOption) (*ListUserResponse, error) {
 	var out ListUserResponse
 	pattern := "/v1/users"
@@ -640,6 +2621,45 @@ func (c *UserCenterHTTPClientImpl) ListUser(ctx context.Context, in *ListUserReq
 	return &out, nil
 }
 
+func (c *UserCenterHTTPClientImpl) ListUserAuditLog(ctx context.Context, in *ListAuditLogRequest, opts ...http.CallOption) (*ListAuditLogResponse, error) {
+	var out ListAuditLogResponse
+	pattern := "/v1/users/{userID}/audit-logs"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListUserAuditLog))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListUserRole(ctx context.Context, in *ListUserRoleRequest, opts ...http.CallOption) (*ListUserRoleResponse, error) {
+	var out ListUserRoleResponse
+	pattern := "/v1/users/{userID}/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ListUserSession(ctx context.Context, in *ListSessionRequest, opts ...http.CallOption) (*ListSessionResponse, error) {
+	var out ListSessionResponse
+	pattern := "/v1/users/{userID}/sessions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterListUserSession))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserCenterHTTPClientImpl) Login(ctx context.Context, in *LoginRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/auth/login"
@@ -666,6 +2686,45 @@ func (c *UserCenterHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest
 	return &out, nil
 }
 
+func (c *UserCenterHTTPClientImpl) OIDCCallback(ctx context.Context, in *OIDCCallbackRequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/auth/oidc/{provider}/callback"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterOIDCCallback))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) OIDCLogin(ctx context.Context, in *OIDCLoginRequest, opts ...http.CallOption) (*OIDCLoginResponse, error) {
+	var out OIDCLoginResponse
+	pattern := "/v1/auth/oidc/{provider}/login"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterOIDCLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) PromoteJWTKey(ctx context.Context, in *PromoteJWTKeyRequest, opts ...http.CallOption) (*PromoteJWTKeyResponse, error) {
+	var out PromoteJWTKeyResponse
+	pattern := "/v1/jwt-keys/promote"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterPromoteJWTKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserCenterHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*LoginReply, error) {
 	var out LoginReply
 	pattern := "/v1/auth/refresh-token"
@@ -679,6 +2738,97 @@ func (c *UserCenterHTTPClientImpl) RefreshToken(ctx context.Context, in *Refresh
 	return &out, nil
 }
 
+func (c *UserCenterHTTPClientImpl) RemoveUserRole(ctx context.Context, in *RemoveUserRoleRequest, opts ...http.CallOption) (*RemoveUserRoleResponse, error) {
+	var out RemoveUserRoleResponse
+	pattern := "/v1/users/{userID}/roles/{name}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserCenterRemoveUserRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ReplaceSubjectPolicy(ctx context.Context, in *ReplaceSubjectPolicyRequest, opts ...http.CallOption) (*ReplaceSubjectPolicyResponse, error) {
+	var out ReplaceSubjectPolicyResponse
+	pattern := "/v1/policies/{subject}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterReplaceSubjectPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ResendVerification(ctx context.Context, in *ResendVerificationRequest, opts ...http.CallOption) (*ResendVerificationResponse, error) {
+	var out ResendVerificationResponse
+	pattern := "/v1/auth/email/resend"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterResendVerification))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) ResetPassword(ctx context.Context, in *ResetPasswordRequest, opts ...http.CallOption) (*ResetPasswordResponse, error) {
+	var out ResetPasswordResponse
+	pattern := "/v1/auth/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterResetPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) UnlockUser(ctx context.Context, in *UnlockUserRequest, opts ...http.CallOption) (*UnlockUserResponse, error) {
+	var out UnlockUserResponse
+	pattern := "/v1/users/{userID}/unlock"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUnlockUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) UpdateDepartment(ctx context.Context, in *UpdateDepartmentRequest, opts ...http.CallOption) (*UpdateDepartmentResponse, error) {
+	var out UpdateDepartmentResponse
+	pattern := "/v1/departments/{departmentID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) UpdateMenu(ctx context.Context, in *UpdateMenuRequest, opts ...http.CallOption) (*UpdateMenuResponse, error) {
+	var out UpdateMenuResponse
+	pattern := "/v1/menus/{menuID}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateMenu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserCenterHTTPClientImpl) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...http.CallOption) (*UpdatePasswordResponse, error) {
 	var out UpdatePasswordResponse
 	pattern := "/v1/users/{userID}/update-password"
@@ -692,6 +2842,45 @@ func (c *UserCenterHTTPClientImpl) UpdatePassword(ctx context.Context, in *Updat
 	return &out, nil
 }
 
+func (c *UserCenterHTTPClientImpl) UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...http.CallOption) (*UpdatePolicyResponse, error) {
+	var out UpdatePolicyResponse
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdatePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...http.CallOption) (*UpdateRoleResponse, error) {
+	var out UpdateRoleResponse
+	pattern := "/v1/roles/{name}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) UpdateRoleMenu(ctx context.Context, in *UpdateRoleMenuRequest, opts ...http.CallOption) (*UpdateRoleMenuResponse, error) {
+	var out UpdateRoleMenuResponse
+	pattern := "/v1/roles/{name}/menus"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateRoleMenu))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *UserCenterHTTPClientImpl) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...http.CallOption) (*UpdateSecretResponse, error) {
 	var out UpdateSecretResponse
 	pattern := "/v1/secrets/{name}"
@@ -717,3 +2906,42 @@ func (c *UserCenterHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUse
 	}
 	return &out, nil
 }
+
+func (c *UserCenterHTTPClientImpl) UpdateUserDepartment(ctx context.Context, in *UpdateUserDepartmentRequest, opts ...http.CallOption) (*UpdateUserDepartmentResponse, error) {
+	var out UpdateUserDepartmentResponse
+	pattern := "/v1/users/{userID}/department"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterUpdateUserDepartment))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) VerifyEmail(ctx context.Context, in *VerifyEmailRequest, opts ...http.CallOption) (*VerifyEmailResponse, error) {
+	var out VerifyEmailResponse
+	pattern := "/v1/auth/email/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterVerifyEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserCenterHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*LoginReply, error) {
+	var out LoginReply
+	pattern := "/v1/auth/mfa/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserCenterVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}